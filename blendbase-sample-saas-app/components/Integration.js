@@ -25,7 +25,7 @@ function CheckIcon() {
 function Integration({ integration, blendbaseClient }) {
   const [clientId, setClientId] = useState();
   const [clientSecret, setClientSecret] = useState();
-  const [salesforceInstanceSubdomain, setSalesforceInstanceSubdomain] = useState();
+  const [salesforceLoginUrl, setSalesforceLoginUrl] = useState();
   const [errorMessage, setErrorMessage] = useState();
   const [successMessage, setSuccessMessage] = useState();
   const [clientCredentialsSet, setClientCredentialsSet] = useState(integration.oauth2Metadata?.clientCredentialsSet);
@@ -66,7 +66,7 @@ function Integration({ integration, blendbaseClient }) {
         input: {
           clientID: clientId,
           clientSecret: clientSecret,
-          salesforceInstanceSubdomain: salesforceInstanceSubdomain
+          salesforceLoginUrl: salesforceLoginUrl
         }
       }
     });
@@ -75,7 +75,7 @@ function Integration({ integration, blendbaseClient }) {
       setSuccessMessage("Credentials updated successfully");
       setClientId("");
       setClientSecret("");
-      setSalesforceInstanceSubdomain("");
+      setSalesforceLoginUrl("");
       setClientCredentialsSet(true);
     } else {
       setErrorMessage("Error updating credentials");
@@ -112,8 +112,8 @@ function Integration({ integration, blendbaseClient }) {
     setClientSecret(event.target.value);
   }
 
-  function onSalesforceInstanceSubdomainChanged(event) {
-    setSalesforceInstanceSubdomain(event.target.value);
+  function onSalesforceLoginUrlChanged(event) {
+    setSalesforceLoginUrl(event.target.value);
   }
 
   function cleanMessages() {
@@ -156,10 +156,10 @@ function Integration({ integration, blendbaseClient }) {
           </div>
           <div className="bg-white px-4 py-5 sm:grid sm:grid-cols-3 sm:gap-4 sm:px-6">
             <dt className="text-sm font-medium text-gray-500">Authentication Type</dt>
-            <dd className="mt-1 text-sm text-gray-900 sm:col-span-2 sm:mt-0">{integration.authType}</dd>
+            <dd className="mt-1 text-sm text-gray-900 sm:col-span-2 sm:mt-0">{integration.authTypes.join(", ")}</dd>
           </div>
 
-          {integration.enabled && integration.authType == "secret" && (
+          {integration.enabled && integration.authTypes.includes("secret") && (
             <div className="bg-gray-50 px-4 py-5 text-sm sm:grid sm:grid-cols-3 sm:gap-4 sm:px-6">
               <dt className="font-medium text-gray-500">Integration Secret</dt>
               <dd className="mt-1 sm:col-span-2 sm:mt-0">
@@ -185,7 +185,7 @@ function Integration({ integration, blendbaseClient }) {
             </div>
           )}
 
-          {integration.enabled && integration.authType == "oauth2" && (
+          {integration.enabled && integration.authTypes.includes("oauth2") && (
             <div className="bg-gray-50 px-4 py-5 text-sm sm:grid sm:grid-cols-3 sm:gap-4 sm:px-6">
               <dt className="font-medium text-gray-500">Client Credentials</dt>
               <dd className="mt-1 sm:col-span-2 sm:mt-0">
@@ -243,12 +243,12 @@ function Integration({ integration, blendbaseClient }) {
                     />
                     {integration.serviceCode == SERVICE_CODE_CRM_SALESFORCE && (
                       <input
-                        value={salesforceInstanceSubdomain}
-                        onChange={onSalesforceInstanceSubdomainChanged}
+                        value={salesforceLoginUrl}
+                        onChange={onSalesforceLoginUrlChanged}
                         type="text"
-                        placeholder="Instance Subdomain"
-                        name="salesforce-instance-subdomain"
-                        id="salesforce-instance-subdomain"
+                        placeholder="Login URL, e.g. https://test.salesforce.com for a sandbox"
+                        name="salesforce-login-url"
+                        id="salesforce-login-url"
                         className="block w-full rounded-md border-gray-300 px-3 py-2 shadow-sm focus:border-indigo-500 focus:ring-indigo-500"
                       />
                     )}
@@ -265,7 +265,7 @@ function Integration({ integration, blendbaseClient }) {
             </div>
           )}
 
-          {integration.enabled && integration.authType == "oauth2" && (
+          {integration.enabled && integration.authTypes.includes("oauth2") && (
             <div className="bg-white px-4 py-5 text-sm sm:grid sm:grid-cols-3 sm:gap-4 sm:px-6">
               <dt className="font-medium text-gray-500">Access Token</dt>
               <dd className="mt-1 sm:col-span-2 sm:mt-0">
@@ -11,6 +11,7 @@ export const LIST_INTEGRATIONS = gql`
         enabled
         description
         authType
+        authTypes
         loginURL
         callbackURL
 
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"blendbase/config"
+	"blendbase/misc/db_utils"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/urfave/cli/v2"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var sandboxIntegrationId string
+
+var SandboxResetCmd = &cli.Command{
+	Name:        "sandbox:reset",
+	Description: "Use this command to delete the records of the sandbox CRM and seed it again, all the sandbox integrations are reset unless --integration-id is given",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "integration-id",
+			Destination: &sandboxIntegrationId,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		godotenv.Load()
+
+		var integrationID *uuid.UUID
+		if sandboxIntegrationId != "" {
+			id, err := uuid.Parse(sandboxIntegrationId)
+			if err != nil {
+				log.Fatalf("invalid integration ID: %s", err)
+				return err
+			}
+			integrationID = &id
+		}
+
+		app, err := config.NewApp()
+		if err != nil {
+			log.Fatalf("failed to create an app: %s", err)
+			return err
+		}
+
+		if err := db_utils.ResetSandbox(app, integrationID); err != nil {
+			log.Fatalf("failed to reset the sandbox: %s", err)
+			return err
+		}
+
+		log.Info("Sandbox reset successfully")
+
+		return nil
+	},
+}
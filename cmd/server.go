@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"blendbase/connectors/salesforce"
+	"blendbase/connectors"
 	"blendbase/graph"
 	"blendbase/graph/auth"
 	"blendbase/graph/generated"
@@ -77,7 +77,7 @@ var ServerCmd = &cli.Command{
 			AllowedOrigins: []string{"https://*", "http://*"},
 			// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
 			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "x-api-token"},
+			AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "x-api-token", graph.INTEGRATION_ID_HEADER},
 			// ExposedHeaders:   []string{"Link"},
 			AllowCredentials: true,
 			MaxAge:           300, // Maximum value not ignored by any of major browsers
@@ -93,10 +93,16 @@ var ServerCmd = &cli.Command{
 			r.Route("/{consumerID:[0-9a-f-]+}/integrations", func(r chi.Router) {
 				r.Use(ConsumerCtx)
 
-				r.Route("/crm_salesforce/oauth2", func(r chi.Router) {
-					r.Get("/login", salesforce.AuthHandleLogin(app))
-					r.Get("/callback", salesforce.AuthHandleCallback(app))
-				})
+				for _, registration := range connectors.Registrations() {
+					if registration.OAuth2 == nil {
+						continue
+					}
+
+					r.Route(fmt.Sprintf("/%s/oauth2", registration.ServiceCode), func(r chi.Router) {
+						r.Get("/login", connectors.HandleOAuth2Login(app, registration))
+						r.Get("/callback", connectors.HandleOAuth2Callback(app, registration))
+					})
+				}
 			})
 		})
 
@@ -104,6 +110,7 @@ var ServerCmd = &cli.Command{
 		r.Route("/omni", func(r chi.Router) {
 			r.Use(graphAuth.Verifier())
 			r.Use(graphAuth.Authenticator)
+			r.Use(graph.IntegrationSelector)
 			r.Handle("/query", omniAPIServer)
 		})
 
@@ -111,6 +118,9 @@ var ServerCmd = &cli.Command{
 		// TODO: Dev env only
 		r.Handle("/", playground.Handler("GraphQL playground", "/omni/query"))
 
+		// the OAuth2 tokens are refreshed ahead of their expiration while the server runs
+		go connectors.RefreshExpiringTokens(c.Context, app)
+
 		app.Logger.Infof("Connect to http://localhost:%s/ for GraphQL playground", port)
 		app.Logger.Fatal(http.ListenAndServe(":"+port, r))
 
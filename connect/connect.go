@@ -2,6 +2,7 @@ package connect
 
 import (
 	"blendbase/connectors"
+	_ "blendbase/connectors/all"
 	"blendbase/graph/model"
 	"blendbase/integrations"
 	"blendbase/misc/gormext"
@@ -14,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 type ConnectClient struct {
@@ -43,68 +45,70 @@ func (client *ConnectClient) loadOAuth2Configuration(consumerIntegrationID uuid.
 	return &oauth2Configuration, nil
 }
 
+// Lists the integrations of the consumer along with the connectors the consumer has no integration with,
+// the connectors the consumer has several integrations with are listed once per integration
 func (client *ConnectClient) ListIntegrations() ([]*model.ConsumerIntegration, error) {
 	consumerIntegrations := []integrations.ConsumerIntegration{}
-	if err := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Find(&consumerIntegrations).Error; err != nil {
+	if err := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Order("created_at ASC").Find(&consumerIntegrations).Error; err != nil {
 		log.Errorf("Error finding integrations for %s: %s", client.ConsumerID, err)
 	}
 
 	outputIntegrations := []*model.ConsumerIntegration{}
 
-	// Loop through all available connectors
-	for _, availableConnector := range connectors.AvailableConnectors {
-		connector := availableConnector
-		enabled := false // disabled by default
+	// Loop through all registered connectors
+	for _, registration := range connectors.Registrations() {
+		connector := registration.Connector
 
-		outputIntegration := client.createOutputIntegrationFromConnector(&connector)
+		// find the matches from the DB consumer integrations by service code
+		matchingIntegrations := findConsumerIntegrationsByServiceCode(&consumerIntegrations, connector.ServiceCode)
 
-		// find the match from the DB consumer integration by service code
-		consumerIntegration := findConsumerIntegrationByServiceCode(&consumerIntegrations, connector.ServiceCode)
+		// disabled connector without an ID when there is no match
+		if len(matchingIntegrations) == 0 {
+			enabled := false
+			outputIntegration := client.createOutputIntegrationFromConnector(&connector)
+			outputIntegration.Capabilities = registration.CapabilitiesModel()
+			outputIntegration.Enabled = &enabled
 
-		// if there is a match, assing values from the exiting integration
-		if consumerIntegration != nil {
-			enabled = consumerIntegration.Enabled
-			ID := consumerIntegration.ID.String()
-			outputIntegration.ID = &ID
-
-			clientCredentialsSet := false
-			tokensSet := false
-			oauth2Config, _ := client.loadOAuth2Configuration(consumerIntegration.ID)
+			outputIntegrations = append(outputIntegrations, outputIntegration)
+			continue
+		}
 
-			if oauth2Config != nil {
-				clientCredentialsSet = (oauth2Config.ClientID.Raw != "" && oauth2Config.ClientSecret.Raw != "")
-				tokensSet = (oauth2Config.AccessToken.Raw != "" && oauth2Config.RefreshToken.Raw != "")
-			}
+		// if there are matches, assign values from the existing integrations
+		for _, consumerIntegration := range matchingIntegrations {
+			outputIntegration := client.createOutputIntegrationFromConnector(&connector)
+			outputIntegration.Capabilities = registration.CapabilitiesModel()
+			client.assignConsumerIntegration(outputIntegration, consumerIntegration)
 
-			outputIntegration.Oauth2Metadata = &model.OAuth2Metadata{
-				ClientCredentialsSet: clientCredentialsSet,
-				TokensSet:            tokensSet,
-			}
+			outputIntegrations = append(outputIntegrations, outputIntegration)
 		}
-		outputIntegration.Enabled = &enabled
-
-		outputIntegrations = append(outputIntegrations, outputIntegration)
 	}
 
 	return outputIntegrations, nil
 }
 
-// Enables or disables an integration
-// Adds or removes the integration to the DB if it doesn't exist
-func (client *ConnectClient) EnableIntegration(serviceCode string, enabled bool) (bool, error) {
+// Enables or disables an integration, the first integration of the connector unless an integration ID is given
+// Adds the integration to the DB if it doesn't exist
+// The consumer can have several integrations of the same type enabled, the first one enabled becomes the default
+func (client *ConnectClient) EnableIntegration(serviceCode string, enabled bool, consumerIntegrationID *uuid.UUID) (bool, error) {
 	connector := findConnectorByServiceCode(serviceCode)
 	if connector == nil {
 		return false, fmt.Errorf("cannot add %s integration. %s is not in the list of available integrations for this client", serviceCode, serviceCode)
 	}
 
 	consumerIntegration := integrations.ConsumerIntegration{}
-	query := client.App.DB.FirstOrCreate(&consumerIntegration, integrations.ConsumerIntegration{
-		ConsumerID:  client.ConsumerID,
-		ServiceCode: connector.ServiceCode,
-		Type:        connector.Type,
-	})
-	if err := query.Error; err != nil {
-		return false, fmt.Errorf("error creating integration for %s: %s", serviceCode, err)
+	if consumerIntegrationID != nil {
+		if err := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Where("id = ?", *consumerIntegrationID).Where("service_code = ?", serviceCode).First(&consumerIntegration).Error; err != nil {
+			return false, fmt.Errorf("error finding %s integration #%s: %s", serviceCode, *consumerIntegrationID, err)
+		}
+	} else {
+		query := client.App.DB.Order("created_at ASC").FirstOrCreate(&consumerIntegration, integrations.ConsumerIntegration{
+			ConsumerID:  client.ConsumerID,
+			ServiceCode: connector.ServiceCode,
+			Type:        connector.Type,
+		})
+		if err := query.Error; err != nil {
+			return false, fmt.Errorf("error creating integration for %s: %s", serviceCode, err)
+		}
 	}
 
 	// updated enabled flag for the integration
@@ -112,17 +116,79 @@ func (client *ConnectClient) EnableIntegration(serviceCode string, enabled bool)
 		return false, fmt.Errorf("error enabling integrations #%s: %s", consumerIntegration.ID, err)
 	}
 
-	//disable remaining integrations of the same type
-	if enabled {
-		client.App.DB.Model(
-			&integrations.ConsumerIntegration{},
-		).Where(
-			"consumer_id = ?", client.ConsumerID,
-		).Where(
-			"id <> ?", consumerIntegration.ID,
-		).Where(
-			"type = ?", consumerIntegration.Type,
-		).Update("enabled", false)
+	if err := client.updateDefaultIntegration(consumerIntegration.Type); err != nil {
+		return false, fmt.Errorf("error updating the default %s integration: %s", consumerIntegration.Type, err)
+	}
+
+	return true, nil
+}
+
+// Lists the integrations of the type the consumer has connected, enabled or not, the default integration first.
+// The integrations are connected once they have a secret or the tokens of their OAuth2 configuration.
+func (client *ConnectClient) ListConnectedIntegrations(integrationType string) ([]*integrations.ConsumerIntegration, error) {
+	var consumerIntegrations []*integrations.ConsumerIntegration
+	query := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Where("type = ?", integrationType)
+	if err := query.Order("is_default DESC").Order("created_at ASC").Find(&consumerIntegrations).Error; err != nil {
+		return nil, err
+	}
+
+	connectedIntegrations := []*integrations.ConsumerIntegration{}
+	for _, consumerIntegration := range consumerIntegrations {
+		// the secrets and the tokens are encrypted, they're checked once they're loaded
+		if consumerIntegration.Secret.Raw == "" {
+			oauth2Configuration, _ := client.loadOAuth2Configuration(consumerIntegration.ID)
+			if oauth2Configuration == nil || (oauth2Configuration.AccessToken.Raw == "" && oauth2Configuration.RefreshToken.Raw == "") {
+				continue
+			}
+		}
+
+		connectedIntegrations = append(connectedIntegrations, consumerIntegration)
+	}
+
+	return connectedIntegrations, nil
+}
+
+// Adds another integration with the connector, e.g. for a second Salesforce organization
+// Returns the ID of the new integration, it's disabled until it's enabled
+func (client *ConnectClient) AddIntegration(serviceCode string) (uuid.UUID, error) {
+	connector := findConnectorByServiceCode(serviceCode)
+	if connector == nil {
+		return uuid.Nil, fmt.Errorf("cannot add %s integration. %s is not in the list of available integrations for this client", serviceCode, serviceCode)
+	}
+
+	consumerIntegration := integrations.ConsumerIntegration{
+		ConsumerID:  client.ConsumerID,
+		ServiceCode: connector.ServiceCode,
+		Type:        connector.Type,
+	}
+	if err := client.App.DB.Create(&consumerIntegration).Error; err != nil {
+		return uuid.Nil, fmt.Errorf("error creating integration for %s: %s", serviceCode, err)
+	}
+
+	return consumerIntegration.ID, nil
+}
+
+// Makes the enabled integration the default of its type,
+// the default integration is used when the Omni API requests don't select an integration
+func (client *ConnectClient) SetDefaultIntegration(consumerIntegrationID uuid.UUID) (bool, error) {
+	consumerIntegration := integrations.ConsumerIntegration{}
+	if err := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Where("id = ?", consumerIntegrationID).First(&consumerIntegration).Error; err != nil {
+		return false, fmt.Errorf("error finding integration #%s: %s", consumerIntegrationID, err)
+	}
+
+	if !consumerIntegration.Enabled {
+		return false, fmt.Errorf("integration #%s must be enabled to be the default", consumerIntegrationID)
+	}
+
+	err := client.App.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&integrations.ConsumerIntegration{}).Where("consumer_id = ?", client.ConsumerID).Where("type = ?", consumerIntegration.Type).Where("id <> ?", consumerIntegration.ID).Update("is_default", false).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&consumerIntegration).Update("is_default", true).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf("error setting the default integration #%s: %s", consumerIntegrationID, err)
 	}
 
 	return true, nil
@@ -156,18 +222,20 @@ func (client *ConnectClient) ConfigureOAuth2(consumerIntegrationID uuid.UUID, oa
 		return false, fmt.Errorf("clientID and clientSecret must be non-empty")
 	}
 
-	if consumerIntegration.ServiceCode == connectors.CONNECTOR_CRM_SALESFORCE && (oauth2Settings.SalesforceInstanceSubdomain == nil || *oauth2Settings.SalesforceInstanceSubdomain == "") {
-		return false, fmt.Errorf("salesforceInstanceSubdomain must be provided")
+	// connector specific settings, e.g. the Salesforce instance subdomain
+	var customSettings *integrations.ConsumerOauth2ConfigurationCustomSettings
+	if registration := connectors.Lookup(consumerIntegration.ServiceCode); registration != nil && registration.OAuth2 != nil && registration.OAuth2.CustomSettings != nil {
+		var err error
+		if customSettings, err = registration.OAuth2.CustomSettings(oauth2Settings); err != nil {
+			return false, err
+		}
 	}
 
 	oauth2Configuration.ClientID = gormext.EncryptedValue{Raw: *oauth2Settings.ClientID}
 	oauth2Configuration.ClientSecret = gormext.EncryptedValue{Raw: *oauth2Settings.ClientSecret}
 	oauth2Configuration.RedirectURL = client.getCallbackUrl(consumerIntegration.ServiceCode)
 
-	if consumerIntegration.ServiceCode == connectors.CONNECTOR_CRM_SALESFORCE {
-		customSettings := integrations.ConsumerOauth2ConfigurationCustomSettings{
-			SalesforceInstanceSubdomain: *oauth2Settings.SalesforceInstanceSubdomain,
-		}
+	if customSettings != nil {
 		customSettingsJson, _ := json.Marshal(customSettings)
 		oauth2Configuration.CustomSettings = datatypes.JSON(customSettingsJson)
 	}
@@ -210,22 +278,140 @@ func (client *ConnectClient) SetConsumerIntegrationSecret(consumerIntegrationID
 	return nil
 }
 
+// Maps the key of the custom fields of the object to a field of the CRM for the consumer integration,
+// the field of an existing key is replaced
+func (client *ConnectClient) SetFieldMapping(consumerIntegrationID uuid.UUID, input *model.FieldMappingInput) error {
+	consumerIntegration := integrations.ConsumerIntegration{}
+	if err := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Where("id = ?", consumerIntegrationID).First(&consumerIntegration).Error; err != nil {
+		return fmt.Errorf("error finding integration #%s: %s", consumerIntegrationID, err)
+	}
+
+	if err := connectors.ValidateFieldMapping(input.Object, input.Key, input.Field); err != nil {
+		return err
+	}
+
+	fieldMapping := integrations.ConsumerIntegrationFieldMapping{}
+	query := client.App.DB.FirstOrInit(&fieldMapping, integrations.ConsumerIntegrationFieldMapping{
+		ConsumerIntegrationID: consumerIntegration.ID,
+		Object:                input.Object.String(),
+		Key:                   input.Key,
+	})
+	if err := query.Error; err != nil {
+		return fmt.Errorf("error finding field mapping %s of consumer integration #%s: %s", input.Key, consumerIntegration.ID.String(), err)
+	}
+
+	fieldMapping.Field = input.Field
+	if err := client.App.DB.Save(&fieldMapping).Error; err != nil {
+		return fmt.Errorf("error saving field mapping %s of consumer integration #%s: %s", input.Key, consumerIntegration.ID.String(), err)
+	}
+
+	return nil
+}
+
+// Deletes the key of the custom fields of the object from the field mapping of the consumer integration,
+// returns false when the key isn't mapped
+func (client *ConnectClient) DeleteFieldMapping(consumerIntegrationID uuid.UUID, object model.CrmObject, key string) (bool, error) {
+	consumerIntegration := integrations.ConsumerIntegration{}
+	if err := client.App.DB.Where("consumer_id = ?", client.ConsumerID).Where("id = ?", consumerIntegrationID).First(&consumerIntegration).Error; err != nil {
+		return false, fmt.Errorf("error finding integration #%s: %s", consumerIntegrationID, err)
+	}
+
+	query := client.App.DB.Where("consumer_integration_id = ?", consumerIntegration.ID).Where("object = ?", object.String()).Where("key = ?", key).Delete(&integrations.ConsumerIntegrationFieldMapping{})
+	if err := query.Error; err != nil {
+		return false, fmt.Errorf("error deleting field mapping %s of consumer integration #%s: %s", key, consumerIntegration.ID.String(), err)
+	}
+
+	return query.RowsAffected > 0, nil
+}
+
 // -------- Private --------
-func findConsumerIntegrationByServiceCode(integrations *[]integrations.ConsumerIntegration, serviceCode string) *integrations.ConsumerIntegration {
-	for _, integration := range *integrations {
-		if integration.ServiceCode == serviceCode {
-			return &integration
+func findConsumerIntegrationsByServiceCode(consumerIntegrations *[]integrations.ConsumerIntegration, serviceCode string) []*integrations.ConsumerIntegration {
+	matchingIntegrations := []*integrations.ConsumerIntegration{}
+	for i := range *consumerIntegrations {
+		if (*consumerIntegrations)[i].ServiceCode == serviceCode {
+			matchingIntegrations = append(matchingIntegrations, &(*consumerIntegrations)[i])
 		}
 	}
 
-	return nil
+	return matchingIntegrations
 }
 
-func findConnectorByServiceCode(serviceCode string) *connectors.Connector {
-	for _, connector := range connectors.AvailableConnectors {
-		if connector.ServiceCode == serviceCode {
-			return &connector
+// Assigns the values of the existing integration to the listed one
+func (client *ConnectClient) assignConsumerIntegration(outputIntegration *model.ConsumerIntegration, consumerIntegration *integrations.ConsumerIntegration) {
+	enabled := consumerIntegration.Enabled
+	isDefault := consumerIntegration.IsDefault
+	ID := consumerIntegration.ID.String()
+	outputIntegration.ID = &ID
+	outputIntegration.Enabled = &enabled
+	outputIntegration.Default = &isDefault
+
+	// the login URL selects the integration to authorize
+	loginUrl := fmt.Sprintf("%s?integrationId=%s", *outputIntegration.LoginURL, ID)
+	outputIntegration.LoginURL = &loginUrl
+
+	clientCredentialsSet := false
+	tokensSet := false
+	oauth2Config, _ := client.loadOAuth2Configuration(consumerIntegration.ID)
+
+	if oauth2Config != nil {
+		clientCredentialsSet = (oauth2Config.ClientID.Raw != "" && oauth2Config.ClientSecret.Raw != "")
+		tokensSet = (oauth2Config.AccessToken.Raw != "" && oauth2Config.RefreshToken.Raw != "")
+	}
+
+	outputIntegration.Oauth2Metadata = &model.OAuth2Metadata{
+		ClientCredentialsSet: clientCredentialsSet,
+		TokensSet:            tokensSet,
+	}
+
+	fieldMappings := []integrations.ConsumerIntegrationFieldMapping{}
+	if err := client.App.DB.Where("consumer_integration_id = ?", consumerIntegration.ID).Order("object ASC, key ASC").Find(&fieldMappings).Error; err != nil {
+		log.Errorf("Error finding field mappings for integration #%s: %s", consumerIntegration.ID, err)
+	}
+
+	for _, fieldMapping := range fieldMappings {
+		outputIntegration.FieldMappings = append(outputIntegration.FieldMappings, &model.FieldMapping{
+			Object: model.CrmObject(fieldMapping.Object),
+			Key:    fieldMapping.Key,
+			Field:  fieldMapping.Field,
+		})
+	}
+}
+
+// Keeps a single default among the enabled integrations of the type,
+// the oldest enabled integration becomes the default when the default is disabled
+func (client *ConnectClient) updateDefaultIntegration(integrationType string) error {
+	return client.App.DB.Transaction(func(tx *gorm.DB) error {
+		typeIntegrations := func() *gorm.DB {
+			return tx.Model(&integrations.ConsumerIntegration{}).Where("consumer_id = ?", client.ConsumerID).Where("type = ?", integrationType)
+		}
+
+		if err := typeIntegrations().Where("enabled = ?", false).Update("is_default", false).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := typeIntegrations().Where("is_default = ?", true).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+
+		defaultIntegration := integrations.ConsumerIntegration{}
+		if err := typeIntegrations().Where("enabled = ?", true).Order("created_at ASC").Limit(1).Find(&defaultIntegration).Error; err != nil {
+			return err
+		}
+		if defaultIntegration.ID == uuid.Nil {
+			return nil
 		}
+
+		return tx.Model(&defaultIntegration).Update("is_default", true).Error
+	})
+}
+
+func findConnectorByServiceCode(serviceCode string) *connectors.Connector {
+	if registration := connectors.Lookup(serviceCode); registration != nil {
+		return &registration.Connector
 	}
 
 	return nil
@@ -234,23 +420,32 @@ func findConnectorByServiceCode(serviceCode string) *connectors.Connector {
 func (client *ConnectClient) createOutputIntegrationFromConnector(connector *connectors.Connector) *model.ConsumerIntegration {
 	loginUrl := client.getLoginUrl(connector.ServiceCode)
 	callbackUrl := client.getCallbackUrl(connector.ServiceCode)
-	var authType model.AuthType
-
-	switch connector.AuthType {
-	case connectors.AUTH_TYPE_OAUTH2:
-		authType = model.AuthTypeOauth2
-	default:
-		authType = model.AuthTypeSecret
+	authTypes := []model.AuthType{}
+	for _, authType := range connector.AuthTypes() {
+		authTypes = append(authTypes, authTypeModel(authType))
 	}
 
 	return &model.ConsumerIntegration{
-		Type:        &connector.Type,
-		ServiceCode: &connector.ServiceCode,
-		ServiceName: &connector.Name,
-		Description: &connector.Description,
-		LoginURL:    &loginUrl,
-		CallbackURL: &callbackUrl,
-		AuthType:    authType,
+		Type:          &connector.Type,
+		ServiceCode:   &connector.ServiceCode,
+		ServiceName:   &connector.Name,
+		Description:   &connector.Description,
+		LoginURL:      &loginUrl,
+		CallbackURL:   &callbackUrl,
+		AuthType:      authTypes[0],
+		AuthTypes:     authTypes,
+		FieldMappings: []*model.FieldMapping{},
+	}
+}
+
+func authTypeModel(authType string) model.AuthType {
+	switch authType {
+	case connectors.AUTH_TYPE_OAUTH2:
+		return model.AuthTypeOauth2
+	case connectors.AUTH_TYPE_NONE:
+		return model.AuthTypeNone
+	default:
+		return model.AuthTypeSecret
 	}
 }
 
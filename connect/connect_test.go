@@ -8,6 +8,7 @@ import (
 	"blendbase/connectors"
 	"blendbase/graph/model"
 	"blendbase/integrations"
+	"blendbase/misc/gormext"
 
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
@@ -42,6 +43,7 @@ func TestMain(m *testing.M) {
 	exitVal := m.Run()
 
 	log.Println("Cleaning up after tests!")
+	app.DB.Where("1 = 1").Delete(&integrations.ConsumerIntegrationFieldMapping{})
 	app.DB.Where("1 = 1").Delete(&integrations.ConsumerOauth2Configuration{})
 	app.DB.Where("1 = 1").Delete(&integrations.ConsumerIntegration{})
 	// app.DB.Where("1 = 1").Delete(&integrations.Consumer{})
@@ -99,8 +101,35 @@ func TestListIntegrationsWithSeededDB(t *testing.T) {
 	assert.NotEmpty(t, *integration.ID, "The integration should have an ID assigned")
 }
 
+func TestListIntegrationsListsRegisteredConnectors(t *testing.T) {
+	integrations, err := connectClient.ListIntegrations()
+	assert.Nil(t, err, "There should be no error")
+
+	registrations := connectors.Registrations()
+	assert.Equal(t, len(registrations), len(integrations), "Every registered connector should be listed")
+	for i, registration := range registrations {
+		assert.Equal(t, registration.ServiceCode, *integrations[i].ServiceCode, "The integrations should be in the order of the connectors")
+	}
+	assert.Equal(t, connectors.CONNECTOR_CRM_SALESFORCE, *integrations[0].ServiceCode, "Salesforce should be listed first")
+	assert.NotEmpty(t, integrations[0].Capabilities.Objects, "The capabilities of the connector should be listed")
+}
+
+func TestListIntegrationsListsHubspotAuthTypes(t *testing.T) {
+	integrations, err := connectClient.ListIntegrations()
+	assert.Nil(t, err, "There should be no error")
+
+	for _, integration := range integrations {
+		if *integration.ServiceCode == connectors.CONNECTOR_CRM_HUBSPOT {
+			assert.Equal(t, model.AuthTypeSecret, integration.AuthType, "The private app tokens should stay the auth type of HubSpot")
+			assert.Equal(t, []model.AuthType{model.AuthTypeSecret, model.AuthTypeOauth2}, integration.AuthTypes, "The public apps should be authorized with OAuth2")
+			return
+		}
+	}
+	t.Error("HubSpot should be listed")
+}
+
 func TestEnableIntegrationWhenNoIntegrationsExistInTheDB(t *testing.T) {
-	_, err := connectClient.EnableIntegration(connectors.CONNECTOR_CRM_HUBSPOT, false)
+	_, err := connectClient.EnableIntegration(connectors.CONNECTOR_CRM_HUBSPOT, false, nil)
 
 	assert.Nil(t, err, "There should be no error")
 
@@ -115,7 +144,7 @@ func TestEnableIntegrationWhenIntegrationsExist(t *testing.T) {
 
 	firstIntegration := addedIntegrations[0]
 	// Both integrations should be disabled after creation
-	_, err := connectClient.EnableIntegration(firstIntegration.ServiceCode, true)
+	_, err := connectClient.EnableIntegration(firstIntegration.ServiceCode, true, nil)
 
 	assert.Nil(t, err, "There should be no error")
 
@@ -126,16 +155,82 @@ func TestEnableIntegrationWhenIntegrationsExist(t *testing.T) {
 	// Now enabling the second integration of the same type
 	secondIntegration := addedIntegrations[1]
 
-	_, err = connectClient.EnableIntegration(secondIntegration.ServiceCode, true)
+	_, err = connectClient.EnableIntegration(secondIntegration.ServiceCode, true, nil)
 	assert.Nil(t, err, "There should be no error")
 
 	app.DB.Model(&integrations.ConsumerIntegration{}).Where("consumer_id = ?", consumer.ID).Where("enabled = ?", true).Count(&count)
-	assert.Equal(t, int64(1), count, "There should be one enabled integration in the database")
+	assert.Equal(t, int64(2), count, "There should be two enabled integrations in the database")
 
-	// The first integration should still be DISABLED
+	// The first integration should still be ENABLED and the DEFAULT one
 	firstIntegrationReloaded := integrations.ConsumerIntegration{}
 	app.DB.Where("id = ?", firstIntegration.ID).First(&firstIntegrationReloaded)
-	assert.False(t, firstIntegration.Enabled, "The first integration should not be disabled")
+	assert.True(t, firstIntegrationReloaded.Enabled, "The first integration should still be enabled")
+	assert.True(t, firstIntegrationReloaded.IsDefault, "The first integration should be the default one")
+
+	secondIntegrationReloaded := integrations.ConsumerIntegration{}
+	app.DB.Where("id = ?", secondIntegration.ID).First(&secondIntegrationReloaded)
+	assert.False(t, secondIntegrationReloaded.IsDefault, "The second integration should not be the default one")
+
+	// Disabling the default integration makes the other enabled integration the default
+	_, err = connectClient.EnableIntegration(firstIntegration.ServiceCode, false, &firstIntegration.ID)
+	assert.Nil(t, err, "There should be no error")
+
+	app.DB.Where("id = ?", secondIntegration.ID).First(&secondIntegrationReloaded)
+	assert.True(t, secondIntegrationReloaded.IsDefault, "The second integration should be the default one")
+}
+
+func TestAddIntegration(t *testing.T) {
+	addedIntegrations := addCrmIntegrations(t, consumer.ID)
+	salesforceIntegration := addedIntegrations[1]
+
+	consumerIntegrationID, err := connectClient.AddIntegration(connectors.CONNECTOR_CRM_SALESFORCE)
+	assert.Nil(t, err, "There should be no error")
+	t.Cleanup(func() {
+		app.DB.Delete(&integrations.ConsumerIntegration{}, consumerIntegrationID)
+	})
+
+	listedIntegrations, _ := connectClient.ListIntegrations()
+	salesforceIDs := []string{}
+	for _, integration := range listedIntegrations {
+		if *integration.ServiceCode == connectors.CONNECTOR_CRM_SALESFORCE {
+			salesforceIDs = append(salesforceIDs, *integration.ID)
+		}
+	}
+	assert.Equal(t, []string{salesforceIntegration.ID.String(), consumerIntegrationID.String()}, salesforceIDs, "Both Salesforce integrations should be listed")
+
+	// Both Salesforce organizations can be enabled at once
+	_, err = connectClient.EnableIntegration(connectors.CONNECTOR_CRM_SALESFORCE, true, &salesforceIntegration.ID)
+	assert.Nil(t, err, "There should be no error")
+	_, err = connectClient.EnableIntegration(connectors.CONNECTOR_CRM_SALESFORCE, true, &consumerIntegrationID)
+	assert.Nil(t, err, "There should be no error")
+
+	var count int64
+	app.DB.Model(&integrations.ConsumerIntegration{}).Where("consumer_id = ?", consumer.ID).Where("service_code = ?", connectors.CONNECTOR_CRM_SALESFORCE).Where("enabled = ?", true).Count(&count)
+	assert.Equal(t, int64(2), count, "There should be two enabled Salesforce integrations in the database")
+}
+
+func TestSetDefaultIntegration(t *testing.T) {
+	addedIntegrations := addCrmIntegrations(t, consumer.ID)
+	firstIntegration := addedIntegrations[0]
+	secondIntegration := addedIntegrations[1]
+
+	_, err := connectClient.SetDefaultIntegration(secondIntegration.ID)
+	assert.NotNil(t, err, "There should be an error for a disabled integration")
+
+	connectClient.EnableIntegration(firstIntegration.ServiceCode, true, &firstIntegration.ID)
+	connectClient.EnableIntegration(secondIntegration.ServiceCode, true, &secondIntegration.ID)
+
+	success, err := connectClient.SetDefaultIntegration(secondIntegration.ID)
+	assert.Nil(t, err, "There should be no error")
+	assert.True(t, success, "The integration should be the default one")
+
+	var count int64
+	app.DB.Model(&integrations.ConsumerIntegration{}).Where("consumer_id = ?", consumer.ID).Where("is_default = ?", true).Count(&count)
+	assert.Equal(t, int64(1), count, "There should be one default integration in the database")
+
+	secondIntegrationReloaded := integrations.ConsumerIntegration{}
+	app.DB.Where("id = ?", secondIntegration.ID).First(&secondIntegrationReloaded)
+	assert.True(t, secondIntegrationReloaded.IsDefault, "The second integration should be the default one")
 }
 
 func TestConfigureOAuth2WhenProvided(t *testing.T) {
@@ -195,6 +290,81 @@ func TestConfigureOAuth2WhenMissingInput(t *testing.T) {
 	app.DB.Where("1 = 1").Delete(&integrations.ConsumerOauth2Configuration{})
 }
 
+func TestConfigureOAuth2ForDynamics(t *testing.T) {
+	testClientID := "test_client_id"
+	testClientSecret := "test_client_secret"
+	testOrgURL := "https://contoso.crm.dynamics.com/"
+
+	dynamicsIntegration := integrations.ConsumerIntegration{
+		ConsumerID:  consumer.ID,
+		Type:        "crm",
+		ServiceCode: connectors.CONNECTOR_CRM_DYNAMICS,
+	}
+	app.DB.Create(&dynamicsIntegration)
+
+	oauth2Settings := model.OAuth2ConfigurationInput{
+		ClientID:     &testClientID,
+		ClientSecret: &testClientSecret,
+	}
+
+	success, err := connectClient.ConfigureOAuth2(dynamicsIntegration.ID, &oauth2Settings)
+	assert.NotNil(t, err, "There should be an error without the organization URL")
+	assert.False(t, success, "The configuration should not be successful")
+
+	oauth2Settings.DynamicsOrgURL = &testOrgURL
+
+	success, err = connectClient.ConfigureOAuth2(dynamicsIntegration.ID, &oauth2Settings)
+	assert.Nil(t, err, "There should be no error")
+	assert.True(t, success, "The configuration should be successful")
+
+	consumerOauth2Configuration := integrations.ConsumerOauth2Configuration{}
+	app.DB.Where("consumer_integration_id = ?", dynamicsIntegration.ID).First(&consumerOauth2Configuration)
+
+	customSettings, err := consumerOauth2Configuration.GetCustomSettings()
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, "https://contoso.crm.dynamics.com", customSettings.DynamicsOrgURL, "The organization URL should be stored without the trailing slash")
+
+	app.DB.Where("1 = 1").Delete(&integrations.ConsumerOauth2Configuration{})
+}
+
+func TestConfigureOAuth2ForZoho(t *testing.T) {
+	testClientID := "test_client_id"
+	testClientSecret := "test_client_secret"
+	testDataCenter := "eu.example.com"
+
+	zohoIntegration := integrations.ConsumerIntegration{
+		ConsumerID:  consumer.ID,
+		Type:        "crm",
+		ServiceCode: connectors.CONNECTOR_CRM_ZOHO,
+	}
+	app.DB.Create(&zohoIntegration)
+
+	oauth2Settings := model.OAuth2ConfigurationInput{
+		ClientID:       &testClientID,
+		ClientSecret:   &testClientSecret,
+		ZohoDataCenter: &testDataCenter,
+	}
+
+	success, err := connectClient.ConfigureOAuth2(zohoIntegration.ID, &oauth2Settings)
+	assert.NotNil(t, err, "There should be an error for an unknown data center")
+	assert.False(t, success, "The configuration should not be successful")
+
+	testDataCenter = "eu"
+
+	success, err = connectClient.ConfigureOAuth2(zohoIntegration.ID, &oauth2Settings)
+	assert.Nil(t, err, "There should be no error")
+	assert.True(t, success, "The configuration should be successful")
+
+	consumerOauth2Configuration := integrations.ConsumerOauth2Configuration{}
+	app.DB.Where("consumer_integration_id = ?", zohoIntegration.ID).First(&consumerOauth2Configuration)
+
+	customSettings, err := consumerOauth2Configuration.GetCustomSettings()
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, "eu", customSettings.ZohoDataCenter, "The data center should be stored")
+
+	app.DB.Where("1 = 1").Delete(&integrations.ConsumerOauth2Configuration{})
+}
+
 func TestCreateConsumer(t *testing.T) {
 	consumerID, err := connectClient.CreateConsumer()
 
@@ -215,3 +385,74 @@ func TestSetConsumerIntegrationSecret(t *testing.T) {
 
 	assert.Equal(t, integration.Secret.Raw, secret, "The secret in the DB should match the one provided")
 }
+
+func TestSetFieldMapping(t *testing.T) {
+	addedIntegrations := addCrmIntegrations(t, consumer.ID)
+	firstIntegration := addedIntegrations[0]
+	t.Cleanup(func() {
+		app.DB.Where("consumer_integration_id = ?", firstIntegration.ID).Delete(&integrations.ConsumerIntegrationFieldMapping{})
+	})
+
+	input := model.FieldMappingInput{Object: model.CrmObjectContact, Key: "score", Field: "hs_score"}
+	err := connectClient.SetFieldMapping(firstIntegration.ID, &input)
+	assert.Nil(t, err, "There should be no error")
+
+	// the field of an existing key is replaced
+	input.Field = "lead_score"
+	err = connectClient.SetFieldMapping(firstIntegration.ID, &input)
+	assert.Nil(t, err, "There should be no error")
+
+	var count int64
+	app.DB.Model(&integrations.ConsumerIntegrationFieldMapping{}).Where("consumer_integration_id = ?", firstIntegration.ID).Count(&count)
+	assert.Equal(t, int64(1), count, "There should be one field mapping in the database")
+
+	fieldMapping, err := connectors.LoadFieldMapping(app, firstIntegration.ID)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, "lead_score", fieldMapping[model.CrmObjectContact]["score"], "The key should be mapped to the new field")
+
+	err = connectClient.SetFieldMapping(firstIntegration.ID, &model.FieldMappingInput{Object: model.CrmObjectCompany, Key: "score", Field: "score"})
+	assert.NotNil(t, err, "There should be an error for an object without custom fields")
+
+	err = connectClient.SetFieldMapping(firstIntegration.ID, &model.FieldMappingInput{Object: model.CrmObjectContact, Key: "score", Field: "score; DROP"})
+	assert.NotNil(t, err, "There should be an error for an invalid field")
+
+	err = connectClient.SetFieldMapping(uuid.New(), &input)
+	assert.NotNil(t, err, "There should be an error for an integration of another consumer")
+
+	deleted, err := connectClient.DeleteFieldMapping(firstIntegration.ID, model.CrmObjectContact, "score")
+	assert.Nil(t, err, "There should be no error")
+	assert.True(t, deleted, "The field mapping should be deleted")
+
+	deleted, err = connectClient.DeleteFieldMapping(firstIntegration.ID, model.CrmObjectContact, "score")
+	assert.Nil(t, err, "There should be no error")
+	assert.False(t, deleted, "There should be no field mapping left to delete")
+}
+
+// The disabled integrations with credentials are queried by crm.all
+func TestListConnectedIntegrations(t *testing.T) {
+	addedIntegrations := addCrmIntegrations(t, consumer.ID)
+	hubspotIntegration, salesforceIntegration := addedIntegrations[0], addedIntegrations[1]
+
+	oauth2Configuration := integrations.ConsumerOauth2Configuration{
+		ConsumerIntegrationID: salesforceIntegration.ID,
+		AccessToken:           gormext.EncryptedValue{Raw: "test_access_token"},
+		RefreshToken:          gormext.EncryptedValue{Raw: "test_refresh_token"},
+	}
+	app.DB.Create(&oauth2Configuration)
+	t.Cleanup(func() {
+		app.DB.Delete(&oauth2Configuration)
+	})
+
+	connectedIntegrations, err := connectClient.ListConnectedIntegrations("crm")
+	assert.Nil(t, err, "There should be no error")
+	assert.Len(t, connectedIntegrations, 1, "Only the integration with tokens should be connected")
+	assert.Equal(t, salesforceIntegration.ID, connectedIntegrations[0].ID, "The disabled integration with tokens should be connected")
+	assert.False(t, connectedIntegrations[0].Enabled, "The integration should stay disabled")
+
+	err = connectClient.SetConsumerIntegrationSecret(hubspotIntegration.ID, "test_secret")
+	assert.Nil(t, err, "There should be no error")
+
+	connectedIntegrations, err = connectClient.ListConnectedIntegrations("crm")
+	assert.Nil(t, err, "There should be no error")
+	assert.Len(t, connectedIntegrations, 2, "The integration with a secret should be connected")
+}
@@ -0,0 +1,12 @@
+// Package all loads every connector, importing it registers the connectors in blendbase/connectors.
+// A new connector is added by importing its package here.
+package all
+
+import (
+	_ "blendbase/connectors/dynamics"
+	_ "blendbase/connectors/hubspot"
+	_ "blendbase/connectors/pipedrive"
+	_ "blendbase/connectors/salesforce"
+	_ "blendbase/connectors/sandbox"
+	_ "blendbase/connectors/zoho"
+)
@@ -0,0 +1,74 @@
+package connectors
+
+import (
+	"blendbase/graph/model"
+)
+
+// Operations of the objects as they're supported by the connectors with every object mapped,
+// the connectors list the objects they support with the fields they map, e.g.
+//
+//	connectors.ObjectCapabilities(model.CrmObjectContact, "id", "firstName", "lastName")
+var DefaultOperations = map[model.CrmObject][]model.CrmOperation{
+	model.CrmObjectContact:     {model.CrmOperationList, model.CrmOperationGet, model.CrmOperationCreate, model.CrmOperationUpdate, model.CrmOperationDelete, model.CrmOperationLink},
+	model.CrmObjectOpportunity: {model.CrmOperationList, model.CrmOperationGet, model.CrmOperationCreate, model.CrmOperationUpdate, model.CrmOperationDelete, model.CrmOperationLink},
+	model.CrmObjectCompany:     {model.CrmOperationList, model.CrmOperationGet, model.CrmOperationCreate, model.CrmOperationUpdate, model.CrmOperationDelete},
+	model.CrmObjectLead:        {model.CrmOperationList, model.CrmOperationGet, model.CrmOperationCreate, model.CrmOperationUpdate, model.CrmOperationDelete, model.CrmOperationConvert},
+	model.CrmObjectUser:        {model.CrmOperationList, model.CrmOperationGet},
+	model.CrmObjectNote:        {model.CrmOperationList, model.CrmOperationCreate},
+	model.CrmObjectTask:        {model.CrmOperationList, model.CrmOperationCreate, model.CrmOperationUpdate},
+	model.CrmObjectActivity:    {model.CrmOperationList, model.CrmOperationCreate},
+	model.CrmObjectPipeline:    {model.CrmOperationList},
+	model.CrmObjectChange:      {model.CrmOperationList},
+}
+
+// Fields of the pipelines and the changes, they're the same for all the connectors
+var (
+	PipelineFields = []string{"id", "label", "displayOrder", "stages"}
+	ChangeFields   = []string{"objectType", "objectId", "changeType", "changedAt"}
+)
+
+// Capabilities of an object with the default operations
+func ObjectCapabilities(object model.CrmObject, fields ...string) *model.ObjectCapabilities {
+	return &model.ObjectCapabilities{
+		Object:     object,
+		Operations: DefaultOperations[object],
+		Fields:     fields,
+	}
+}
+
+// Capabilities of an object that supports only some of the operations
+func ObjectOperationCapabilities(object model.CrmObject, operations []model.CrmOperation, fields ...string) *model.ObjectCapabilities {
+	return &model.ObjectCapabilities{
+		Object:     object,
+		Operations: operations,
+		Fields:     fields,
+	}
+}
+
+// Capabilities of the connector for the Connect and Omni APIs
+func (registration *Registration) CapabilitiesModel() *model.Capabilities {
+	objects := make([]*model.ObjectCapabilities, len(registration.Capabilities))
+	copy(objects, registration.Capabilities)
+
+	return &model.Capabilities{
+		ServiceCode: registration.ServiceCode,
+		Objects:     objects,
+	}
+}
+
+// Returns true when the connector supports the operation on the object
+func (registration *Registration) Supports(object model.CrmObject, operation model.CrmOperation) bool {
+	for _, objectCapabilities := range registration.Capabilities {
+		if objectCapabilities.Object != object {
+			continue
+		}
+
+		for _, supportedOperation := range objectCapabilities.Operations {
+			if supportedOperation == operation {
+				return true
+			}
+		}
+	}
+
+	return false
+}
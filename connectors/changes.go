@@ -0,0 +1,247 @@
+package connectors
+
+import (
+	"blendbase/graph/model"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Position in the change feed. Changes are ordered by the change time, the object type and the object ID,
+// so the cursor stays valid as long as the CRM keeps the changes and can be stored to resume the feed later.
+type ChangeCursor struct {
+	Since      time.Time              `json:"since"`
+	ChangedAt  time.Time              `json:"changedAt"`
+	ObjectType model.ChangeObjectType `json:"objectType"`
+	ObjectID   string                 `json:"objectId"`
+}
+
+// Arguments of the change feed query
+type ChangesParams struct {
+	Since       time.Time
+	After       *ChangeCursor
+	First       int
+	ObjectTypes []model.ChangeObjectType
+}
+
+// Lower bound of the changes of an object type that are left in the feed
+type ChangeBound struct {
+	Time      time.Time
+	After     *string // changes at the bound time have to have a greater object ID
+	Exclusive bool    // changes at the bound time are excluded
+}
+
+// Changes read from a single source, e.g. the updated contacts.
+// HasMore is set when the source has more changes than the batch has.
+type ChangeBatch struct {
+	Changes []*model.Change
+	HasMore bool
+}
+
+func NewChangesParams(since *time.Time, first int, after *string, objectTypes []model.ChangeObjectType) (*ChangesParams, error) {
+	params := ChangesParams{First: first, ObjectTypes: objectTypes}
+	if len(params.ObjectTypes) == 0 {
+		params.ObjectTypes = model.AllChangeObjectType
+	}
+
+	if after != nil {
+		cursor := ChangeCursor{}
+		if err := json.Unmarshal([]byte(DecodeCursor(*after)), &cursor); err != nil || cursor.ObjectID == "" {
+			return nil, errors.New("invalid cursor")
+		}
+
+		// the cursor keeps the start of the feed
+		params.After = &cursor
+		params.Since = cursor.Since
+	} else if since != nil {
+		params.Since = since.UTC()
+	} else {
+		return nil, errors.New("since or after is required")
+	}
+
+	return &params, nil
+}
+
+func (params *ChangesParams) Includes(objectType model.ChangeObjectType) bool {
+	for _, includedType := range params.ObjectTypes {
+		if includedType == objectType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lower bound of the changes of the object type, it's up to the connectors to translate it to a query
+func (params *ChangesParams) Bound(objectType model.ChangeObjectType) ChangeBound {
+	if params.After == nil {
+		return ChangeBound{Time: params.Since}
+	}
+
+	bound := ChangeBound{Time: params.After.ChangedAt}
+	switch {
+	case objectType == params.After.ObjectType:
+		bound.After = &params.After.ObjectID
+	case objectType < params.After.ObjectType:
+		bound.Exclusive = true
+	}
+
+	return bound
+}
+
+// Records created since the start of the feed are new to the client, the others are updated
+func (params *ChangesParams) ChangeType(createdAt *time.Time) model.ChangeType {
+	if createdAt != nil && !createdAt.Before(params.Since) {
+		return model.ChangeTypeCreated
+	}
+
+	return model.ChangeTypeUpdated
+}
+
+// Change of a created or updated record
+func (params *ChangesParams) NewChange(objectType model.ChangeObjectType, objectId string, createdAt *time.Time, updatedAt *time.Time) *model.Change {
+	change := model.Change{
+		ObjectType: objectType,
+		ObjectID:   objectId,
+		ChangeType: params.ChangeType(createdAt),
+	}
+
+	if updatedAt != nil {
+		change.ChangedAt = *updatedAt
+	} else if createdAt != nil {
+		change.ChangedAt = *createdAt
+	}
+
+	return &change
+}
+
+// Merges the batches into a page of the feed. Sources may return changes that are before the cursor,
+// they're dropped, and a page never goes past the last change of a batch that has more changes.
+func NewChangeConnection(params *ChangesParams, batches []*ChangeBatch) *model.ChangeConnection {
+	changes := []*model.Change{}
+	var bound *model.Change
+	for _, batch := range batches {
+		for _, change := range batch.Changes {
+			if params.isLeft(change) {
+				changes = append(changes, change)
+			}
+		}
+
+		if batch.HasMore && len(batch.Changes) > 0 {
+			last := batch.Changes[len(batch.Changes)-1]
+			for _, change := range batch.Changes {
+				if CompareChanges(change, last) > 0 {
+					last = change
+				}
+			}
+
+			if bound == nil || CompareChanges(last, bound) < 0 {
+				bound = last
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return CompareChanges(changes[i], changes[j]) < 0
+	})
+
+	hasNextPage := bound != nil
+	if bound != nil {
+		end := sort.Search(len(changes), func(i int) bool {
+			return CompareChanges(changes[i], bound) > 0
+		})
+		changes = changes[:end]
+	}
+	if len(changes) > params.First {
+		changes = changes[:params.First]
+		hasNextPage = true
+	}
+
+	edges := make([]*model.ChangeEdge, len(changes))
+	for i, change := range changes {
+		edges[i] = &model.ChangeEdge{
+			Node:   change,
+			Cursor: params.cursor(change),
+		}
+	}
+
+	pageInfo := &model.PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: params.After != nil,
+	}
+
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.ChangeConnection{
+		Edges:    edges,
+		PageInfo: pageInfo,
+	}
+}
+
+// Orders changes by the change time, the object type and the object ID
+func CompareChanges(a *model.Change, b *model.Change) int {
+	if !a.ChangedAt.Equal(b.ChangedAt) {
+		if a.ChangedAt.Before(b.ChangedAt) {
+			return -1
+		}
+		return 1
+	}
+
+	if a.ObjectType != b.ObjectType {
+		return strings.Compare(string(a.ObjectType), string(b.ObjectType))
+	}
+
+	return CompareObjectIDs(a.ObjectID, b.ObjectID)
+}
+
+// Numeric IDs are compared as numbers, the way CRMs using them order the records
+func CompareObjectIDs(a string, b string) int {
+	aNumber, aErr := strconv.ParseInt(a, 10, 64)
+	bNumber, bErr := strconv.ParseInt(b, 10, 64)
+	if aErr != nil || bErr != nil {
+		return strings.Compare(a, b)
+	}
+
+	switch {
+	case aNumber < bNumber:
+		return -1
+	case aNumber > bNumber:
+		return 1
+	}
+
+	return 0
+}
+
+// Returns true when the change is left in the feed
+func (params *ChangesParams) isLeft(change *model.Change) bool {
+	if change.ChangedAt.Before(params.Since) {
+		return false
+	}
+
+	if params.After == nil {
+		return true
+	}
+
+	return CompareChanges(change, &model.Change{
+		ChangedAt:  params.After.ChangedAt,
+		ObjectType: params.After.ObjectType,
+		ObjectID:   params.After.ObjectID,
+	}) > 0
+}
+
+func (params *ChangesParams) cursor(change *model.Change) string {
+	encoded, _ := json.Marshal(ChangeCursor{
+		Since:      params.Since,
+		ChangedAt:  change.ChangedAt,
+		ObjectType: change.ObjectType,
+		ObjectID:   change.ObjectID,
+	})
+
+	return EncodeCursor(string(encoded))
+}
@@ -4,16 +4,27 @@ import (
 	"blendbase/graph/model"
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 )
 
 const (
 	CONNECTOR_TYPE_CRM       = "crm"
 	CONNECTOR_CRM_SALESFORCE = "crm_salesforce"
 	CONNECTOR_CRM_HUBSPOT    = "crm_hubspot"
+	CONNECTOR_CRM_PIPEDRIVE  = "crm_pipedrive"
+	CONNECTOR_CRM_DYNAMICS   = "crm_dynamics"
+	CONNECTOR_CRM_ZOHO       = "crm_zoho"
+	CONNECTOR_CRM_SANDBOX    = "crm_sandbox"
 
 	AUTH_TYPE_OAUTH2 = "oauth2"
 	AUTH_TYPE_SECRET = "secret"
+	AUTH_TYPE_NONE   = "none"
 )
 
 type Connector struct {
@@ -21,27 +32,28 @@ type Connector struct {
 	Type        string // e.g. "crm"
 	Name        string // e.g "Salesforce"
 	Description string
-	AuthType    string // e.g. "oauth2" or "secret"
-}
-
-var AvailableConnectors = [...]Connector{
-	{
-		ServiceCode: CONNECTOR_CRM_SALESFORCE,
-		Type:        CONNECTOR_TYPE_CRM,
-		Name:        "Salesforce",
-		Description: "Salesforce is the world’s #1 customer relationship management (CRM) platform.",
-		AuthType:    AUTH_TYPE_OAUTH2,
-	},
-	{
-		ServiceCode: CONNECTOR_CRM_HUBSPOT,
-		Type:        CONNECTOR_TYPE_CRM,
-		Name:        "Hubspot",
-		Description: "HubSpot’s CRM platform also offers enterprise software for marketing, sales, customer service, content management, and operations.",
-		AuthType:    AUTH_TYPE_SECRET,
-	},
-}
-
-// Takes a struct and returns a slice of its field names
+	AuthType    string // e.g. "oauth2", "secret" or "none"
+
+	// other auth types the integrations can use, e.g. the OAuth2 of the HubSpot public apps next to the secret of the private apps
+	OtherAuthTypes []string
+}
+
+// Every auth type the integrations of the connector can use, AuthType first
+func (connector *Connector) AuthTypes() []string {
+	return append([]string{connector.AuthType}, connector.OtherAuthTypes...)
+}
+
+func (connector *Connector) SupportsAuthType(authType string) bool {
+	for _, supportedAuthType := range connector.AuthTypes() {
+		if supportedAuthType == authType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Takes a struct and returns a slice of its field names, without the fields that aren't decoded from JSON
 func StructFieldNames(iface interface{}) []string {
 	fields := make([]string, 0)
 	ifv := reflect.Indirect(reflect.ValueOf(iface))
@@ -49,6 +61,9 @@ func StructFieldNames(iface interface{}) []string {
 
 	for i := 0; i < ift.NumField(); i++ {
 		v := ifv.Type().Field(i)
+		if v.Tag.Get("json") == "-" {
+			continue
+		}
 		fields = append(fields, v.Name)
 	}
 
@@ -56,7 +71,7 @@ func StructFieldNames(iface interface{}) []string {
 }
 
 type CrmConnector interface {
-	ListContacts(ctx context.Context, first int, after *string) (*model.ContactConnection, error)
+	ListContacts(ctx context.Context, params *ListParams) (*model.ContactConnection, error)
 	GetContact(ctx context.Context, contactId string) (*model.Contact, error)
 	CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error)
 	UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error)
@@ -64,13 +79,52 @@ type CrmConnector interface {
 	ListContactNotes(ctx context.Context, contactId string) ([]*model.Note, error)
 	CreateContactNote(ctx context.Context, contactId string, input *model.NoteInput) (*model.Note, error)
 
-	ListOpportunities(ctx context.Context, first int, after *string) (*model.OpportunityConnection, error)
+	ListOpportunities(ctx context.Context, params *ListParams) (*model.OpportunityConnection, error)
 	GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error)
 	CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error)
 	UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error)
 	DeleteOpportunity(ctx context.Context, opportunityId string) (bool, error)
 	ListOpportunityNotes(ctx context.Context, opportunityId string) ([]*model.Note, error)
 	CreateOpportunityNote(ctx context.Context, opportunityId string, input *model.NoteInput) (*model.Note, error)
+	ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error)
+
+	ListCompanies(ctx context.Context, params *ListParams) (*model.CompanyConnection, error)
+	GetCompany(ctx context.Context, companyId string) (*model.Company, error)
+	CreateCompany(ctx context.Context, input *model.CompanyInput) (*model.Company, error)
+	UpdateCompany(ctx context.Context, companyId string, input *model.CompanyInput) (bool, error)
+	DeleteCompany(ctx context.Context, companyId string) (bool, error)
+	ListCompanyContacts(ctx context.Context, companyId string) ([]*model.Contact, error)
+	ListCompanyOpportunities(ctx context.Context, companyId string) ([]*model.Opportunity, error)
+
+	LinkContactToCompany(ctx context.Context, contactId string, companyId string) (bool, error)
+	UnlinkContactFromCompany(ctx context.Context, contactId string, companyId string) (bool, error)
+	LinkOpportunityToCompany(ctx context.Context, opportunityId string, companyId string) (bool, error)
+	UnlinkOpportunityFromCompany(ctx context.Context, opportunityId string, companyId string) (bool, error)
+
+	ListLeads(ctx context.Context, params *ListParams) (*model.LeadConnection, error)
+	GetLead(ctx context.Context, leadId string) (*model.Lead, error)
+	CreateLead(ctx context.Context, input *model.LeadInput) (*model.Lead, error)
+	UpdateLead(ctx context.Context, leadId string, input *model.LeadInput) (bool, error)
+	DeleteLead(ctx context.Context, leadId string) (bool, error)
+	ConvertLead(ctx context.Context, leadId string, input *model.LeadConversionInput) (*model.LeadConversionResult, error)
+
+	ListContactTasks(ctx context.Context, contactId string) ([]*model.Task, error)
+	CreateContactTask(ctx context.Context, contactId string, input *model.TaskInput) (*model.Task, error)
+	ListOpportunityTasks(ctx context.Context, opportunityId string) ([]*model.Task, error)
+	CreateOpportunityTask(ctx context.Context, opportunityId string, input *model.TaskInput) (*model.Task, error)
+	UpdateTask(ctx context.Context, taskId string, input *model.TaskInput) (bool, error)
+
+	ListContactActivities(ctx context.Context, contactId string) ([]*model.Activity, error)
+	CreateContactActivity(ctx context.Context, contactId string, input *model.ActivityInput) (*model.Activity, error)
+	ListOpportunityActivities(ctx context.Context, opportunityId string) ([]*model.Activity, error)
+	CreateOpportunityActivity(ctx context.Context, opportunityId string, input *model.ActivityInput) (*model.Activity, error)
+
+	ListUsers(ctx context.Context, params *ListParams) (*model.UserConnection, error)
+	GetUser(ctx context.Context, userId string) (*model.User, error)
+
+	ListPipelines(ctx context.Context) ([]*model.Pipeline, error)
+
+	ListChanges(ctx context.Context, params *ChangesParams) (*model.ChangeConnection, error)
 }
 
 func EncodeCursor(cursor string) string {
@@ -81,3 +135,125 @@ func DecodeCursor(cursor string) string {
 	decoded, _ := base64.StdEncoding.DecodeString(cursor)
 	return string(decoded)
 }
+
+// Cursor of a record in a list sorted by other fields than ID,
+// it holds the values of the sort fields of the record and its ID as the tie-breaker
+type SortCursor struct {
+	Values []*string `json:"values"`
+	ID     string    `json:"id"`
+}
+
+func EncodeSortCursor(values []*string, id string) string {
+	encoded, _ := json.Marshal(SortCursor{Values: values, ID: id})
+	return EncodeCursor(string(encoded))
+}
+
+func DecodeSortCursor(cursor string, sortFieldsCount int) (*SortCursor, error) {
+	sortCursor := SortCursor{}
+	if err := json.Unmarshal([]byte(DecodeCursor(cursor)), &sortCursor); err != nil || len(sortCursor.Values) != sortFieldsCount {
+		return nil, errors.New("invalid cursor for the requested order")
+	}
+
+	return &sortCursor, nil
+}
+
+// Returns true when the list is sorted in the descending order of the field
+func IsDescending(sort *model.SortInput) bool {
+	return sort.Direction != nil && *sort.Direction == model.SortDirectionDesc
+}
+
+// Orders activities from the most recent one, activities without a start time go last
+func SortActivities(activities []*model.Activity) {
+	sort.SliceStable(activities, func(i, j int) bool {
+		if activities[j].StartTime == nil {
+			return activities[i].StartTime != nil
+		}
+		if activities[i].StartTime == nil {
+			return false
+		}
+
+		return activities[i].StartTime.After(*activities[j].StartTime)
+	})
+}
+
+// Unified fields of the users that are sorted in memory by the connectors listing all the users at once
+var userSortFields = map[string]func(a, b *model.User) int{
+	"name":      func(a, b *model.User) int { return compareStrings(a.Name, b.Name) },
+	"firstName": func(a, b *model.User) int { return compareStrings(a.FirstName, b.FirstName) },
+	"lastName":  func(a, b *model.User) int { return compareStrings(a.LastName, b.LastName) },
+	"email":     func(a, b *model.User) int { return compareStrings(a.Email, b.Email) },
+	"createdAt": func(a, b *model.User) int { return compareTimes(a.CreatedAt, b.CreatedAt) },
+	"updatedAt": func(a, b *model.User) int { return compareTimes(a.UpdatedAt, b.UpdatedAt) },
+}
+
+// Sorts the users by the fields of the order, nulls go first in the ascending order and last in the descending order
+func SortUsers(users []*model.User, orderBy []*model.SortInput) error {
+	for _, sortInput := range orderBy {
+		if _, ok := userSortFields[sortInput.Field]; !ok {
+			return fmt.Errorf("sorting by %s is not supported", sortInput.Field)
+		}
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		for _, sortInput := range orderBy {
+			comparison := userSortFields[sortInput.Field](users[i], users[j])
+			if IsDescending(sortInput) {
+				comparison = -comparison
+			}
+			if comparison != 0 {
+				return comparison < 0
+			}
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+func compareStrings(a, b *string) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	return strings.Compare(*a, *b)
+}
+
+func compareTimes(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	case a.Before(*b):
+		return -1
+	case a.After(*b):
+		return 1
+	}
+
+	return 0
+}
+
+// Finds the stage in the given pipeline, or in any pipeline when the pipeline is unknown
+func FindPipelineStage(pipelines []*model.Pipeline, pipelineId *string, stageId string) *model.PipelineStage {
+	for _, pipeline := range pipelines {
+		if pipelineId != nil && *pipelineId != "" && pipeline.ID != *pipelineId {
+			continue
+		}
+
+		for _, stage := range pipeline.Stages {
+			if stage.ID == stageId {
+				return stage
+			}
+		}
+	}
+
+	return nil
+}
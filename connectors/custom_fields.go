@@ -0,0 +1,184 @@
+package connectors
+
+import (
+	"blendbase/config"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Objects of which the records have custom fields
+var CustomFieldsObjects = []model.CrmObject{model.CrmObjectContact, model.CrmObjectOpportunity}
+
+var (
+	customFieldKeyPattern  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	customFieldNamePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.]*$`) // the names end up in the queries of the CRMs
+)
+
+// Fields of the CRM returned in the custom fields of the records of an integration, by object and by the keys of the consumer, e.g.
+//
+//	connectors.FieldMapping{model.CrmObjectContact: {"score": "Custom_Score__c"}}
+type FieldMapping map[model.CrmObject]map[string]string
+
+// Loads the field mapping of the integration
+func LoadFieldMapping(app *config.App, integrationID uuid.UUID) (FieldMapping, error) {
+	var fieldMappings []integrations.ConsumerIntegrationFieldMapping
+	if err := app.DB.Where("consumer_integration_id = ?", integrationID).Find(&fieldMappings).Error; err != nil {
+		return nil, err
+	}
+
+	mapping := FieldMapping{}
+	for _, fieldMapping := range fieldMappings {
+		object := model.CrmObject(fieldMapping.Object)
+		if mapping[object] == nil {
+			mapping[object] = map[string]string{}
+		}
+		mapping[object][fieldMapping.Key] = fieldMapping.Field
+	}
+
+	return mapping, nil
+}
+
+// Validates a field of a field mapping before it's stored
+func ValidateFieldMapping(object model.CrmObject, key string, field string) error {
+	supported := false
+	for _, customFieldsObject := range CustomFieldsObjects {
+		if object == customFieldsObject {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf("custom fields are not supported for %s", object)
+	}
+
+	if !customFieldKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid key %q. must start with a letter and contain only letters, digits and underscores", key)
+	}
+
+	if !customFieldNamePattern.MatchString(field) {
+		return fmt.Errorf("invalid field %q. must contain only letters, digits, underscores and dots", field)
+	}
+
+	return nil
+}
+
+// Names of the fields of the CRM to fetch for the custom fields of the object, sorted and without duplicates
+func (mapping FieldMapping) Fields(object model.CrmObject) []string {
+	fields := []string{}
+	seen := map[string]bool{}
+	for _, field := range mapping[object] {
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// Fields of a query of the object followed by the mapped fields that aren't among them, the names are compared
+// case-insensitively since the APIs reject the duplicate fields
+func (mapping FieldMapping) QueryFields(object model.CrmObject, fields []string) []string {
+	queryFields := append([]string{}, fields...)
+	for _, field := range mapping.Fields(object) {
+		selected := false
+		for _, queryField := range queryFields {
+			if strings.EqualFold(queryField, field) {
+				selected = true
+				break
+			}
+		}
+
+		if !selected {
+			queryFields = append(queryFields, field)
+		}
+	}
+
+	return queryFields
+}
+
+// Custom fields of a record from its fields as they're returned by the CRM, nil when no field of the object is mapped.
+// The fields of the related records are looked up by their paths, e.g. "Account.Industry"
+func (mapping FieldMapping) CustomFields(object model.CrmObject, rawFields map[string]interface{}) map[string]interface{} {
+	if len(mapping[object]) == 0 {
+		return nil
+	}
+
+	customFields := make(map[string]interface{}, len(mapping[object]))
+	for key, field := range mapping[object] {
+		customFields[key] = rawFieldValue(rawFields, field)
+	}
+
+	return customFields
+}
+
+func rawFieldValue(rawFields map[string]interface{}, field string) interface{} {
+	if value, found := rawFields[field]; found {
+		return value
+	}
+
+	path := strings.SplitN(field, ".", 2)
+	if len(path) != 2 {
+		return nil
+	}
+
+	related, ok := rawFields[path[0]].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return rawFieldValue(related, path[1])
+}
+
+// Fields of the CRM to set from the custom fields of an input, the keys of the mapping are replaced by their fields
+// and the other keys are sent to the CRM as they are
+func (mapping FieldMapping) CrmFields(object model.CrmObject, customFields map[string]interface{}) map[string]interface{} {
+	fields := make(map[string]interface{}, len(customFields))
+	for key, value := range customFields {
+		if field, mapped := mapping[object][key]; mapped {
+			fields[field] = value
+		} else {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
+
+// Adds the fields to the JSON object of a create or update payload, the fields of the payload take precedence
+func MergePayload(payload interface{}, fields map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := map[string]interface{}{}
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+
+	for field, value := range fields {
+		if _, set := merged[field]; !set {
+			merged[field] = value
+		}
+	}
+
+	return merged, nil
+}
+
+// Decodes the JSON of a record of a CRM into the struct of the connector and into the target of the raw fields,
+// e.g. a map of the fields for the custom fields of the record
+func UnmarshalWithRawFields(data []byte, record interface{}, rawFields interface{}) error {
+	if err := json.Unmarshal(data, record); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, rawFields)
+}
@@ -0,0 +1,96 @@
+package connectors
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// How long the descriptions of the objects are cached, the fields of the CRMs rarely change
+const DESCRIPTION_CACHE_TTL = 15 * time.Minute
+
+// Connectors that describe the fields of the objects in the CRM, the objects are listed with
+// the DESCRIBE operation in the capabilities of the connector
+type ObjectDescriber interface {
+	DescribeObject(ctx context.Context, object model.CrmObject) (*model.ObjectDescription, error)
+}
+
+// Descriptions of the objects of the integrations, shared by the requests of the Omni API
+var Descriptions = NewDescriptionCache(DESCRIPTION_CACHE_TTL)
+
+type descriptionCacheKey struct {
+	IntegrationID uuid.UUID
+	Object        model.CrmObject
+}
+
+type descriptionCacheEntry struct {
+	Description *model.ObjectDescription
+	ExpiresAt   time.Time
+}
+
+// Descriptions of the objects by integration, the failed descriptions aren't cached
+type DescriptionCache struct {
+	TTL time.Duration
+
+	mutex   sync.Mutex
+	entries map[descriptionCacheKey]descriptionCacheEntry
+	now     func() time.Time
+}
+
+func NewDescriptionCache(ttl time.Duration) *DescriptionCache {
+	return &DescriptionCache{
+		TTL:     ttl,
+		entries: map[descriptionCacheKey]descriptionCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// Returns the cached description of the object of the integration, describes it with the connector when it's not cached or expired
+func (cache *DescriptionCache) Describe(ctx context.Context, integrationID uuid.UUID, object model.CrmObject, describer ObjectDescriber) (*model.ObjectDescription, error) {
+	key := descriptionCacheKey{IntegrationID: integrationID, Object: object}
+
+	cache.mutex.Lock()
+	entry, found := cache.entries[key]
+	cache.mutex.Unlock()
+
+	if found && cache.now().Before(entry.ExpiresAt) {
+		return entry.Description, nil
+	}
+
+	// the lock isn't held while the CRM is called, concurrent requests may describe the object twice
+	description, err := describer.DescribeObject(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mutex.Lock()
+	cache.entries[key] = descriptionCacheEntry{Description: description, ExpiresAt: cache.now().Add(cache.TTL)}
+	cache.mutex.Unlock()
+
+	return description, nil
+}
+
+// Drops the descriptions of the integration, e.g. when it's connected to another account of the CRM
+func (cache *DescriptionCache) Invalidate(integrationID uuid.UUID) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	for key := range cache.entries {
+		if key.IntegrationID == integrationID {
+			delete(cache.entries, key)
+		}
+	}
+}
+
+// Adds the operations to the capabilities of the object, e.g.
+//
+//	connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectContact, "id"), model.CrmOperationDescribe)
+func WithOperations(capabilities *model.ObjectCapabilities, operations ...model.CrmOperation) *model.ObjectCapabilities {
+	// the default operations are shared by the connectors, they're copied before they're extended
+	capabilities.Operations = append(append([]model.CrmOperation{}, capabilities.Operations...), operations...)
+
+	return capabilities
+}
@@ -0,0 +1,177 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ACTIVITY_POINTERS_ENTITY_SET = "activitypointers"
+)
+
+// Dynamics activity type of the unified activity types and the entity set the activities are created in
+type d365ActivityType struct {
+	TypeCode  string
+	EntitySet string
+}
+
+var d365ActivityTypes = map[model.ActivityType]d365ActivityType{
+	model.ActivityTypeCall:    {TypeCode: "phonecall", EntitySet: "phonecalls"},
+	model.ActivityTypeMeeting: {TypeCode: "appointment", EntitySet: "appointments"},
+	model.ActivityTypeEmail:   {TypeCode: "email", EntitySet: "emails"},
+}
+
+type D365ActivitiesListResponse struct {
+	D365ListResponseBase
+	Value []D365Activity `json:"value"`
+}
+
+// Activity pointers hold the common fields of all the activity types
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/activitypointer
+type D365Activity struct {
+	ID             string  `json:"activityid"`
+	TypeCode       string  `json:"activitytypecode"`
+	Subject        *string `json:"subject"`
+	Description    *string `json:"description"`
+	ScheduledStart *string `json:"scheduledstart"`
+	ScheduledEnd   *string `json:"scheduledend"`
+	ActualStart    *string `json:"actualstart"`
+	ActualEnd      *string `json:"actualend"`
+	CreatedOn      *string `json:"createdon"`
+	ModifiedOn     *string `json:"modifiedon"`
+}
+
+func (client *Client) ListContactActivities(ctx context.Context, contactId string) ([]*model.Activity, error) {
+	return client.listActivities(contactId)
+}
+
+func (client *Client) CreateContactActivity(ctx context.Context, contactId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivity(CONTACTS_ENTITY_SET, "contact", contactId, input)
+}
+
+func (client *Client) ListOpportunityActivities(ctx context.Context, opportunityId string) ([]*model.Activity, error) {
+	return client.listActivities(opportunityId)
+}
+
+func (client *Client) CreateOpportunityActivity(ctx context.Context, opportunityId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivity(OPPORTUNITIES_ENTITY_SET, "opportunity", opportunityId, input)
+}
+
+// Lists the calls, the meetings and the emails regarding the record, tasks are listed on their own
+func (client *Client) listActivities(objectId string) ([]*model.Activity, error) {
+	id, err := parseD365ID(objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	typeConditions := []string{}
+	for _, activityType := range model.AllActivityType {
+		typeConditions = append(typeConditions, fmt.Sprintf("activitytypecode eq '%s'", d365ActivityTypes[activityType].TypeCode))
+	}
+	filter := fmt.Sprintf("_regardingobjectid_value eq %s and (%s)", id, strings.Join(typeConditions, " or "))
+
+	response := D365ActivitiesListResponse{}
+	if err := client.listWithFilter(d365ActivityEntity(ACTIVITY_POINTERS_ENTITY_SET), filter, "", &response); err != nil {
+		return nil, err
+	}
+
+	activities := []*model.Activity{}
+	for i := range response.Value {
+		if activity := response.Value[i].mapActivityProperties(); activity != nil {
+			activities = append(activities, activity)
+		}
+	}
+	connectors.SortActivities(activities)
+
+	return activities, nil
+}
+
+// Activities are created in the entity set of their type, the regarding record is bound by a property of the type
+func (client *Client) createActivity(entitySet string, logicalName string, objectId string, input *model.ActivityInput) (*model.Activity, error) {
+	activityType, ok := d365ActivityTypes[input.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported activity type %s", input.Type)
+	}
+
+	regarding, err := d365Bind(entitySet, objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := createD365ActivityPayload(input)
+	payload[fmt.Sprintf("regardingobjectid_%s_%s@odata.bind", logicalName, activityType.TypeCode)] = *regarding
+
+	d365Activity := D365Activity{}
+	if err := client.create(d365ActivityEntity(activityType.EntitySet), payload, &d365Activity); err != nil {
+		log.Errorf("Error creating activity: %s", err)
+		return nil, err
+	}
+	d365Activity.TypeCode = activityType.TypeCode
+
+	return d365Activity.mapActivityProperties(), nil
+}
+
+func d365ActivityEntity(entitySet string) d365Entity {
+	return d365Entity{
+		EntitySet: entitySet,
+		Key:       "activityid",
+		Fields:    d365SelectFields(D365Activity{}),
+	}
+}
+
+// Creates Dynamics activity payload from GraphQL input, the properties of the activity types differ in the bound records only
+func createD365ActivityPayload(input *model.ActivityInput) map[string]interface{} {
+	payload := map[string]interface{}{}
+
+	if input.Subject != nil {
+		payload["subject"] = *input.Subject
+	}
+	if input.Description != nil {
+		payload["description"] = *input.Description
+	}
+	if input.StartTime != nil {
+		payload["scheduledstart"] = formatD365DateTime(*input.StartTime)
+	}
+	if input.EndTime != nil {
+		payload["scheduledend"] = formatD365DateTime(*input.EndTime)
+	}
+
+	return payload
+}
+
+// Returns nil for the activity types that aren't unified
+func (d365Activity *D365Activity) mapActivityProperties() *model.Activity {
+	for activityType, d365Type := range d365ActivityTypes {
+		if d365Type.TypeCode != d365Activity.TypeCode {
+			continue
+		}
+
+		activity := model.Activity{
+			ID:          d365Activity.ID,
+			Type:        activityType,
+			Subject:     d365Activity.Subject,
+			Description: d365Activity.Description,
+			StartTime:   parseD365DateTime(d365Activity.ScheduledStart),
+			EndTime:     parseD365DateTime(d365Activity.ScheduledEnd),
+			CreatedAt:   parseD365DateTime(d365Activity.CreatedOn),
+			UpdatedAt:   parseD365DateTime(d365Activity.ModifiedOn),
+		}
+
+		// the actual times of the completed activities take precedence over the scheduled ones
+		if d365Activity.ActualStart != nil {
+			activity.StartTime = parseD365DateTime(d365Activity.ActualStart)
+		}
+		if d365Activity.ActualEnd != nil {
+			activity.EndTime = parseD365DateTime(d365Activity.ActualEnd)
+		}
+
+		return &activity
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package dynamics
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Contacts and opportunities reference their company with a lookup, linking sets the lookup
+// and unlinking removes the reference when it still points to the company
+
+func (client *Client) LinkContactToCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	account, err := d365Bind(ACCOUNTS_ENTITY_SET, companyId)
+	if err != nil {
+		return false, err
+	}
+
+	return client.update(CONTACTS_ENTITY_SET, contactId, &D365ContactCreateUpdatePayload{Account: account})
+}
+
+func (client *Client) UnlinkContactFromCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	d365Contact := D365Contact{}
+	if err := client.get(d365Contacts, contactId, &d365Contact); err != nil {
+		return false, err
+	}
+
+	if !sameD365ID(d365Contact.ParentCustomerID, companyId) {
+		return true, nil
+	}
+
+	return client.deleteReference(CONTACTS_ENTITY_SET, contactId, "parentcustomerid_account")
+}
+
+func (client *Client) LinkOpportunityToCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	account, err := d365Bind(ACCOUNTS_ENTITY_SET, companyId)
+	if err != nil {
+		return false, err
+	}
+
+	return client.update(OPPORTUNITIES_ENTITY_SET, opportunityId, &D365OpportunityCreateUpdatePayload{Account: account})
+}
+
+func (client *Client) UnlinkOpportunityFromCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	d365Opportunity := D365Opportunity{}
+	if err := client.get(d365Opportunities, opportunityId, &d365Opportunity); err != nil {
+		return false, err
+	}
+
+	if !sameD365ID(d365Opportunity.ParentAccountID, companyId) {
+		return true, nil
+	}
+
+	return client.deleteReference(OPPORTUNITIES_ENTITY_SET, opportunityId, "parentaccountid")
+}
+
+func (client *Client) ListCompanyContacts(ctx context.Context, companyId string) ([]*model.Contact, error) {
+	id, err := parseD365ID(companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365ContactsListResponse{}
+	if err := client.listWithFilter(client.withCustomFields(d365Contacts, model.CrmObjectContact), fmt.Sprintf("_parentcustomerid_value eq %s", id), "", &response); err != nil {
+		return nil, err
+	}
+
+	contacts := make([]*model.Contact, len(response.Value))
+	for i := range response.Value {
+		contacts[i] = client.mapContact(&response.Value[i])
+	}
+
+	return contacts, nil
+}
+
+func (client *Client) ListCompanyOpportunities(ctx context.Context, companyId string) ([]*model.Opportunity, error) {
+	id, err := parseD365ID(companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365OpportunitiesListResponse{}
+	if err := client.listWithFilter(client.withCustomFields(d365Opportunities, model.CrmObjectOpportunity), fmt.Sprintf("_parentaccountid_value eq %s", id), "", &response); err != nil {
+		return nil, err
+	}
+
+	opportunities := make([]*model.Opportunity, len(response.Value))
+	for i := range response.Value {
+		opportunities[i] = client.mapOpportunity(&response.Value[i])
+	}
+
+	return opportunities, nil
+}
+
+// Clears a single-valued navigation property of the record
+func (client *Client) deleteReference(entitySet string, recordId string, property string) (bool, error) {
+	recordPath, err := d365RecordPath(entitySet, recordId)
+	if err != nil {
+		return false, err
+	}
+
+	return client.deletePath(fmt.Sprintf("%s/%s/$ref", recordPath, property))
+}
+
+// GUIDs are case-insensitive
+func sameD365ID(id *string, otherId string) bool {
+	return id != nil && strings.EqualFold(*id, otherId)
+}
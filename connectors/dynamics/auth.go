@@ -0,0 +1,63 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/integrations"
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	AzureADAuthUrlTemplate  = "https://login.microsoftonline.com/%s/oauth2/v2.0/authorize"
+	AzureADTokenUrlTemplate = "https://login.microsoftonline.com/%s/oauth2/v2.0/token"
+
+	// accounts of any Azure AD organization can sign in when the tenant isn't configured
+	D365_DEFAULT_TENANT = "organizations"
+)
+
+var oauth2Flow = connectors.OAuth2Flow{
+	Config:         getOAuthConfig,
+	PKCE:           true,
+	CustomSettings: oauth2CustomSettings,
+}
+
+func (client *Client) refreshToken() error {
+	log.Info("Dynamics refreshing token")
+
+	newToken, err := oauth2Flow.RefreshToken(client.app, client.consumerOAuthConfig)
+	if err != nil {
+		return err
+	}
+
+	client.HTTPClient = oauth2Flow.Config(client.consumerOAuthConfig).Client(context.TODO(), newToken)
+
+	return nil
+}
+
+// Azure AD issues the tokens of the organization given by the scope, the refresh token needs offline access
+func getOAuthConfig(consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *oauth2.Config {
+	orgUrl := ""
+	tenant := D365_DEFAULT_TENANT
+	if customSettings, _ := consumerOAuthConfig.GetCustomSettings(); customSettings != nil {
+		orgUrl = strings.TrimSuffix(customSettings.DynamicsOrgURL, "/")
+		if customSettings.DynamicsTenantID != "" {
+			tenant = customSettings.DynamicsTenantID
+		}
+	}
+
+	return &oauth2.Config{
+		RedirectURL:  consumerOAuthConfig.RedirectURL,
+		ClientID:     consumerOAuthConfig.ClientID.Raw,
+		ClientSecret: consumerOAuthConfig.ClientSecret.Raw,
+		Scopes:       []string{orgUrl + "/user_impersonation", "offline_access"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  fmt.Sprintf(AzureADAuthUrlTemplate, tenant),
+			TokenURL: fmt.Sprintf(AzureADTokenUrlTemplate, tenant),
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var d365ModifiedOnField = d365FilterField{Name: "modifiedon", Type: D365_FIELD_TYPE_DATETIME}
+
+// Created and updated records are read by their modification time.
+// Deleted records aren't in the feed, Dynamics only tracks them for the entities with change tracking enabled.
+func (client *Client) ListChanges(ctx context.Context, params *connectors.ChangesParams) (*model.ChangeConnection, error) {
+	batches := []*connectors.ChangeBatch{}
+	sources := []struct {
+		objectType model.ChangeObjectType
+		list       func(*connectors.ChangesParams) (*connectors.ChangeBatch, error)
+	}{
+		{model.ChangeObjectTypeContact, client.listContactChanges},
+		{model.ChangeObjectTypeNote, client.listNoteChanges},
+		{model.ChangeObjectTypeOpportunity, client.listOpportunityChanges},
+	}
+
+	for _, source := range sources {
+		if !params.Includes(source.objectType) {
+			continue
+		}
+
+		batch, err := source.list(params)
+		if err != nil {
+			log.Errorf("Error listing changes of %s: %s", source.objectType, err)
+			return nil, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return connectors.NewChangeConnection(params, batches), nil
+}
+
+func (client *Client) listContactChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	response := D365ContactsListResponse{}
+	if err := client.listChanged(client.withCustomFields(d365Contacts, model.CrmObjectContact), model.ChangeObjectTypeContact, params, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Value))
+	for i := range response.Value {
+		contact := client.mapContact(&response.Value[i])
+		changes[i] = params.NewChange(model.ChangeObjectTypeContact, contact.ID, contact.CreatedAt, contact.UpdatedAt)
+		changes[i].Contact = contact
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+func (client *Client) listNoteChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	response := D365AnnotationsListResponse{}
+	if err := client.listChanged(d365Annotations, model.ChangeObjectTypeNote, params, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Value))
+	for i := range response.Value {
+		note := response.Value[i].mapNoteProperties()
+		changes[i] = params.NewChange(model.ChangeObjectTypeNote, note.ID, note.CreatedAt, note.UpdatedAt)
+		changes[i].Note = note
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+func (client *Client) listOpportunityChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	response := D365OpportunitiesListResponse{}
+	if err := client.listChanged(client.withCustomFields(d365Opportunities, model.CrmObjectOpportunity), model.ChangeObjectTypeOpportunity, params, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Value))
+	for i := range response.Value {
+		opportunity := client.mapOpportunity(&response.Value[i])
+		changes[i] = params.NewChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.CreatedAt, opportunity.UpdatedAt)
+		changes[i].Opportunity = opportunity
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+// Lists the records modified after the bound of the object type in the order of the feed
+func (client *Client) listChanged(entity d365Entity, objectType model.ChangeObjectType, params *connectors.ChangesParams, response interface{}) error {
+	return client.list(
+		entity,
+		&connectors.ListParams{First: params.First},
+		d365ChangesCondition(params.Bound(objectType)),
+		[]d365Sort{{Field: d365ModifiedOnField}},
+		response,
+	)
+}
+
+// Dynamics orders GUIDs differently than the feed does, so the records modified at the bound time
+// are read again and the ones before the cursor are dropped when the batches are merged
+func d365ChangesCondition(bound connectors.ChangeBound) string {
+	operator := "ge"
+	if bound.Exclusive {
+		operator = "gt"
+	}
+
+	return fmt.Sprintf("modifiedon %s %s", operator, formatD365DateTime(bound.Time))
+}
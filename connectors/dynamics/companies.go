@@ -0,0 +1,194 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ACCOUNTS_ENTITY_SET  = "accounts"
+	ACCOUNT_LOGICAL_NAME = "account"
+)
+
+type D365AccountsListResponse struct {
+	D365ListResponseBase
+	Value []D365Account `json:"value"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/account
+type D365Account struct {
+	ID                string   `json:"accountid"`
+	Name              string   `json:"name"`
+	Website           *string  `json:"websiteurl"`
+	Phone             *string  `json:"telephone1"`
+	IndustryCode      *int     `json:"industrycode"`
+	Industry          *string  `json:"industrycode@OData.Community.Display.V1.FormattedValue"`
+	Description       *string  `json:"description"`
+	City              *string  `json:"address1_city"`
+	Country           *string  `json:"address1_country"`
+	NumberOfEmployees *int     `json:"numberofemployees"`
+	Revenue           *float64 `json:"revenue"`
+
+	OwnerID    *string `json:"_ownerid_value"`
+	StateCode  int     `json:"statecode"` // 0 for active, 1 for inactive
+	CreatedOn  *string `json:"createdon"`
+	ModifiedOn *string `json:"modifiedon"`
+}
+
+// The industry is an option set, it isn't written from the free-text input
+type D365AccountCreateUpdatePayload struct {
+	Name              string   `json:"name,omitempty"`
+	Website           *string  `json:"websiteurl,omitempty"`
+	Phone             *string  `json:"telephone1,omitempty"`
+	Description       *string  `json:"description,omitempty"`
+	City              *string  `json:"address1_city,omitempty"`
+	Country           *string  `json:"address1_country,omitempty"`
+	NumberOfEmployees *int     `json:"numberofemployees,omitempty"`
+	Revenue           *float64 `json:"revenue,omitempty"`
+	Owner             *string  `json:"ownerid@odata.bind,omitempty"`
+}
+
+var d365Accounts = d365Entity{
+	EntitySet: ACCOUNTS_ENTITY_SET,
+	Key:       "accountid",
+	Fields:    d365SelectFields(D365Account{}),
+}
+
+func (client *Client) ListCompanies(ctx context.Context, params *connectors.ListParams) (*model.CompanyConnection, error) {
+	sorts, err := d365Sorts(params.OrderBy, d365CompanyFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365AccountsListResponse{}
+	if err := client.list(d365Accounts, params, "", sorts, &response); err != nil {
+		log.Errorf("Error listing companies: %s", err)
+		return nil, err
+	}
+
+	companyEdges := make([]*model.CompanyEdge, len(response.Value))
+	for i := range response.Value {
+		company := response.Value[i].mapCompanyProperties()
+		companyEdges[i] = &model.CompanyEdge{
+			Cursor: d365RecordCursor(response.Value[i], company.ID, sorts),
+			Node:   company,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &companyEdges)
+
+	return &model.CompanyConnection{
+		Edges:    recordsValue.Interface().([]*model.CompanyEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetCompany(ctx context.Context, companyId string) (*model.Company, error) {
+	d365Account := D365Account{}
+	if err := client.get(d365Accounts, companyId, &d365Account); err != nil {
+		log.Errorf("Error getting company: %s", err)
+		return nil, err
+	}
+
+	return d365Account.mapCompanyProperties(), nil
+}
+
+func (client *Client) CreateCompany(ctx context.Context, input *model.CompanyInput) (*model.Company, error) {
+	payload, err := createD365AccountPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	d365Account := D365Account{}
+	if err := client.create(d365Accounts, payload, &d365Account); err != nil {
+		log.Errorf("Error creating company: %s", err)
+		return nil, err
+	}
+
+	return d365Account.mapCompanyProperties(), nil
+}
+
+func (client *Client) UpdateCompany(ctx context.Context, companyId string, input *model.CompanyInput) (bool, error) {
+	payload, err := createD365AccountPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	success, err := client.update(ACCOUNTS_ENTITY_SET, companyId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating company #%s: %s", companyId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteCompany(ctx context.Context, companyId string) (bool, error) {
+	return client.delete(ACCOUNTS_ENTITY_SET, companyId)
+}
+
+// Creates Dynamics Account Update/Create payload from GraphQL input
+func createD365AccountPayload(input *model.CompanyInput) (*D365AccountCreateUpdatePayload, error) {
+	payload := D365AccountCreateUpdatePayload{
+		Name:              input.Name,
+		Website:           input.Website,
+		Phone:             input.Phone,
+		Description:       input.Description,
+		City:              input.City,
+		Country:           input.Country,
+		NumberOfEmployees: input.NumberOfEmployees,
+	}
+
+	if input.AnnualRevenue != nil {
+		revenue, err := strconv.ParseFloat(*input.AnnualRevenue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annual revenue %s", *input.AnnualRevenue)
+		}
+		payload.Revenue = &revenue
+	}
+
+	if input.OwnerID != nil {
+		owner, err := d365Bind(SYSTEM_USERS_ENTITY_SET, *input.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		payload.Owner = owner
+	}
+
+	return &payload, nil
+}
+
+func (d365Account *D365Account) mapCompanyProperties() *model.Company {
+	archived := d365Account.StateCode != 0
+
+	company := model.Company{
+		ID:                d365Account.ID,
+		Name:              d365Account.Name,
+		Website:           d365Account.Website,
+		Phone:             d365Account.Phone,
+		Industry:          d365Account.Industry,
+		Description:       d365Account.Description,
+		City:              d365Account.City,
+		Country:           d365Account.Country,
+		NumberOfEmployees: d365Account.NumberOfEmployees,
+		Archived:          &archived,
+		CreatedAt:         parseD365DateTime(d365Account.CreatedOn),
+		UpdatedAt:         parseD365DateTime(d365Account.ModifiedOn),
+	}
+
+	if d365Account.Revenue != nil {
+		annualRevenue := strconv.FormatFloat(*d365Account.Revenue, 'f', -1, 64)
+		company.AnnualRevenue = &annualRevenue
+	}
+
+	if d365Account.OwnerID != nil {
+		company.Owner = &model.User{ID: *d365Account.OwnerID}
+	}
+
+	return &company
+}
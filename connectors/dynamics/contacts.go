@@ -0,0 +1,218 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	CONTACTS_ENTITY_SET = "contacts"
+)
+
+type D365ContactsListResponse struct {
+	D365ListResponseBase
+	Value []D365Contact `json:"value"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/contact
+type D365Contact struct {
+	ID        string  `json:"contactid"`
+	FullName  *string `json:"fullname"`
+	FirstName *string `json:"firstname"`
+	LastName  *string `json:"lastname"`
+	Email     *string `json:"emailaddress1"`
+	Phone     *string `json:"telephone1"`
+	Website   *string `json:"websiteurl"`
+
+	// the parent customer is either an account or a contact
+	ParentCustomerID   *string `json:"_parentcustomerid_value"`
+	ParentCustomerName *string `json:"_parentcustomerid_value@OData.Community.Display.V1.FormattedValue"`
+	ParentCustomerType *string `json:"_parentcustomerid_value@Microsoft.Dynamics.CRM.lookuplogicalname"`
+
+	OwnerID    *string `json:"_ownerid_value"`
+	StateCode  int     `json:"statecode"` // 0 for active, 1 for inactive
+	CreatedOn  *string `json:"createdon"`
+	ModifiedOn *string `json:"modifiedon"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (d365Contact *D365Contact) UnmarshalJSON(data []byte) error {
+	type plainD365Contact D365Contact
+	return connectors.UnmarshalWithRawFields(data, (*plainD365Contact)(d365Contact), &d365Contact.RawFields)
+}
+
+type D365ContactCreateUpdatePayload struct {
+	FirstName *string `json:"firstname,omitempty"`
+	LastName  *string `json:"lastname,omitempty"`
+	Email     *string `json:"emailaddress1,omitempty"`
+	Phone     *string `json:"telephone1,omitempty"`
+	Website   *string `json:"websiteurl,omitempty"`
+	Account   *string `json:"parentcustomerid_account@odata.bind,omitempty"`
+	Owner     *string `json:"ownerid@odata.bind,omitempty"`
+}
+
+var d365Contacts = d365Entity{
+	EntitySet: CONTACTS_ENTITY_SET,
+	Key:       "contactid",
+	Fields:    d365SelectFields(D365Contact{}),
+}
+
+func (client *Client) ListContacts(ctx context.Context, params *connectors.ListParams) (*model.ContactConnection, error) {
+	filter, err := d365ListFilter(params, d365Contacts, d365ContactFilterFields, d365ContactSearchFields)
+	if err != nil {
+		return nil, err
+	}
+
+	sorts, err := d365Sorts(params.OrderBy, d365ContactFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365ContactsListResponse{}
+	if err := client.list(client.withCustomFields(d365Contacts, model.CrmObjectContact), params, filter, sorts, &response); err != nil {
+		log.Errorf("Error listing contacts: %s", err)
+		return nil, err
+	}
+
+	contactEdges := make([]*model.ContactEdge, len(response.Value))
+	for i := range response.Value {
+		contact := client.mapContact(&response.Value[i])
+		contactEdges[i] = &model.ContactEdge{
+			Cursor: d365RecordCursor(response.Value[i], contact.ID, sorts),
+			Node:   contact,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &contactEdges)
+
+	connection := model.ContactConnection{
+		Edges:    recordsValue.Interface().([]*model.ContactEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(d365Contacts, filter)
+		if err != nil {
+			log.Errorf("Error counting contacts: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetContact(ctx context.Context, contactId string) (*model.Contact, error) {
+	d365Contact := D365Contact{}
+	if err := client.get(client.withCustomFields(d365Contacts, model.CrmObjectContact), contactId, &d365Contact); err != nil {
+		log.Errorf("Error getting contact: %s", err)
+		return nil, err
+	}
+
+	return client.mapContact(&d365Contact), nil
+}
+
+// Create contact using GraphQL input
+func (client *Client) CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error) {
+	payload, err := client.createD365ContactPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	d365Contact := D365Contact{}
+	if err := client.create(client.withCustomFields(d365Contacts, model.CrmObjectContact), payload, &d365Contact); err != nil {
+		log.Errorf("Error creating contact: %s", err)
+		return nil, err
+	}
+
+	return client.mapContact(&d365Contact), nil
+}
+
+func (client *Client) UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error) {
+	payload, err := client.createD365ContactPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	success, err := client.update(CONTACTS_ENTITY_SET, contactId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating contact #%s: %s", contactId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteContact(ctx context.Context, contactId string) (bool, error) {
+	return client.delete(CONTACTS_ENTITY_SET, contactId)
+}
+
+// Creates Dynamics Contact Update/Create payload from GraphQL input
+func (client *Client) createD365ContactPayload(input *model.ContactInput) (map[string]interface{}, error) {
+	payload := D365ContactCreateUpdatePayload{
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+		Email:     input.Email,
+		Phone:     input.Phone,
+		Website:   input.Website,
+	}
+
+	var err error
+	if input.CompanyID != nil {
+		if payload.Account, err = d365Bind(ACCOUNTS_ENTITY_SET, *input.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.OwnerID != nil {
+		if payload.Owner, err = d365Bind(SYSTEM_USERS_ENTITY_SET, *input.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectContact, input.CustomFields))
+}
+
+// Maps the contact with its custom fields
+func (client *Client) mapContact(d365Contact *D365Contact) *model.Contact {
+	contact := d365Contact.mapContactProperties()
+	contact.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectContact, d365Contact.RawFields)
+
+	return contact
+}
+
+func (d365Contact *D365Contact) mapContactProperties() *model.Contact {
+	archived := d365Contact.StateCode != 0
+
+	contact := model.Contact{
+		ID:        d365Contact.ID,
+		Name:      d365Contact.FullName,
+		FirstName: d365Contact.FirstName,
+		LastName:  d365Contact.LastName,
+		Email:     d365Contact.Email,
+		Phone:     d365Contact.Phone,
+		Website:   d365Contact.Website,
+		Archived:  &archived,
+		CreatedAt: parseD365DateTime(d365Contact.CreatedOn),
+		UpdatedAt: parseD365DateTime(d365Contact.ModifiedOn),
+	}
+
+	if d365Contact.ParentCustomerID != nil && d365Contact.ParentCustomerType != nil && *d365Contact.ParentCustomerType == ACCOUNT_LOGICAL_NAME {
+		contact.Company = &model.Company{ID: *d365Contact.ParentCustomerID}
+
+		if d365Contact.ParentCustomerName != nil {
+			contact.CompanyName = d365Contact.ParentCustomerName
+			contact.Company.Name = *d365Contact.ParentCustomerName
+		}
+	}
+
+	if d365Contact.OwnerID != nil {
+		contact.Owner = &model.User{ID: *d365Contact.OwnerID}
+	}
+
+	return &contact
+}
@@ -0,0 +1,444 @@
+package dynamics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+)
+
+const (
+	BaseUrlTemplate  = "%s/api/data/v9.2"
+	D365_DATE_FORMAT = "2006-01-02"
+
+	// annotations requested for every record, lookups and option sets come with their display values
+	D365_PREFER_ANNOTATIONS = `odata.include-annotations="*"`
+)
+
+type Client struct {
+	OrgURL       string // e.g. https://contoso.crm.dynamics.com
+	HTTPClient   *http.Client
+	FieldMapping connectors.FieldMapping
+
+	app                 *config.App
+	consumerOAuthConfig *integrations.ConsumerOauth2Configuration
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/compose-http-requests-handle-errors
+type D365ErrorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type D365ListResponseBase struct {
+	Count    *int   `json:"@odata.count"`
+	NextLink string `json:"@odata.nextLink"`
+}
+
+func DynamicsClient(app *config.App, consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *Client {
+	context := context.Background()
+
+	orgUrl := ""
+	if customSettings, _ := consumerOAuthConfig.GetCustomSettings(); customSettings != nil {
+		orgUrl = customSettings.DynamicsOrgURL
+	}
+
+	return &Client{
+		OrgURL:              strings.TrimSuffix(orgUrl, "/"),
+		HTTPClient:          oauth2Flow.Client(context, app, consumerOAuthConfig),
+		app:                 app,
+		consumerOAuthConfig: consumerOAuthConfig,
+	}
+}
+
+// Loads the client of the integration of the consumer, the latest integration of the connector unless an integration ID is given
+func LoadClientFromDB(app *config.App, consumer *integrations.Consumer, integrationID *uuid.UUID) (*Client, error) {
+	consumerOAuthConfig, err := connectors.LoadOAuth2Configuration(app, consumer, connectors.CONNECTOR_CRM_DYNAMICS, integrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return DynamicsClient(app, consumerOAuthConfig), nil
+}
+
+func (client *Client) baseUrl() string {
+	return fmt.Sprintf(BaseUrlTemplate, client.OrgURL)
+}
+
+func (client *Client) sendAPIRequest(req *http.Request, response interface{}) error {
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("OData-MaxVersion", "4.0")
+	req.Header.Set("OData-Version", "4.0")
+	if req.Header.Get("Prefer") == "" {
+		req.Header.Set("Prefer", D365_PREFER_ANNOTATIONS)
+	}
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized && client.consumerOAuthConfig != nil {
+		// the token may be revoked or expire before its expiration, it's refreshed when the API rejects it
+		if err := client.refreshToken(); err != nil {
+			return err
+		}
+
+		// retry with the body read again
+		if err := connectors.RewindRequestBody(req); err != nil {
+			return err
+		}
+		res, err = client.HTTPClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+	}
+
+	log.WithFields(log.Fields{
+		"status_code": res.StatusCode,
+		"url":         req.URL.Path,
+		"method":      req.Method,
+	}).Info("Dynamics request")
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var errRes D365ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil && errRes.Error.Message != "" {
+			return errors.New(errRes.Error.Message)
+		}
+
+		return fmt.Errorf("unexpected error response with status code %d", res.StatusCode)
+	}
+	if res.StatusCode == http.StatusNoContent || response == nil {
+		return nil
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(response); err != nil {
+		log.Errorf("Error decoding response body: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// === API Specific Functions ===
+// Generalized list records request, filter is an optional OData condition.
+// Records are ordered by the sorts and then by the key, cursors of sorted lists hold the sorted values.
+// Backward pagination reads the records in the reverse order, prepareListResults restores the order.
+func (client *Client) list(entity d365Entity, params *connectors.ListParams, filter string, sorts []d365Sort, response interface{}) error {
+	query := url.Values{}
+
+	cursor := params.After
+	if params.Backward() {
+		cursor = params.Before
+	}
+
+	conditions := []string{}
+	if cursor != nil {
+		cursorCondition, err := d365CursorCondition(*cursor, entity, sorts, params.Backward())
+		if err != nil {
+			return err
+		}
+		conditions = append(conditions, cursorCondition)
+	}
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	query.Set("$select", strings.Join(entity.Fields, ","))
+	if len(conditions) > 0 {
+		query.Set("$filter", strings.Join(conditions, " and "))
+	}
+	query.Set("$orderby", d365OrderBy(entity, sorts, params.Backward()))
+	// +1 to see if there are more pages
+	query.Set("$top", fmt.Sprint(params.Limit()+1))
+
+	log.Debugf("Listing %s: %s", entity.EntitySet, query.Get("$filter"))
+
+	return client.getURL(fmt.Sprintf("%s/%s?%s", client.baseUrl(), entity.EntitySet, encodeODataQuery(query)), response)
+}
+
+// Lists all the records matching the OData condition, up to the size of a single page
+func (client *Client) listWithFilter(entity d365Entity, filter string, orderBy string, response interface{}) error {
+	query := url.Values{}
+	query.Set("$select", strings.Join(entity.Fields, ","))
+	query.Set("$filter", filter)
+	if orderBy != "" {
+		query.Set("$orderby", orderBy)
+	}
+
+	log.Infof("Listing %s: %s", entity.EntitySet, filter)
+
+	return client.getURL(fmt.Sprintf("%s/%s?%s", client.baseUrl(), entity.EntitySet, encodeODataQuery(query)), response)
+}
+
+// Counts the records matching the optional OData condition, Dynamics stops counting at 5000 records
+func (client *Client) count(entity d365Entity, filter string) (int, error) {
+	query := url.Values{}
+	query.Set("$select", entity.Key)
+	if filter != "" {
+		query.Set("$filter", filter)
+	}
+	query.Set("$count", "true")
+	query.Set("$top", "1")
+
+	response := D365ListResponseBase{}
+	if err := client.getURL(fmt.Sprintf("%s/%s?%s", client.baseUrl(), entity.EntitySet, encodeODataQuery(query)), &response); err != nil {
+		return 0, err
+	}
+
+	if response.Count == nil {
+		return 0, nil
+	}
+
+	return *response.Count, nil
+}
+
+func (client *Client) prepareListResults(params *connectors.ListParams, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
+	recordsValue := reflect.ValueOf(edgesPtr).Elem()
+	recordsLenght := recordsValue.Len()
+
+	end := recordsLenght
+	hasMore := false
+
+	if recordsLenght > params.Limit() {
+		// remove the item that was added to see if there are more pages
+		end = params.Limit()
+		hasMore = true
+	}
+
+	ret := recordsValue.Slice(0, end)
+	pageInfo := &model.PageInfo{
+		HasNextPage:     hasMore,
+		HasPreviousPage: params.After != nil,
+	}
+
+	if params.Backward() {
+		ret = reverseSlice(ret)
+		pageInfo.HasNextPage = params.Before != nil
+		pageInfo.HasPreviousPage = hasMore
+	}
+
+	if ret.Len() > 0 {
+		startCursor := ret.Index(0).Elem().FieldByName("Cursor").String()
+		endCursor := ret.Index(ret.Len() - 1).Elem().FieldByName("Cursor").String()
+
+		pageInfo.StartCursor = &startCursor
+		pageInfo.EndCursor = &endCursor
+	}
+
+	return ret, pageInfo
+}
+
+// Returns a copy of the slice with the elements in the reverse order
+func reverseSlice(slice reflect.Value) reflect.Value {
+	reversed := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		reversed.Index(slice.Len() - 1 - i).Set(slice.Index(i))
+	}
+
+	return reversed
+}
+
+// Gets a record by ID
+func (client *Client) get(entity d365Entity, recordId string, response interface{}) error {
+	recordPath, err := d365RecordPath(entity.EntitySet, recordId)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("$select", strings.Join(entity.Fields, ","))
+	log.Infof("Fetching %s", recordPath)
+
+	return client.getURL(fmt.Sprintf("%s/%s?%s", client.baseUrl(), recordPath, encodeODataQuery(query)), response)
+}
+
+func (client *Client) getURL(url string, response interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	return client.sendAPIRequest(req, response)
+}
+
+// Creates a new record, Dynamics returns the created record with the selected fields
+func (client *Client) create(entity d365Entity, payload interface{}, response interface{}) error {
+	query := url.Values{}
+	query.Set("$select", strings.Join(entity.Fields, ","))
+
+	req, err := newD365PayloadRequest("POST", fmt.Sprintf("%s/%s?%s", client.baseUrl(), entity.EntitySet, encodeODataQuery(query)), payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Prefer", "return=representation,"+D365_PREFER_ANNOTATIONS)
+
+	log.Debugf("creating %s", entity.EntitySet)
+
+	return client.sendAPIRequest(req, response)
+}
+
+func (client *Client) update(entitySet string, recordId string, payload interface{}) (bool, error) {
+	recordPath, err := d365RecordPath(entitySet, recordId)
+	if err != nil {
+		return false, err
+	}
+
+	req, err := newD365PayloadRequest("PATCH", fmt.Sprintf("%s/%s", client.baseUrl(), recordPath), payload)
+	if err != nil {
+		return false, err
+	}
+	// PATCH creates missing records unless it's prevented
+	req.Header.Set("If-Match", "*")
+
+	log.Debugf("updating %s", recordPath)
+
+	if err := client.sendAPIRequest(req, nil); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) delete(entitySet string, recordId string) (bool, error) {
+	recordPath, err := d365RecordPath(entitySet, recordId)
+	if err != nil {
+		return false, err
+	}
+
+	log.Debugf("deleting %s", recordPath)
+
+	return client.deletePath(recordPath)
+}
+
+func (client *Client) deletePath(path string) (bool, error) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s", client.baseUrl(), path), nil)
+	if err != nil {
+		return false, err
+	}
+
+	if err := client.sendAPIRequest(req, nil); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Calls a bound action of the record, e.g. QualifyLead
+func (client *Client) callAction(entitySet string, recordId string, action string, payload interface{}, response interface{}) error {
+	recordPath, err := d365RecordPath(entitySet, recordId)
+	if err != nil {
+		return err
+	}
+
+	req, err := newD365PayloadRequest("POST", fmt.Sprintf("%s/%s/Microsoft.Dynamics.CRM.%s", client.baseUrl(), recordPath, action), payload)
+	if err != nil {
+		return err
+	}
+
+	return client.sendAPIRequest(req, response)
+}
+
+func newD365PayloadRequest(method string, url string, payload interface{}) (*http.Request, error) {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		log.Errorf("Error encoding payload %v: %s", payload, err)
+		return nil, err
+	}
+
+	return http.NewRequest(method, url, bytes.NewBuffer(encodedPayload))
+}
+
+// OData options are separated by "&", the rest of the query is encoded the usual way.
+// Dynamics doesn't accept "+" for the spaces of the conditions.
+func encodeODataQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+// Dynamics IDs are GUIDs, they are validated before they are put into the paths and the conditions
+func parseD365ID(id string) (string, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return "", fmt.Errorf("invalid ID %s", id)
+	}
+
+	return parsed.String(), nil
+}
+
+func d365RecordPath(entitySet string, recordId string) (string, error) {
+	id, err := parseD365ID(recordId)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s(%s)", entitySet, id), nil
+}
+
+// Value of a single-valued navigation property, e.g. "/accounts(00000000-0000-0000-0000-000000000001)"
+func d365Bind(entitySet string, recordId string) (*string, error) {
+	recordPath, err := d365RecordPath(entitySet, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	bind := "/" + recordPath
+	return &bind, nil
+}
+
+func parseD365DateTime(dateTime *string) *time.Time {
+	if dateTime == nil || *dateTime == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *dateTime)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"dateTime": *dateTime,
+		}).Error("Failed to parse dateTime")
+		return nil
+	}
+
+	return &t
+}
+
+func parseD365Date(date *string) *time.Time {
+	if date == nil || *date == "" {
+		return nil
+	}
+
+	t, err := time.Parse(D365_DATE_FORMAT, *date)
+	if err != nil {
+		return parseD365DateTime(date)
+	}
+
+	return &t
+}
+
+func formatD365DateTime(dateTime time.Time) string {
+	return dateTime.UTC().Format(time.RFC3339)
+}
+
+// Entity with the mapped fields of the object among the selected fields, for the custom fields of the records
+func (client *Client) withCustomFields(entity d365Entity, object model.CrmObject) d365Entity {
+	entity.Fields = client.FieldMapping.QueryFields(object, entity.Fields)
+	return entity
+}
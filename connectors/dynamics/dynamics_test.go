@@ -0,0 +1,205 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	contactId = "00000000-0000-0000-0000-000000000001"
+	companyId = "00000000-0000-0000-0000-000000000002"
+)
+
+// Client of a fake Web API, the handler gets the requests sent to the organization
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{OrgURL: server.URL, HTTPClient: server.Client()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func TestListContactsPagination(t *testing.T) {
+	var query map[string][]string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/data/v9.2/contacts", r.URL.Path, "expecting the contacts entity set")
+		query = r.URL.Query()
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": []map[string]interface{}{
+				{"contactid": contactId, "fullname": "Jane Doe", "_parentcustomerid_value": companyId, "_parentcustomerid_value@Microsoft.Dynamics.CRM.lookuplogicalname": "account"},
+				{"contactid": "00000000-0000-0000-0000-000000000003", "fullname": "John Doe"},
+			},
+		})
+	})
+
+	ctx := context.Background()
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 1})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, []string{"2"}, query["$top"], "expecting one more record to be read")
+	assert.Equal(t, []string{"contactid asc"}, query["$orderby"], "expecting the contacts ordered by the key")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	assert.True(t, contactConnection.PageInfo.HasNextPage, "expecting a next page")
+	assert.Equal(t, companyId, contactConnection.Edges[0].Node.Company.ID, "expecting the parent account as the company")
+
+	contactConnection, err = c.ListContacts(ctx, &connectors.ListParams{First: 1, After: contactConnection.PageInfo.EndCursor})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, []string{"contactid gt " + contactId}, query["$filter"], "expecting the contacts after the cursor")
+	assert.True(t, contactConnection.PageInfo.HasPreviousPage, "expecting a previous page")
+}
+
+func TestContactCRUD(t *testing.T) {
+	requests := []string{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "POST":
+			assert.Contains(t, r.Header.Get("Prefer"), "return=representation", "expecting the created record in the response")
+
+			payload := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			assert.Equal(t, "/accounts("+companyId+")", payload["parentcustomerid_account@odata.bind"], "expecting the company to be bound")
+
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"contactid": contactId, "emailaddress1": payload["emailaddress1"]})
+		case "PATCH":
+			assert.Equal(t, "*", r.Header.Get("If-Match"), "expecting the update of an existing record")
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	email := "jane.doe@example.com"
+	company := companyId
+
+	contact, err := c.CreateContact(ctx, &model.ContactInput{Email: &email, CompanyID: &company})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, contactId, contact.ID, "expecting the ID of the created contact")
+	assert.Equal(t, email, *contact.Email, "expecting an email for the contact equal to the created one")
+
+	success, err := c.UpdateContact(ctx, contact.ID, &model.ContactInput{Email: &email})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting successful update")
+
+	success, err = c.DeleteContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting successful deletion")
+
+	_, err = c.DeleteContact(ctx, "1")
+	assert.NotNil(t, err, "expecting an error for an invalid ID")
+
+	assert.Equal(t, []string{
+		"POST /api/data/v9.2/contacts",
+		"PATCH /api/data/v9.2/contacts(" + contactId + ")",
+		"DELETE /api/data/v9.2/contacts(" + contactId + ")",
+	}, requests, "expecting no request for the invalid ID")
+}
+
+func TestErrorResponse(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"error": map[string]string{"code": "0x80040217", "message": "contact With Id = " + contactId + " Does Not Exist"},
+		})
+	})
+
+	_, err := c.GetContact(context.Background(), contactId)
+	assert.EqualError(t, err, "contact With Id = "+contactId+" Does Not Exist", "expecting the message of the error response")
+}
+
+func TestListPipelines(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"OptionSet": map[string]interface{}{
+				"Options": []map[string]interface{}{
+					{"Value": 0, "Label": map[string]interface{}{"UserLocalizedLabel": map[string]string{"Label": "Qualify"}}},
+					{"Value": 1, "Label": map[string]interface{}{"UserLocalizedLabel": map[string]string{"Label": "Develop"}}},
+				},
+			},
+		})
+	})
+
+	pipelines, err := c.ListPipelines(context.Background())
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(pipelines), "expecting a single pipeline")
+	assert.Equal(t, D365_DEFAULT_PIPELINE_ID, pipelines[0].ID, "expecting the default pipeline")
+	assert.Equal(t, "1", pipelines[0].Stages[1].ID, "expecting the option value as the stage ID")
+	assert.Equal(t, "Develop", pipelines[0].Stages[1].Label, "expecting the option label as the stage label")
+}
+
+func TestListFilter(t *testing.T) {
+	ownerId := "00000000-0000-0000-0000-000000000004"
+	stageName := "1"
+	pipelineId := "other"
+	query := "O'Brien_"
+
+	filter, err := d365ListFilter(&connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			Or: []*model.OpportunityFilter{
+				{OwnerID: &model.IDFilter{Eq: &ownerId}},
+				{StageName: &model.StringFilter{In: []string{stageName}}},
+			},
+		}),
+		Query: &query,
+	}, d365Opportunities, d365OpportunityFilterFields, d365OpportunitySearchFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "(((_ownerid_value eq "+ownerId+") or ((salesstage eq 1)))) and (contains(name,'O''Brien[_]'))", filter, "expecting the alternatives and the escaped search")
+
+	filter, err = d365ListFilter(&connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			PipelineID: &model.IDFilter{Eq: &pipelineId},
+		}),
+	}, d365Opportunities, d365OpportunityFilterFields, d365OpportunitySearchFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "(opportunityid eq null)", filter, "expecting no opportunities outside of the default pipeline")
+
+	invalidStageName := "Qualify"
+	_, err = d365ListFilter(&connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			StageName: &model.StringFilter{Eq: &invalidStageName},
+		}),
+	}, d365Opportunities, d365OpportunityFilterFields, d365OpportunitySearchFields)
+	assert.NotNil(t, err, "expecting an error for a stage that isn't an option value")
+}
+
+func TestKeysetCondition(t *testing.T) {
+	direction := model.SortDirectionDesc
+	sorts, err := d365Sorts([]*model.SortInput{{Field: "updatedAt", Direction: &direction}}, d365ContactFilterFields)
+	assert.Nil(t, err, "expecting nil error")
+
+	modifiedOn := "2022-10-01T10:00:00Z"
+	condition, err := d365KeysetCondition(d365Contacts, sorts, []*string{&modifiedOn}, contactId, false)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "(modifiedon lt 2022-10-01T10:00:00Z or modifiedon eq null or (modifiedon eq 2022-10-01T10:00:00Z and contactid gt "+contactId+"))", condition, "expecting the contacts after the cursor")
+
+	condition, err = d365KeysetCondition(d365Contacts, sorts, []*string{nil}, contactId, true)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "(modifiedon ne null or (modifiedon eq null and contactid lt "+contactId+"))", condition, "expecting the contacts before the cursor with no value")
+
+	_, err = d365KeysetCondition(d365Contacts, sorts, []*string{&modifiedOn}, "1", false)
+	assert.NotNil(t, err, "expecting an error for an invalid cursor")
+}
+
+func TestChangesCondition(t *testing.T) {
+	since := time.Date(2022, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "modifiedon ge 2022-10-01T10:00:00Z", d365ChangesCondition(connectors.ChangeBound{Time: since}), "expecting the records modified at the bound to be read again")
+	assert.Equal(t, "modifiedon gt 2022-10-01T10:00:00Z", d365ChangesCondition(connectors.ChangeBound{Time: since, Exclusive: true}), "expecting the records modified after the bound")
+}
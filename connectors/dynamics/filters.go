@@ -0,0 +1,298 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	D365_FIELD_TYPE_STRING = iota
+	D365_FIELD_TYPE_NUMBER
+	D365_FIELD_TYPE_DATETIME
+	D365_FIELD_TYPE_DATE
+	D365_FIELD_TYPE_ID     // GUID, e.g. the value of a lookup
+	D365_FIELD_TYPE_OPTION // integer value of an option set
+)
+
+// Entity set of the Web API and the fields read from it
+type d365Entity struct {
+	EntitySet string   // e.g. "contacts"
+	Key       string   // e.g. "contactid"
+	Fields    []string // selected fields
+}
+
+type d365FilterField struct {
+	Name     string
+	Type     int
+	Constant *string // value of a field that isn't stored in Dynamics
+}
+
+var d365DefaultPipelineID = D365_DEFAULT_PIPELINE_ID
+
+// Dynamics fields of the unified filter fields
+var d365ContactFilterFields = map[string]d365FilterField{
+	"firstName": {Name: "firstname"},
+	"lastName":  {Name: "lastname"},
+	"email":     {Name: "emailaddress1"},
+	"phone":     {Name: "telephone1"},
+	"companyId": {Name: "_parentcustomerid_value", Type: D365_FIELD_TYPE_ID},
+	"ownerId":   {Name: "_ownerid_value", Type: D365_FIELD_TYPE_ID},
+	"createdAt": {Name: "createdon", Type: D365_FIELD_TYPE_DATETIME},
+	"updatedAt": {Name: "modifiedon", Type: D365_FIELD_TYPE_DATETIME},
+}
+
+var d365OpportunityFilterFields = map[string]d365FilterField{
+	"name":       {Name: "name"},
+	"stageName":  {Name: "salesstage", Type: D365_FIELD_TYPE_OPTION},
+	"companyId":  {Name: "_parentaccountid_value", Type: D365_FIELD_TYPE_ID},
+	"ownerId":    {Name: "_ownerid_value", Type: D365_FIELD_TYPE_ID},
+	"amount":     {Name: "estimatedvalue", Type: D365_FIELD_TYPE_NUMBER},
+	"closeDate":  {Name: "estimatedclosedate", Type: D365_FIELD_TYPE_DATE},
+	"createdAt":  {Name: "createdon", Type: D365_FIELD_TYPE_DATETIME},
+	"updatedAt":  {Name: "modifiedon", Type: D365_FIELD_TYPE_DATETIME},
+	"pipelineId": {Constant: &d365DefaultPipelineID}, // there is a single pipeline
+}
+
+// Companies can't be filtered yet, the fields are used for sorting
+var d365CompanyFilterFields = map[string]d365FilterField{
+	"name":              {Name: "name"},
+	"ownerId":           {Name: "_ownerid_value", Type: D365_FIELD_TYPE_ID},
+	"numberOfEmployees": {Name: "numberofemployees", Type: D365_FIELD_TYPE_NUMBER},
+	"annualRevenue":     {Name: "revenue", Type: D365_FIELD_TYPE_NUMBER},
+	"createdAt":         {Name: "createdon", Type: D365_FIELD_TYPE_DATETIME},
+	"updatedAt":         {Name: "modifiedon", Type: D365_FIELD_TYPE_DATETIME},
+}
+
+// Leads can't be filtered yet, the fields are used for sorting
+var d365LeadFilterFields = map[string]d365FilterField{
+	"firstName":   {Name: "firstname"},
+	"lastName":    {Name: "lastname"},
+	"email":       {Name: "emailaddress1"},
+	"companyName": {Name: "companyname"},
+	"status":      {Name: "statuscode", Type: D365_FIELD_TYPE_OPTION},
+	"createdAt":   {Name: "createdon", Type: D365_FIELD_TYPE_DATETIME},
+	"updatedAt":   {Name: "modifiedon", Type: D365_FIELD_TYPE_DATETIME},
+}
+
+// Users can't be filtered yet, the fields are used for sorting
+var d365UserFilterFields = map[string]d365FilterField{
+	"name":      {Name: "fullname"},
+	"firstName": {Name: "firstname"},
+	"lastName":  {Name: "lastname"},
+	"email":     {Name: "internalemailaddress"},
+	"createdAt": {Name: "createdon", Type: D365_FIELD_TYPE_DATETIME},
+	"updatedAt": {Name: "modifiedon", Type: D365_FIELD_TYPE_DATETIME},
+}
+
+// Text fields matched by the free-text search
+var d365ContactSearchFields = []string{"fullname", "emailaddress1", "telephone1"}
+var d365OpportunitySearchFields = []string{"name"}
+
+// Fields selected from the entity set, they are the JSON names of the struct fields without the annotations
+func d365SelectFields(record interface{}) []string {
+	fields := []string{}
+	recordType := reflect.TypeOf(record)
+
+	for i := 0; i < recordType.NumField(); i++ {
+		name := strings.Split(recordType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" && !strings.Contains(name, "@") {
+			fields = append(fields, name)
+		}
+	}
+
+	return fields
+}
+
+// Builds the OData $filter of the list params, returns an empty string when there is nothing to filter by
+func d365ListFilter(params *connectors.ListParams, entity d365Entity, filterFields map[string]d365FilterField, searchFields []string) (string, error) {
+	clauses := []string{}
+
+	if params.Filter != nil {
+		clause, err := compileODataFilter(params.Filter, entity, filterFields)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	if params.Query != nil && *params.Query != "" {
+		searchClauses := make([]string, len(searchFields))
+		for i, field := range searchFields {
+			searchClauses[i] = fmt.Sprintf("contains(%s,%s)", field, formatODataContainsValue(*params.Query))
+		}
+		clauses = append(clauses, "("+strings.Join(searchClauses, " or ")+")")
+	}
+
+	return strings.Join(clauses, " and "), nil
+}
+
+func compileODataFilter(filter *connectors.Filter, entity d365Entity, filterFields map[string]d365FilterField) (string, error) {
+	clauses := []string{}
+
+	for _, condition := range filter.Conditions {
+		clause, err := compileODataCondition(condition, entity, filterFields)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, clause)
+	}
+
+	for _, and := range filter.And {
+		clause, err := compileODataFilter(and, entity, filterFields)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		orClauses := []string{}
+		for _, or := range filter.Or {
+			clause, err := compileODataFilter(or, entity, filterFields)
+			if err != nil {
+				return "", err
+			}
+			if clause == "" {
+				// an empty filter matches everything
+				orClauses = nil
+				break
+			}
+			orClauses = append(orClauses, clause)
+		}
+
+		if len(orClauses) > 0 {
+			clauses = append(clauses, "("+strings.Join(orClauses, " or ")+")")
+		}
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+
+	return "(" + strings.Join(clauses, " and ") + ")", nil
+}
+
+func compileODataCondition(condition connectors.FilterCondition, entity d365Entity, filterFields map[string]d365FilterField) (string, error) {
+	field, ok := filterFields[condition.Field]
+	if !ok {
+		return "", fmt.Errorf("filtering by %s is not supported", condition.Field)
+	}
+
+	if field.Constant != nil {
+		return compileODataConstantCondition(condition, entity, *field.Constant)
+	}
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ, connectors.FILTER_OPERATOR_GTE, connectors.FILTER_OPERATOR_LTE:
+		value, err := formatODataValue(field, condition.Value)
+		if err != nil {
+			return "", err
+		}
+
+		operator := map[string]string{
+			connectors.FILTER_OPERATOR_EQ:  "eq",
+			connectors.FILTER_OPERATOR_GTE: "ge",
+			connectors.FILTER_OPERATOR_LTE: "le",
+		}[condition.Operator]
+
+		return fmt.Sprintf("%s %s %s", field.Name, operator, value), nil
+	case connectors.FILTER_OPERATOR_CONTAINS:
+		value, _ := condition.Value.(string)
+		return fmt.Sprintf("contains(%s,%s)", field.Name, formatODataContainsValue(value)), nil
+	case connectors.FILTER_OPERATOR_IN:
+		values, _ := condition.Value.([]string)
+		if len(values) == 0 {
+			// nothing can match an empty list
+			return fmt.Sprintf("%s eq null", entity.Key), nil
+		}
+
+		clauses := make([]string, len(values))
+		for i, value := range values {
+			formattedValue, err := formatODataValue(field, value)
+			if err != nil {
+				return "", err
+			}
+			clauses[i] = fmt.Sprintf("%s eq %s", field.Name, formattedValue)
+		}
+		return "(" + strings.Join(clauses, " or ") + ")", nil
+	}
+
+	return "", fmt.Errorf("unsupported filter operator %s", condition.Operator)
+}
+
+// Evaluates the condition on a constant field, the result doesn't depend on the record
+func compileODataConstantCondition(condition connectors.FilterCondition, entity d365Entity, constant string) (string, error) {
+	matches := false
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ:
+		value, _ := condition.Value.(string)
+		matches = value == constant
+	case connectors.FILTER_OPERATOR_IN:
+		values, _ := condition.Value.([]string)
+		for _, value := range values {
+			matches = matches || value == constant
+		}
+	default:
+		return "", fmt.Errorf("unsupported filter operator %s for %s", condition.Operator, condition.Field)
+	}
+
+	if matches {
+		return fmt.Sprintf("%s ne null", entity.Key), nil
+	}
+
+	return fmt.Sprintf("%s eq null", entity.Key), nil
+}
+
+func formatODataValue(field d365FilterField, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case time.Time:
+		if field.Type == D365_FIELD_TYPE_DATE {
+			return v.UTC().Format(D365_DATE_FORMAT), nil
+		}
+		return formatD365DateTime(v), nil
+	case string:
+		switch field.Type {
+		case D365_FIELD_TYPE_ID:
+			return parseD365ID(v)
+		case D365_FIELD_TYPE_OPTION:
+			if _, err := strconv.Atoi(v); err != nil {
+				return "", fmt.Errorf("invalid value %s of %s", v, field.Name)
+			}
+			return v, nil
+		case D365_FIELD_TYPE_NUMBER:
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				return "", fmt.Errorf("invalid value %s of %s", v, field.Name)
+			}
+			return v, nil
+		case D365_FIELD_TYPE_DATETIME, D365_FIELD_TYPE_DATE:
+			// values of the cursors are stored the way Dynamics returns them
+			t := parseD365Date(&v)
+			if t == nil {
+				return "", fmt.Errorf("invalid value %s of %s", v, field.Name)
+			}
+			return formatODataValue(field, *t)
+		}
+		return formatODataString(v), nil
+	}
+
+	return formatODataString(fmt.Sprint(value)), nil
+}
+
+// Single quotes are doubled in OData string literals
+func formatODataString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// Dynamics treats the wildcards of the contains function as the LIKE wildcards, they are escaped with brackets
+func formatODataContainsValue(value string) string {
+	return formatODataString(strings.NewReplacer(`[`, `[[]`, `%`, `[%]`, `_`, `[_]`).Replace(value))
+}
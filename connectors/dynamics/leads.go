@@ -0,0 +1,306 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	LEADS_ENTITY_SET = "leads"
+
+	D365_LEAD_STATE_OPEN         = 0
+	D365_LEAD_STATE_QUALIFIED    = 1
+	D365_LEAD_STATE_DISQUALIFIED = 2
+)
+
+type D365LeadsListResponse struct {
+	D365ListResponseBase
+	Value []D365Lead `json:"value"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/lead
+type D365Lead struct {
+	ID          string  `json:"leadid"`
+	FullName    *string `json:"fullname"`
+	FirstName   *string `json:"firstname"`
+	LastName    *string `json:"lastname"`
+	Email       *string `json:"emailaddress1"`
+	Phone       *string `json:"telephone1"`
+	Website     *string `json:"websiteurl"`
+	CompanyName *string `json:"companyname"`
+	JobTitle    *string `json:"jobtitle"`
+	StatusCode  *int    `json:"statuscode"`
+	Status      *string `json:"statuscode@OData.Community.Display.V1.FormattedValue"`
+	StateCode   int     `json:"statecode"`
+	CreatedOn   *string `json:"createdon"`
+	ModifiedOn  *string `json:"modifiedon"`
+}
+
+type D365LeadCreateUpdatePayload struct {
+	Subject     *string `json:"subject,omitempty"`
+	FirstName   *string `json:"firstname,omitempty"`
+	LastName    *string `json:"lastname,omitempty"`
+	Email       *string `json:"emailaddress1,omitempty"`
+	Phone       *string `json:"telephone1,omitempty"`
+	Website     *string `json:"websiteurl,omitempty"`
+	CompanyName *string `json:"companyname,omitempty"`
+	JobTitle    *string `json:"jobtitle,omitempty"`
+	StatusCode  *int    `json:"statuscode,omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/qualifylead
+type D365QualifyLeadPayload struct {
+	CreateAccount     bool `json:"CreateAccount"`
+	CreateContact     bool `json:"CreateContact"`
+	CreateOpportunity bool `json:"CreateOpportunity"`
+	Status            int  `json:"Status"`
+}
+
+// The records created by the qualification, their types are given by the OData type
+type D365QualifyLeadResponse struct {
+	Value []struct {
+		ODataType     string `json:"@odata.type"`
+		AccountID     string `json:"accountid"`
+		ContactID     string `json:"contactid"`
+		OpportunityID string `json:"opportunityid"`
+	} `json:"value"`
+}
+
+var d365Leads = d365Entity{
+	EntitySet: LEADS_ENTITY_SET,
+	Key:       "leadid",
+	Fields:    d365SelectFields(D365Lead{}),
+}
+
+func (client *Client) ListLeads(ctx context.Context, params *connectors.ListParams) (*model.LeadConnection, error) {
+	sorts, err := d365Sorts(params.OrderBy, d365LeadFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365LeadsListResponse{}
+	if err := client.list(d365Leads, params, "", sorts, &response); err != nil {
+		log.Errorf("Error listing leads: %s", err)
+		return nil, err
+	}
+
+	leadEdges := make([]*model.LeadEdge, len(response.Value))
+	for i := range response.Value {
+		lead := response.Value[i].mapLeadProperties()
+		leadEdges[i] = &model.LeadEdge{
+			Cursor: d365RecordCursor(response.Value[i], lead.ID, sorts),
+			Node:   lead,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &leadEdges)
+
+	connection := model.LeadConnection{
+		Edges:    recordsValue.Interface().([]*model.LeadEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(d365Leads, "")
+		if err != nil {
+			log.Errorf("Error counting leads: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetLead(ctx context.Context, leadId string) (*model.Lead, error) {
+	d365Lead := D365Lead{}
+	if err := client.get(d365Leads, leadId, &d365Lead); err != nil {
+		log.Errorf("Error getting lead: %s", err)
+		return nil, err
+	}
+
+	return d365Lead.mapLeadProperties(), nil
+}
+
+func (client *Client) CreateLead(ctx context.Context, input *model.LeadInput) (*model.Lead, error) {
+	payload, err := client.createD365LeadPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// the topic is required on the lead form
+	subject := strings.TrimSpace(strings.Join([]string{stringValue(input.FirstName), stringValue(input.LastName)}, " "))
+	if input.CompanyName != nil && *input.CompanyName != "" {
+		subject = *input.CompanyName
+	}
+	payload.Subject = &subject
+
+	d365Lead := D365Lead{}
+	if err := client.create(d365Leads, payload, &d365Lead); err != nil {
+		log.Errorf("Error creating lead: %s", err)
+		return nil, err
+	}
+
+	return d365Lead.mapLeadProperties(), nil
+}
+
+func (client *Client) UpdateLead(ctx context.Context, leadId string, input *model.LeadInput) (bool, error) {
+	payload, err := client.createD365LeadPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	success, err := client.update(LEADS_ENTITY_SET, leadId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating lead #%s: %s", leadId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteLead(ctx context.Context, leadId string) (bool, error) {
+	return client.delete(LEADS_ENTITY_SET, leadId)
+}
+
+// Qualifies the lead, Dynamics creates the contact, the account and the opportunity.
+// The account isn't created when the lead is converted into an existing company, the records are linked to it instead.
+func (client *Client) ConvertLead(ctx context.Context, leadId string, input *model.LeadConversionInput) (*model.LeadConversionResult, error) {
+	status, err := client.leadStatusCode(input.ConvertedStatus, D365_LEAD_STATE_QUALIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := D365QualifyLeadPayload{
+		CreateAccount:     input.CompanyID == nil,
+		CreateContact:     true,
+		CreateOpportunity: input.CreateOpportunity == nil || *input.CreateOpportunity,
+		Status:            status,
+	}
+
+	response := D365QualifyLeadResponse{}
+	if err := client.callAction(LEADS_ENTITY_SET, leadId, "QualifyLead", &payload, &response); err != nil {
+		log.Errorf("Error converting lead #%s: %s", leadId, err)
+		return nil, err
+	}
+
+	conversionResult := model.LeadConversionResult{CompanyID: input.CompanyID}
+	for _, record := range response.Value {
+		switch record.ODataType {
+		case "#Microsoft.Dynamics.CRM.contact":
+			conversionResult.ContactID = record.ContactID
+		case "#Microsoft.Dynamics.CRM.account":
+			accountId := record.AccountID
+			conversionResult.CompanyID = &accountId
+		case "#Microsoft.Dynamics.CRM.opportunity":
+			opportunityId := record.OpportunityID
+			conversionResult.OpportunityID = &opportunityId
+		}
+	}
+
+	if conversionResult.ContactID == "" {
+		return nil, errors.New("the lead was qualified without a contact")
+	}
+
+	if input.CompanyID != nil {
+		if _, err := client.LinkContactToCompany(ctx, conversionResult.ContactID, *input.CompanyID); err != nil {
+			return nil, err
+		}
+		if conversionResult.OpportunityID != nil {
+			if _, err := client.LinkOpportunityToCompany(ctx, *conversionResult.OpportunityID, *input.CompanyID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if input.OpportunityName != nil && conversionResult.OpportunityID != nil {
+		if _, err := client.update(OPPORTUNITIES_ENTITY_SET, *conversionResult.OpportunityID, &D365OpportunityCreateUpdatePayload{Name: *input.OpportunityName}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &conversionResult, nil
+}
+
+// Creates Dynamics Lead Update/Create payload from GraphQL input, the status is the label of an open lead status
+func (client *Client) createD365LeadPayload(input *model.LeadInput) (*D365LeadCreateUpdatePayload, error) {
+	payload := D365LeadCreateUpdatePayload{
+		FirstName:   input.FirstName,
+		LastName:    input.LastName,
+		Email:       input.Email,
+		Phone:       input.Phone,
+		Website:     input.Website,
+		CompanyName: input.CompanyName,
+		JobTitle:    input.Title,
+	}
+
+	if input.Status != nil {
+		statusCode, err := client.leadStatusCode(input.Status, D365_LEAD_STATE_OPEN)
+		if err != nil {
+			return nil, err
+		}
+		payload.StatusCode = &statusCode
+	}
+
+	return &payload, nil
+}
+
+// Finds the lead status of the state by its label, the first status of the state is the default one
+func (client *Client) leadStatusCode(status *string, state int) (int, error) {
+	options, err := client.listOptions("lead", "statuscode", "StatusAttributeMetadata")
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range options {
+		if options[i].State == nil || *options[i].State != state {
+			continue
+		}
+
+		if status == nil || strings.EqualFold(options[i].label(), *status) {
+			return options[i].Value, nil
+		}
+	}
+
+	if status == nil {
+		return 0, fmt.Errorf("no lead status for the state %d", state)
+	}
+
+	return 0, fmt.Errorf("unknown lead status %s", *status)
+}
+
+func (d365Lead *D365Lead) mapLeadProperties() *model.Lead {
+	archived := d365Lead.StateCode == D365_LEAD_STATE_DISQUALIFIED
+	converted := d365Lead.StateCode == D365_LEAD_STATE_QUALIFIED
+
+	return &model.Lead{
+		ID:          d365Lead.ID,
+		Name:        d365Lead.FullName,
+		FirstName:   d365Lead.FirstName,
+		LastName:    d365Lead.LastName,
+		Email:       d365Lead.Email,
+		Phone:       d365Lead.Phone,
+		Website:     d365Lead.Website,
+		CompanyName: d365Lead.CompanyName,
+		Title:       d365Lead.JobTitle,
+		Status:      d365Lead.Status,
+		Archived:    &archived,
+		Converted:   &converted,
+		CreatedAt:   parseD365DateTime(d365Lead.CreatedOn),
+		UpdatedAt:   parseD365DateTime(d365Lead.ModifiedOn),
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
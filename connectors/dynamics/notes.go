@@ -0,0 +1,131 @@
+package dynamics
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ANNOTATIONS_ENTITY_SET = "annotations"
+	D365_NOTE_TITLE_LENGTH = 30
+)
+
+type D365AnnotationsListResponse struct {
+	D365ListResponseBase
+	Value []D365Annotation `json:"value"`
+}
+
+// Notes are annotations regarding the record
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/annotation
+type D365Annotation struct {
+	ID         string  `json:"annotationid"`
+	Subject    *string `json:"subject"`
+	NoteText   *string `json:"notetext"`
+	ObjectID   *string `json:"_objectid_value"`
+	CreatedOn  *string `json:"createdon"`
+	ModifiedOn *string `json:"modifiedon"`
+}
+
+type D365AnnotationCreatePayload struct {
+	Subject     string  `json:"subject"`
+	NoteText    string  `json:"notetext"`
+	Contact     *string `json:"objectid_contact@odata.bind,omitempty"`
+	Opportunity *string `json:"objectid_opportunity@odata.bind,omitempty"`
+}
+
+var d365Annotations = d365Entity{
+	EntitySet: ANNOTATIONS_ENTITY_SET,
+	Key:       "annotationid",
+	Fields:    d365SelectFields(D365Annotation{}),
+}
+
+func (client *Client) ListContactNotes(ctx context.Context, contactId string) ([]*model.Note, error) {
+	return client.listNotes(contactId)
+}
+
+func (client *Client) CreateContactNote(ctx context.Context, contactId string, input *model.NoteInput) (*model.Note, error) {
+	payload := createD365AnnotationPayload(input)
+
+	contact, err := d365Bind(CONTACTS_ENTITY_SET, contactId)
+	if err != nil {
+		return nil, err
+	}
+	payload.Contact = contact
+
+	return client.createNote(payload)
+}
+
+func (client *Client) ListOpportunityNotes(ctx context.Context, opportunityId string) ([]*model.Note, error) {
+	return client.listNotes(opportunityId)
+}
+
+func (client *Client) CreateOpportunityNote(ctx context.Context, opportunityId string, input *model.NoteInput) (*model.Note, error) {
+	payload := createD365AnnotationPayload(input)
+
+	opportunity, err := d365Bind(OPPORTUNITIES_ENTITY_SET, opportunityId)
+	if err != nil {
+		return nil, err
+	}
+	payload.Opportunity = opportunity
+
+	return client.createNote(payload)
+}
+
+func (client *Client) listNotes(objectId string) ([]*model.Note, error) {
+	id, err := parseD365ID(objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365AnnotationsListResponse{}
+	if err := client.listWithFilter(d365Annotations, fmt.Sprintf("_objectid_value eq %s", id), "createdon desc", &response); err != nil {
+		return nil, err
+	}
+
+	notes := make([]*model.Note, len(response.Value))
+	for i := range response.Value {
+		notes[i] = response.Value[i].mapNoteProperties()
+	}
+
+	return notes, nil
+}
+
+func (client *Client) createNote(payload *D365AnnotationCreatePayload) (*model.Note, error) {
+	d365Annotation := D365Annotation{}
+	if err := client.create(d365Annotations, payload, &d365Annotation); err != nil {
+		log.Errorf("Error creating note: %s", err)
+		return nil, err
+	}
+
+	return d365Annotation.mapNoteProperties(), nil
+}
+
+// The title of the note is the beginning of its content
+func createD365AnnotationPayload(input *model.NoteInput) *D365AnnotationCreatePayload {
+	subject := []rune(input.Content)
+	if len(subject) > D365_NOTE_TITLE_LENGTH {
+		subject = append(subject[:D365_NOTE_TITLE_LENGTH], []rune("...")...)
+	}
+
+	return &D365AnnotationCreatePayload{
+		Subject:  string(subject),
+		NoteText: input.Content,
+	}
+}
+
+func (d365Annotation *D365Annotation) mapNoteProperties() *model.Note {
+	note := model.Note{
+		ID:        d365Annotation.ID,
+		CreatedAt: parseD365DateTime(d365Annotation.CreatedOn),
+		UpdatedAt: parseD365DateTime(d365Annotation.ModifiedOn),
+	}
+
+	if d365Annotation.NoteText != nil {
+		note.Content = *d365Annotation.NoteText
+	}
+
+	return &note
+}
@@ -0,0 +1,261 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	OPPORTUNITIES_ENTITY_SET = "opportunities"
+)
+
+type D365OpportunitiesListResponse struct {
+	D365ListResponseBase
+	Value []D365Opportunity `json:"value"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/opportunity
+type D365Opportunity struct {
+	ID                 string   `json:"opportunityid"`
+	Name               string   `json:"name"`
+	EstimatedValue     *float64 `json:"estimatedvalue"`
+	EstimatedCloseDate *string  `json:"estimatedclosedate"`
+	SalesStage         *int     `json:"salesstage"` // value of the sales stage option set
+
+	ParentAccountID   *string `json:"_parentaccountid_value"`
+	ParentAccountName *string `json:"_parentaccountid_value@OData.Community.Display.V1.FormattedValue"`
+	ParentContactID   *string `json:"_parentcontactid_value"`
+
+	OwnerID    *string `json:"_ownerid_value"`
+	StateCode  int     `json:"statecode"` // 0 for open, 1 for won, 2 for lost
+	CreatedOn  *string `json:"createdon"`
+	ModifiedOn *string `json:"modifiedon"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (d365Opportunity *D365Opportunity) UnmarshalJSON(data []byte) error {
+	type plainD365Opportunity D365Opportunity
+	return connectors.UnmarshalWithRawFields(data, (*plainD365Opportunity)(d365Opportunity), &d365Opportunity.RawFields)
+}
+
+type D365OpportunityCreateUpdatePayload struct {
+	Name               string   `json:"name,omitempty"`
+	EstimatedValue     *float64 `json:"estimatedvalue,omitempty"`
+	EstimatedCloseDate string   `json:"estimatedclosedate,omitempty"`
+	SalesStage         *int     `json:"salesstage,omitempty"`
+	Account            *string  `json:"parentaccountid@odata.bind,omitempty"`
+	Owner              *string  `json:"ownerid@odata.bind,omitempty"`
+}
+
+var d365Opportunities = d365Entity{
+	EntitySet: OPPORTUNITIES_ENTITY_SET,
+	Key:       "opportunityid",
+	Fields:    d365SelectFields(D365Opportunity{}),
+}
+
+func (client *Client) ListOpportunities(ctx context.Context, params *connectors.ListParams) (*model.OpportunityConnection, error) {
+	filter, err := d365ListFilter(params, d365Opportunities, d365OpportunityFilterFields, d365OpportunitySearchFields)
+	if err != nil {
+		return nil, err
+	}
+
+	sorts, err := d365Sorts(params.OrderBy, d365OpportunityFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365OpportunitiesListResponse{}
+	if err := client.list(client.withCustomFields(d365Opportunities, model.CrmObjectOpportunity), params, filter, sorts, &response); err != nil {
+		log.Errorf("Error listing opportunities: %s", err)
+		return nil, err
+	}
+
+	opportunityEdges := make([]*model.OpportunityEdge, len(response.Value))
+	for i := range response.Value {
+		opportunity := client.mapOpportunity(&response.Value[i])
+		opportunityEdges[i] = &model.OpportunityEdge{
+			Cursor: d365RecordCursor(response.Value[i], opportunity.ID, sorts),
+			Node:   opportunity,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &opportunityEdges)
+
+	connection := model.OpportunityConnection{
+		Edges:    recordsValue.Interface().([]*model.OpportunityEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(d365Opportunities, filter)
+		if err != nil {
+			log.Errorf("Error counting opportunities: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error) {
+	d365Opportunity := D365Opportunity{}
+	if err := client.get(client.withCustomFields(d365Opportunities, model.CrmObjectOpportunity), opportunityId, &d365Opportunity); err != nil {
+		log.Errorf("Error getting opportunity: %s", err)
+		return nil, err
+	}
+
+	return client.mapOpportunity(&d365Opportunity), nil
+}
+
+func (client *Client) CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error) {
+	payload, err := client.createD365OpportunityPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	d365Opportunity := D365Opportunity{}
+	if err := client.create(client.withCustomFields(d365Opportunities, model.CrmObjectOpportunity), payload, &d365Opportunity); err != nil {
+		log.Errorf("Error creating opportunity: %s", err)
+		return nil, err
+	}
+
+	return client.mapOpportunity(&d365Opportunity), nil
+}
+
+func (client *Client) UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error) {
+	payload, err := client.createD365OpportunityPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	success, err := client.update(OPPORTUNITIES_ENTITY_SET, opportunityId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating opportunity #%s: %s", opportunityId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteOpportunity(ctx context.Context, opportunityId string) (bool, error) {
+	return client.delete(OPPORTUNITIES_ENTITY_SET, opportunityId)
+}
+
+// The contact of the opportunity is the only one Dynamics stores on the opportunity itself
+func (client *Client) ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error) {
+	d365Opportunity := D365Opportunity{}
+	if err := client.get(d365Opportunities, opportunityId, &d365Opportunity); err != nil {
+		return nil, err
+	}
+
+	contacts := []*model.Contact{}
+	if d365Opportunity.ParentContactID == nil {
+		return contacts, nil
+	}
+
+	contact, err := client.GetContact(ctx, *d365Opportunity.ParentContactID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(contacts, contact), nil
+}
+
+// Creates Dynamics Opportunity Update/Create payload from GraphQL input,
+// the stage name is the value of the sales stage option set
+func (client *Client) createD365OpportunityPayload(input *model.OpportunityInput) (map[string]interface{}, error) {
+	payload := D365OpportunityCreateUpdatePayload{
+		Name: input.Name,
+	}
+
+	if input.PipelineID != nil && *input.PipelineID != D365_DEFAULT_PIPELINE_ID {
+		return nil, fmt.Errorf("unknown pipeline %s", *input.PipelineID)
+	}
+
+	if input.StageName != "" {
+		salesStage, err := strconv.Atoi(input.StageName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stage %s, the stage has to be the ID of a pipeline stage", input.StageName)
+		}
+		payload.SalesStage = &salesStage
+	}
+
+	if input.Amount != nil {
+		amount, err := strconv.ParseFloat(*input.Amount, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %s", *input.Amount)
+		}
+		payload.EstimatedValue = &amount
+	}
+
+	if !input.CloseDate.IsZero() {
+		payload.EstimatedCloseDate = input.CloseDate.UTC().Format(D365_DATE_FORMAT)
+	}
+
+	var err error
+	if input.CompanyID != nil {
+		if payload.Account, err = d365Bind(ACCOUNTS_ENTITY_SET, *input.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.OwnerID != nil {
+		if payload.Owner, err = d365Bind(SYSTEM_USERS_ENTITY_SET, *input.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectOpportunity, input.CustomFields))
+}
+
+// Maps the opportunity with its custom fields
+func (client *Client) mapOpportunity(d365Opportunity *D365Opportunity) *model.Opportunity {
+	opportunity := d365Opportunity.mapOpportunityProperties()
+	opportunity.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectOpportunity, d365Opportunity.RawFields)
+
+	return opportunity
+}
+
+func (d365Opportunity *D365Opportunity) mapOpportunityProperties() *model.Opportunity {
+	pipelineId := D365_DEFAULT_PIPELINE_ID
+
+	opportunity := model.Opportunity{
+		ID:         d365Opportunity.ID,
+		Name:       d365Opportunity.Name,
+		PipelineID: &pipelineId,
+		CloseDate:  parseD365Date(d365Opportunity.EstimatedCloseDate),
+		CreatedAt:  parseD365DateTime(d365Opportunity.CreatedOn),
+		UpdatedAt:  parseD365DateTime(d365Opportunity.ModifiedOn),
+	}
+
+	if d365Opportunity.SalesStage != nil {
+		stageName := strconv.Itoa(*d365Opportunity.SalesStage)
+		opportunity.StageName = &stageName
+	}
+
+	if d365Opportunity.EstimatedValue != nil {
+		amount := strconv.FormatFloat(*d365Opportunity.EstimatedValue, 'f', -1, 64)
+		opportunity.Amount = &amount
+	}
+
+	if d365Opportunity.ParentAccountID != nil {
+		opportunity.Company = &model.Company{ID: *d365Opportunity.ParentAccountID}
+
+		if d365Opportunity.ParentAccountName != nil {
+			opportunity.Company.Name = *d365Opportunity.ParentAccountName
+		}
+	}
+
+	if d365Opportunity.OwnerID != nil {
+		opportunity.Owner = &model.User{ID: *d365Opportunity.OwnerID}
+	}
+
+	return &opportunity
+}
@@ -0,0 +1,83 @@
+package dynamics
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Opportunities move through the options of the sales stage, there is a single set of them
+	D365_DEFAULT_PIPELINE_ID    = "default"
+	D365_DEFAULT_PIPELINE_LABEL = "Opportunities"
+)
+
+// Metadata of an option set attribute
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/query-metadata-web-api#retrieving-attributes
+type D365OptionSetAttributeResponse struct {
+	OptionSet struct {
+		Options []D365Option `json:"Options"`
+	} `json:"OptionSet"`
+}
+
+type D365Option struct {
+	Value int  `json:"Value"`
+	State *int `json:"State"` // state of the status options
+	Label struct {
+		UserLocalizedLabel *struct {
+			Label string `json:"Label"`
+		} `json:"UserLocalizedLabel"`
+	} `json:"Label"`
+}
+
+func (client *Client) ListPipelines(ctx context.Context) ([]*model.Pipeline, error) {
+	options, err := client.listOptions("opportunity", "salesstage", "PicklistAttributeMetadata")
+	if err != nil {
+		log.Errorf("Error listing sales stages: %s", err)
+		return nil, err
+	}
+
+	stages := make([]*model.PipelineStage, len(options))
+	for i := range options {
+		displayOrder := i
+		stages[i] = &model.PipelineStage{
+			ID:           strconv.Itoa(options[i].Value),
+			Label:        options[i].label(),
+			DisplayOrder: &displayOrder,
+		}
+	}
+
+	pipeline := model.Pipeline{
+		ID:     D365_DEFAULT_PIPELINE_ID,
+		Label:  D365_DEFAULT_PIPELINE_LABEL,
+		Stages: stages,
+	}
+
+	return []*model.Pipeline{&pipeline}, nil
+}
+
+// Lists the options of the option set attribute in their display order
+func (client *Client) listOptions(entityName string, attributeName string, metadataType string) ([]D365Option, error) {
+	url := fmt.Sprintf(
+		"%s/EntityDefinitions(LogicalName='%s')/Attributes(LogicalName='%s')/Microsoft.Dynamics.CRM.%s?$select=LogicalName&$expand=OptionSet($select=Options)",
+		client.baseUrl(), entityName, attributeName, metadataType,
+	)
+
+	response := D365OptionSetAttributeResponse{}
+	if err := client.getURL(url, &response); err != nil {
+		return nil, err
+	}
+
+	return response.OptionSet.Options, nil
+}
+
+func (option *D365Option) label() string {
+	if option.Label.UserLocalizedLabel == nil {
+		return strconv.Itoa(option.Value)
+	}
+
+	return option.Label.UserLocalizedLabel.Label
+}
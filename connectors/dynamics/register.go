@@ -0,0 +1,61 @@
+package dynamics
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+func init() {
+	connectors.Register(connectors.Registration{
+		Connector: connectors.Connector{
+			ServiceCode: connectors.CONNECTOR_CRM_DYNAMICS,
+			Type:        connectors.CONNECTOR_TYPE_CRM,
+			Name:        "Dynamics 365",
+			Description: "Microsoft Dynamics 365 Sales helps sales teams build relationships with their customers and close deals faster.",
+			AuthType:    connectors.AUTH_TYPE_OAUTH2,
+		},
+		Position: 4,
+		NewCrmConnector: func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping connectors.FieldMapping) connectors.CrmConnector {
+			client := DynamicsClient(app, oauthConfig)
+			client.FieldMapping = fieldMapping
+			return client
+		},
+		OAuth2: &oauth2Flow,
+		Capabilities: []*model.ObjectCapabilities{
+			connectors.ObjectCapabilities(model.CrmObjectContact, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectOpportunity, "id", "createdAt", "updatedAt", "name", "amount", "stageName", "pipelineId", "closeDate", "stage", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectCompany, "id", "createdAt", "updatedAt", "archived", "name", "website", "phone", "industry", "description", "city", "country", "numberOfEmployees", "annualRevenue", "owner"),
+			connectors.ObjectCapabilities(model.CrmObjectLead, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "title", "status", "converted"),
+			connectors.ObjectCapabilities(model.CrmObjectUser, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "active"),
+			connectors.ObjectCapabilities(model.CrmObjectNote, "id", "createdAt", "updatedAt", "content"),
+			connectors.ObjectCapabilities(model.CrmObjectTask, "id", "createdAt", "updatedAt", "subject", "description", "status", "priority", "dueDate"),
+			connectors.ObjectCapabilities(model.CrmObjectActivity, "id", "createdAt", "updatedAt", "type", "subject", "description", "startTime", "endTime"),
+			connectors.ObjectCapabilities(model.CrmObjectPipeline, connectors.PipelineFields...),
+			connectors.ObjectCapabilities(model.CrmObjectChange, connectors.ChangeFields...),
+		},
+	})
+}
+
+func oauth2CustomSettings(input *model.OAuth2ConfigurationInput) (*integrations.ConsumerOauth2ConfigurationCustomSettings, error) {
+	if input.DynamicsOrgURL == nil || *input.DynamicsOrgURL == "" {
+		return nil, fmt.Errorf("dynamicsOrgUrl must be provided")
+	}
+
+	if orgUrl, err := url.Parse(*input.DynamicsOrgURL); err != nil || orgUrl.Scheme != "https" || orgUrl.Host == "" {
+		return nil, fmt.Errorf("dynamicsOrgUrl must be an https URL, e.g. https://contoso.crm.dynamics.com")
+	}
+
+	customSettings := integrations.ConsumerOauth2ConfigurationCustomSettings{
+		DynamicsOrgURL: strings.TrimSuffix(*input.DynamicsOrgURL, "/"),
+	}
+	if input.DynamicsTenantID != nil {
+		customSettings.DynamicsTenantID = *input.DynamicsTenantID
+	}
+
+	return &customSettings, nil
+}
@@ -0,0 +1,164 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type d365Sort struct {
+	Field      d365FilterField
+	Descending bool
+}
+
+// Maps the unified sort fields to Dynamics fields
+func d365Sorts(orderBy []*model.SortInput, fields map[string]d365FilterField) ([]d365Sort, error) {
+	sorts := make([]d365Sort, len(orderBy))
+	for i, sort := range orderBy {
+		field, ok := fields[sort.Field]
+		if !ok || field.Constant != nil {
+			return nil, fmt.Errorf("sorting by %s is not supported", sort.Field)
+		}
+
+		sorts[i] = d365Sort{Field: field, Descending: connectors.IsDescending(sort)}
+	}
+
+	return sorts, nil
+}
+
+// $orderby of the sorts, the key is always the last one to make the order stable.
+// Dynamics puts nulls first in the ascending order and last in the descending order.
+// Backward pagination reads the records in the reverse order.
+func d365OrderBy(entity d365Entity, sorts []d365Sort, backward bool) string {
+	clauses := make([]string, 0, len(sorts)+1)
+	for _, sort := range sorts {
+		if sort.Descending != backward {
+			clauses = append(clauses, sort.Field.Name+" desc")
+		} else {
+			clauses = append(clauses, sort.Field.Name+" asc")
+		}
+	}
+
+	if backward {
+		return strings.Join(append(clauses, entity.Key+" desc"), ",")
+	}
+
+	return strings.Join(append(clauses, entity.Key+" asc"), ",")
+}
+
+// Condition matching the records that come after the cursor in the sorted list,
+// or before the cursor when paginating backwards
+func d365CursorCondition(cursor string, entity d365Entity, sorts []d365Sort, backward bool) (string, error) {
+	if len(sorts) == 0 {
+		return d365KeysetCondition(entity, sorts, nil, connectors.DecodeCursor(cursor), backward)
+	}
+
+	sortCursor, err := connectors.DecodeSortCursor(cursor, len(sorts))
+	if err != nil {
+		return "", err
+	}
+
+	return d365KeysetCondition(entity, sorts, sortCursor.Values, sortCursor.ID, backward)
+}
+
+// Records after the cursor either come after it by the first sort field,
+// or have the same value and come after it by the remaining fields
+func d365KeysetCondition(entity d365Entity, sorts []d365Sort, values []*string, id string, backward bool) (string, error) {
+	if len(sorts) == 0 {
+		id, err := parseD365ID(id)
+		if err != nil {
+			return "", fmt.Errorf("invalid cursor")
+		}
+
+		if backward {
+			return fmt.Sprintf("%s lt %s", entity.Key, id), nil
+		}
+		return fmt.Sprintf("%s gt %s", entity.Key, id), nil
+	}
+
+	field := sorts[0].Field
+	descending := sorts[0].Descending != backward
+	next, err := d365KeysetCondition(entity, sorts[1:], values[1:], id, backward)
+	if err != nil {
+		return "", err
+	}
+
+	if values[0] == nil {
+		sameValue := fmt.Sprintf("(%s eq null and %s)", field.Name, next)
+		if descending {
+			// nulls go last in the descending order
+			return sameValue, nil
+		}
+		return fmt.Sprintf("(%s ne null or %s)", field.Name, sameValue), nil
+	}
+
+	value, err := formatODataValue(field, *values[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor")
+	}
+	sameValue := fmt.Sprintf("(%s eq %s and %s)", field.Name, value, next)
+
+	if descending {
+		return fmt.Sprintf("(%s lt %s or %s eq null or %s)", field.Name, value, field.Name, sameValue), nil
+	}
+
+	return fmt.Sprintf("(%s gt %s or %s)", field.Name, value, sameValue), nil
+}
+
+// Cursor of the record in the sorted list, the record is a Dynamics record struct
+func d365RecordCursor(record interface{}, id string, sorts []d365Sort) string {
+	if len(sorts) == 0 {
+		return connectors.EncodeCursor(id)
+	}
+
+	recordValue := reflect.Indirect(reflect.ValueOf(record))
+	values := make([]*string, len(sorts))
+	for i, sort := range sorts {
+		values[i] = d365FieldValueString(d365FieldByName(recordValue, sort.Field.Name))
+	}
+
+	return connectors.EncodeSortCursor(values, id)
+}
+
+// Finds the struct field by the name of the Dynamics field in its JSON tag
+func d365FieldByName(recordValue reflect.Value, name string) reflect.Value {
+	recordType := recordValue.Type()
+	for i := 0; i < recordType.NumField(); i++ {
+		if strings.Split(recordType.Field(i).Tag.Get("json"), ",")[0] == name {
+			return recordValue.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// Returns nil for null and empty values, the values keep the format Dynamics returns them in
+func d365FieldValueString(value reflect.Value) *string {
+	if !value.IsValid() {
+		return nil
+	}
+
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	var str string
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		str = strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	default:
+		str = fmt.Sprint(value.Interface())
+	}
+
+	if str == "" {
+		return nil
+	}
+
+	return &str
+}
@@ -0,0 +1,201 @@
+package dynamics
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TASKS_ENTITY_SET = "tasks"
+
+	D365_ACTIVITY_STATE_OPEN      = 0
+	D365_ACTIVITY_STATE_COMPLETED = 1
+)
+
+// Status reasons of the tasks, the completed one is the only status of the completed state
+var d365TaskStatusCodes = map[model.TaskStatus]int{
+	model.TaskStatusNotStarted: 2,
+	model.TaskStatusInProgress: 3,
+	model.TaskStatusWaiting:    4,
+	model.TaskStatusCompleted:  5,
+	model.TaskStatusDeferred:   7,
+}
+
+var d365TaskPriorityCodes = map[model.TaskPriority]int{
+	model.TaskPriorityLow:    0,
+	model.TaskPriorityNormal: 1,
+	model.TaskPriorityHigh:   2,
+}
+
+type D365TasksListResponse struct {
+	D365ListResponseBase
+	Value []D365Task `json:"value"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/task
+type D365Task struct {
+	ID           string  `json:"activityid"`
+	Subject      string  `json:"subject"`
+	Description  *string `json:"description"`
+	ScheduledEnd *string `json:"scheduledend"` // due date
+	StateCode    int     `json:"statecode"`
+	StatusCode   int     `json:"statuscode"`
+	PriorityCode *int    `json:"prioritycode"`
+	CreatedOn    *string `json:"createdon"`
+	ModifiedOn   *string `json:"modifiedon"`
+}
+
+type D365TaskCreateUpdatePayload struct {
+	Subject      string  `json:"subject,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	ScheduledEnd *string `json:"scheduledend,omitempty"`
+	StateCode    *int    `json:"statecode,omitempty"`
+	StatusCode   *int    `json:"statuscode,omitempty"`
+	PriorityCode *int    `json:"prioritycode,omitempty"`
+	Contact      *string `json:"regardingobjectid_contact_task@odata.bind,omitempty"`
+	Opportunity  *string `json:"regardingobjectid_opportunity_task@odata.bind,omitempty"`
+}
+
+var d365Tasks = d365Entity{
+	EntitySet: TASKS_ENTITY_SET,
+	Key:       "activityid",
+	Fields:    d365SelectFields(D365Task{}),
+}
+
+func (client *Client) ListContactTasks(ctx context.Context, contactId string) ([]*model.Task, error) {
+	return client.listTasks(contactId)
+}
+
+func (client *Client) CreateContactTask(ctx context.Context, contactId string, input *model.TaskInput) (*model.Task, error) {
+	payload := createD365TaskPayload(input)
+
+	contact, err := d365Bind(CONTACTS_ENTITY_SET, contactId)
+	if err != nil {
+		return nil, err
+	}
+	payload.Contact = contact
+
+	return client.createTask(payload)
+}
+
+func (client *Client) ListOpportunityTasks(ctx context.Context, opportunityId string) ([]*model.Task, error) {
+	return client.listTasks(opportunityId)
+}
+
+func (client *Client) CreateOpportunityTask(ctx context.Context, opportunityId string, input *model.TaskInput) (*model.Task, error) {
+	payload := createD365TaskPayload(input)
+
+	opportunity, err := d365Bind(OPPORTUNITIES_ENTITY_SET, opportunityId)
+	if err != nil {
+		return nil, err
+	}
+	payload.Opportunity = opportunity
+
+	return client.createTask(payload)
+}
+
+func (client *Client) UpdateTask(ctx context.Context, taskId string, input *model.TaskInput) (bool, error) {
+	success, err := client.update(TASKS_ENTITY_SET, taskId, createD365TaskPayload(input))
+	if !success || err != nil {
+		log.Errorf("Error updating task #%s: %s", taskId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) listTasks(objectId string) ([]*model.Task, error) {
+	id, err := parseD365ID(objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := D365TasksListResponse{}
+	if err := client.listWithFilter(d365Tasks, fmt.Sprintf("_regardingobjectid_value eq %s", id), "createdon desc", &response); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, len(response.Value))
+	for i := range response.Value {
+		tasks[i] = response.Value[i].mapTaskProperties()
+	}
+
+	return tasks, nil
+}
+
+func (client *Client) createTask(payload *D365TaskCreateUpdatePayload) (*model.Task, error) {
+	d365Task := D365Task{}
+	if err := client.create(d365Tasks, payload, &d365Task); err != nil {
+		log.Errorf("Error creating task: %s", err)
+		return nil, err
+	}
+
+	return d365Task.mapTaskProperties(), nil
+}
+
+// Creates Dynamics Task Update/Create payload from GraphQL input,
+// the state of the task follows its status so completed tasks can be reopened
+func createD365TaskPayload(input *model.TaskInput) *D365TaskCreateUpdatePayload {
+	payload := D365TaskCreateUpdatePayload{
+		Subject:     input.Subject,
+		Description: input.Description,
+	}
+
+	if input.Status != nil {
+		if statusCode, ok := d365TaskStatusCodes[*input.Status]; ok {
+			stateCode := D365_ACTIVITY_STATE_OPEN
+			if *input.Status == model.TaskStatusCompleted {
+				stateCode = D365_ACTIVITY_STATE_COMPLETED
+			}
+			payload.StateCode = &stateCode
+			payload.StatusCode = &statusCode
+		}
+	}
+
+	if input.Priority != nil {
+		if priorityCode, ok := d365TaskPriorityCodes[*input.Priority]; ok {
+			payload.PriorityCode = &priorityCode
+		}
+	}
+
+	if input.DueDate != nil {
+		dueDate := formatD365DateTime(*input.DueDate)
+		payload.ScheduledEnd = &dueDate
+	}
+
+	return &payload
+}
+
+// Canceled tasks have no unified status, they are deferred
+func (d365Task *D365Task) mapTaskProperties() *model.Task {
+	status := model.TaskStatusDeferred
+	for taskStatus, statusCode := range d365TaskStatusCodes {
+		if statusCode == d365Task.StatusCode {
+			status = taskStatus
+		}
+	}
+
+	task := model.Task{
+		ID:          d365Task.ID,
+		Subject:     d365Task.Subject,
+		Description: d365Task.Description,
+		Status:      &status,
+		DueDate:     parseD365DateTime(d365Task.ScheduledEnd),
+		CreatedAt:   parseD365DateTime(d365Task.CreatedOn),
+		UpdatedAt:   parseD365DateTime(d365Task.ModifiedOn),
+	}
+
+	if d365Task.PriorityCode != nil {
+		for taskPriority, priorityCode := range d365TaskPriorityCodes {
+			if priorityCode == *d365Task.PriorityCode {
+				priority := taskPriority
+				task.Priority = &priority
+			}
+		}
+	}
+
+	return &task
+}
@@ -0,0 +1,106 @@
+package dynamics
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SYSTEM_USERS_ENTITY_SET = "systemusers"
+)
+
+type D365SystemUsersListResponse struct {
+	D365ListResponseBase
+	Value []D365SystemUser `json:"value"`
+}
+
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/systemuser
+type D365SystemUser struct {
+	ID         string  `json:"systemuserid"`
+	FullName   *string `json:"fullname"`
+	FirstName  *string `json:"firstname"`
+	LastName   *string `json:"lastname"`
+	Email      *string `json:"internalemailaddress"`
+	IsDisabled bool    `json:"isdisabled"`
+	CreatedOn  *string `json:"createdon"`
+	ModifiedOn *string `json:"modifiedon"`
+}
+
+var d365SystemUsers = d365Entity{
+	EntitySet: SYSTEM_USERS_ENTITY_SET,
+	Key:       "systemuserid",
+	Fields:    d365SelectFields(D365SystemUser{}),
+}
+
+// Application users of the integrations can't own records, they are left out
+func (client *Client) ListUsers(ctx context.Context, params *connectors.ListParams) (*model.UserConnection, error) {
+	sorts, err := d365Sorts(params.OrderBy, d365UserFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := "applicationid eq null"
+
+	response := D365SystemUsersListResponse{}
+	if err := client.list(d365SystemUsers, params, filter, sorts, &response); err != nil {
+		log.Errorf("Error listing users: %s", err)
+		return nil, err
+	}
+
+	userEdges := make([]*model.UserEdge, len(response.Value))
+	for i := range response.Value {
+		user := response.Value[i].mapUserProperties()
+		userEdges[i] = &model.UserEdge{
+			Cursor: d365RecordCursor(response.Value[i], user.ID, sorts),
+			Node:   user,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &userEdges)
+
+	connection := model.UserConnection{
+		Edges:    recordsValue.Interface().([]*model.UserEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(d365SystemUsers, filter)
+		if err != nil {
+			log.Errorf("Error counting users: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetUser(ctx context.Context, userId string) (*model.User, error) {
+	d365SystemUser := D365SystemUser{}
+	if err := client.get(d365SystemUsers, userId, &d365SystemUser); err != nil {
+		log.Errorf("Error getting user: %s", err)
+		return nil, err
+	}
+
+	return d365SystemUser.mapUserProperties(), nil
+}
+
+func (d365SystemUser *D365SystemUser) mapUserProperties() *model.User {
+	active := !d365SystemUser.IsDisabled
+	archived := d365SystemUser.IsDisabled
+
+	return &model.User{
+		ID:        d365SystemUser.ID,
+		Name:      d365SystemUser.FullName,
+		FirstName: d365SystemUser.FirstName,
+		LastName:  d365SystemUser.LastName,
+		Email:     d365SystemUser.Email,
+		Active:    &active,
+		Archived:  &archived,
+		CreatedAt: parseD365DateTime(d365SystemUser.CreatedOn),
+		UpdatedAt: parseD365DateTime(d365SystemUser.ModifiedOn),
+	}
+}
@@ -0,0 +1,180 @@
+package connectors
+
+import (
+	"blendbase/graph/model"
+	"errors"
+)
+
+const (
+	FILTER_OPERATOR_EQ       = "eq"
+	FILTER_OPERATOR_IN       = "in"
+	FILTER_OPERATOR_CONTAINS = "contains"
+	FILTER_OPERATOR_GTE      = "gte"
+	FILTER_OPERATOR_LTE      = "lte"
+)
+
+// Arguments of the list queries, lists are paginated backwards from the "Before" cursor when "Last" is given
+type ListParams struct {
+	First             int
+	After             *string
+	Last              *int
+	Before            *string
+	Filter            *Filter
+	Query             *string // free-text search
+	OrderBy           []*model.SortInput
+	IncludeTotalCount bool // counting the records may take another request
+}
+
+func (params *ListParams) Backward() bool {
+	return params.Last != nil
+}
+
+// Page size in the direction of the pagination
+func (params *ListParams) Limit() int {
+	if params.Backward() {
+		return *params.Last
+	}
+
+	return params.First
+}
+
+func (params *ListParams) Validate() error {
+	if params.Before != nil && params.Last == nil {
+		return errors.New("before can only be used with last")
+	}
+	if params.After != nil && params.Last != nil {
+		return errors.New("after can't be used with last")
+	}
+	if params.Last != nil && *params.Last < 0 {
+		return errors.New("last can't be negative")
+	}
+
+	return nil
+}
+
+// CRM-agnostic filter tree, connectors compile it to their query languages.
+// All the conditions and the "And" filters have to match, at least one of the "Or" filters has to match when given.
+type Filter struct {
+	Conditions []FilterCondition
+	And        []*Filter
+	Or         []*Filter
+}
+
+type FilterCondition struct {
+	Field    string      // unified field name, e.g. "email"
+	Operator string      // e.g. FILTER_OPERATOR_EQ
+	Value    interface{} // string, []string or time.Time
+}
+
+func NewContactFilter(input *model.ContactFilter) *Filter {
+	if input == nil {
+		return nil
+	}
+
+	filter := Filter{}
+	filter.addStringConditions("firstName", input.FirstName)
+	filter.addStringConditions("lastName", input.LastName)
+	filter.addStringConditions("email", input.Email)
+	filter.addStringConditions("phone", input.Phone)
+	filter.addIDConditions("companyId", input.CompanyID)
+	filter.addIDConditions("ownerId", input.OwnerID)
+	filter.addDateTimeConditions("createdAt", input.CreatedAt)
+	filter.addDateTimeConditions("updatedAt", input.UpdatedAt)
+
+	for _, and := range input.And {
+		filter.And = append(filter.And, NewContactFilter(and))
+	}
+	for _, or := range input.Or {
+		filter.Or = append(filter.Or, NewContactFilter(or))
+	}
+
+	return &filter
+}
+
+func NewOpportunityFilter(input *model.OpportunityFilter) *Filter {
+	if input == nil {
+		return nil
+	}
+
+	filter := Filter{}
+	filter.addStringConditions("name", input.Name)
+	filter.addStringConditions("stageName", input.StageName)
+	filter.addIDConditions("pipelineId", input.PipelineID)
+	filter.addIDConditions("companyId", input.CompanyID)
+	filter.addIDConditions("ownerId", input.OwnerID)
+	filter.addDecimalConditions("amount", input.Amount)
+	filter.addDateTimeConditions("closeDate", input.CloseDate)
+	filter.addDateTimeConditions("createdAt", input.CreatedAt)
+	filter.addDateTimeConditions("updatedAt", input.UpdatedAt)
+
+	for _, and := range input.And {
+		filter.And = append(filter.And, NewOpportunityFilter(and))
+	}
+	for _, or := range input.Or {
+		filter.Or = append(filter.Or, NewOpportunityFilter(or))
+	}
+
+	return &filter
+}
+
+func (filter *Filter) addCondition(field string, operator string, value interface{}) {
+	filter.Conditions = append(filter.Conditions, FilterCondition{Field: field, Operator: operator, Value: value})
+}
+
+func (filter *Filter) addStringConditions(field string, input *model.StringFilter) {
+	if input == nil {
+		return
+	}
+
+	if input.Eq != nil {
+		filter.addCondition(field, FILTER_OPERATOR_EQ, *input.Eq)
+	}
+	if input.In != nil {
+		filter.addCondition(field, FILTER_OPERATOR_IN, input.In)
+	}
+	if input.Contains != nil {
+		filter.addCondition(field, FILTER_OPERATOR_CONTAINS, *input.Contains)
+	}
+}
+
+func (filter *Filter) addIDConditions(field string, input *model.IDFilter) {
+	if input == nil {
+		return
+	}
+
+	if input.Eq != nil {
+		filter.addCondition(field, FILTER_OPERATOR_EQ, *input.Eq)
+	}
+	if input.In != nil {
+		filter.addCondition(field, FILTER_OPERATOR_IN, input.In)
+	}
+}
+
+func (filter *Filter) addDecimalConditions(field string, input *model.DecimalFilter) {
+	if input == nil {
+		return
+	}
+
+	if input.Eq != nil {
+		filter.addCondition(field, FILTER_OPERATOR_EQ, *input.Eq)
+	}
+	if input.Gte != nil {
+		filter.addCondition(field, FILTER_OPERATOR_GTE, *input.Gte)
+	}
+	if input.Lte != nil {
+		filter.addCondition(field, FILTER_OPERATOR_LTE, *input.Lte)
+	}
+}
+
+func (filter *Filter) addDateTimeConditions(field string, input *model.DateTimeFilter) {
+	if input == nil {
+		return
+	}
+
+	if input.Gte != nil {
+		filter.addCondition(field, FILTER_OPERATOR_GTE, *input.Gte)
+	}
+	if input.Lte != nil {
+		filter.addCondition(field, FILTER_OPERATOR_LTE, *input.Lte)
+	}
+}
@@ -0,0 +1,204 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strconv"
+	"time"
+)
+
+// Calls, meetings and emails are separate engagement objects in HubSpot
+// https://developers.hubspot.com/docs/api/crm/engagements
+type hsActivityObject struct {
+	Type            model.ActivityType
+	ObjectPath      string
+	AssociationName string // prefix of the association types, e.g. "call" for "call_to_contact"
+	SubjectProperty string
+	BodyProperty    string
+	StartProperty   string
+	EndProperty     string // empty when the object has no end time
+}
+
+var hsActivityObjects = []hsActivityObject{
+	{
+		Type:            model.ActivityTypeCall,
+		ObjectPath:      "calls",
+		AssociationName: "call",
+		SubjectProperty: "hs_call_title",
+		BodyProperty:    "hs_call_body",
+		StartProperty:   "hs_timestamp",
+	},
+	{
+		Type:            model.ActivityTypeMeeting,
+		ObjectPath:      "meetings",
+		AssociationName: "meeting_event",
+		SubjectProperty: "hs_meeting_title",
+		BodyProperty:    "hs_meeting_body",
+		StartProperty:   "hs_meeting_start_time",
+		EndProperty:     "hs_meeting_end_time",
+	},
+	{
+		Type:            model.ActivityTypeEmail,
+		ObjectPath:      "emails",
+		AssociationName: "email",
+		SubjectProperty: "hs_email_subject",
+		BodyProperty:    "hs_email_text",
+		StartProperty:   "hs_timestamp",
+	},
+}
+
+// Engagement properties differ between the objects, so they are read into a map
+type HSActivity struct {
+	Id         string             `json:"id"`
+	CreatedAt  *string            `json:"createdAt"`
+	UpdatedAt  *string            `json:"updatedAt"`
+	Archived   bool               `json:"archived"`
+	Properties map[string]*string `json:"properties"`
+}
+
+type HSActivityCreatePayload struct {
+	Properties map[string]string `json:"properties"`
+}
+
+type HSActivitiesListSuccessResponse struct {
+	Results []HSActivity `json:"results"`
+}
+
+func (client *Client) ListContactActivities(ctx context.Context, contactId string) ([]*model.Activity, error) {
+	return client.listActivities(ctx, "contacts", contactId)
+}
+
+func (client *Client) CreateContactActivity(ctx context.Context, contactId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivityAndAssociate(ctx, "contacts", contactId, "contact", input)
+}
+
+func (client *Client) ListOpportunityActivities(ctx context.Context, opportunityId string) ([]*model.Activity, error) {
+	return client.listActivities(ctx, "deals", opportunityId)
+}
+
+func (client *Client) CreateOpportunityActivity(ctx context.Context, opportunityId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivityAndAssociate(ctx, "deals", opportunityId, "deal", input)
+}
+
+func (client *Client) listActivities(ctx context.Context, objectPath string, objectId string) ([]*model.Activity, error) {
+	activities := []*model.Activity{}
+
+	for _, activityObject := range hsActivityObjects {
+		activityIds, err := client.listAssociations(ctx, objectPath, objectId, activityObject.ObjectPath)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, chunk := range chunkIds(activityIds, HS_BATCH_READ_LIMIT) {
+			response := HSActivitiesListSuccessResponse{}
+			if err := client.batchRead(ctx, activityObject.ObjectPath, chunk, activityObject.properties(), &response); err != nil {
+				return nil, err
+			}
+
+			for i := range response.Results {
+				activities = append(activities, activityObject.mapActivityProperties(&response.Results[i]))
+			}
+		}
+	}
+
+	connectors.SortActivities(activities)
+
+	return activities, nil
+}
+
+// relatedObjectType is the singular object name used in the association type, e.g. "contact"
+func (client *Client) createActivityAndAssociate(ctx context.Context, relatedObjectName string, relatedObjectId string, relatedObjectType string, input *model.ActivityInput) (*model.Activity, error) {
+	activityObject := hsActivityObjectFor(input.Type)
+
+	startTime := time.Now().UTC()
+	if input.StartTime != nil {
+		startTime = input.StartTime.UTC()
+	}
+
+	properties := map[string]string{
+		"hs_timestamp":               formatHSDateTime(startTime),
+		activityObject.StartProperty: formatHSDateTime(startTime),
+	}
+	if input.Subject != nil {
+		properties[activityObject.SubjectProperty] = *input.Subject
+	}
+	if input.Description != nil {
+		properties[activityObject.BodyProperty] = *input.Description
+	}
+
+	switch input.Type {
+	case model.ActivityTypeCall:
+		properties["hs_call_status"] = "COMPLETED"
+		if input.EndTime != nil {
+			properties["hs_call_duration"] = strconv.FormatInt(input.EndTime.Sub(startTime).Milliseconds(), 10)
+		}
+	case model.ActivityTypeMeeting:
+		if input.EndTime != nil {
+			properties[activityObject.EndProperty] = formatHSDateTime(input.EndTime.UTC())
+		}
+	case model.ActivityTypeEmail:
+		// logged outgoing email
+		properties["hs_email_direction"] = "EMAIL"
+		properties["hs_email_status"] = "SENT"
+	}
+
+	hsActivity := HSActivity{}
+	if err := client.create(ctx, activityObject.ObjectPath, &HSActivityCreatePayload{Properties: properties}, &hsActivity); err != nil {
+		return nil, err
+	}
+
+	associationName := activityObject.AssociationName + "_to_" + relatedObjectType
+	if err := client.associate(ctx, activityObject.ObjectPath, hsActivity.Id, relatedObjectName, relatedObjectId, associationName); err != nil {
+		return nil, err
+	}
+
+	return activityObject.mapActivityProperties(&hsActivity), nil
+}
+
+func hsActivityObjectFor(activityType model.ActivityType) hsActivityObject {
+	for _, activityObject := range hsActivityObjects {
+		if activityObject.Type == activityType {
+			return activityObject
+		}
+	}
+
+	return hsActivityObjects[0]
+}
+
+func (activityObject hsActivityObject) properties() []string {
+	props := []string{activityObject.SubjectProperty, activityObject.BodyProperty, activityObject.StartProperty}
+	if activityObject.EndProperty != "" {
+		props = append(props, activityObject.EndProperty)
+	}
+	if activityObject.Type == model.ActivityTypeCall {
+		props = append(props, "hs_call_duration")
+	}
+
+	return props
+}
+
+func (activityObject hsActivityObject) mapActivityProperties(hsActivity *HSActivity) *model.Activity {
+	activity := model.Activity{
+		ID:          hsActivity.Id,
+		Type:        activityObject.Type,
+		Subject:     hsActivity.Properties[activityObject.SubjectProperty],
+		Description: hsActivity.Properties[activityObject.BodyProperty],
+		StartTime:   parseHSDateTime(hsActivity.Properties[activityObject.StartProperty]),
+		CreatedAt:   parseHSDateTime(hsActivity.CreatedAt),
+		UpdatedAt:   parseHSDateTime(hsActivity.UpdatedAt),
+	}
+
+	if activityObject.EndProperty != "" {
+		activity.EndTime = parseHSDateTime(hsActivity.Properties[activityObject.EndProperty])
+	}
+
+	if callDuration := hsActivity.Properties["hs_call_duration"]; activity.StartTime != nil && callDuration != nil {
+		if milliseconds, err := strconv.ParseInt(*callDuration, 10, 64); err == nil {
+			endTime := activity.StartTime.Add(time.Duration(milliseconds) * time.Millisecond)
+			activity.EndTime = &endTime
+		}
+	}
+
+	return &activity
+}
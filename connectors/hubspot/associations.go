@@ -0,0 +1,132 @@
+package hubspot
+
+import (
+	"blendbase/graph/model"
+	"context"
+)
+
+// Maximum number of inputs accepted by the batch read endpoints
+const HS_BATCH_READ_LIMIT = 100
+
+func (client *Client) LinkContactToCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	if err := client.associate(ctx, "contacts", contactId, "companies", companyId, "contact_to_company"); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) UnlinkContactFromCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	if err := client.disassociate(ctx, "contacts", contactId, "companies", companyId, "contact_to_company"); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) LinkOpportunityToCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	if err := client.associate(ctx, "deals", opportunityId, "companies", companyId, "deal_to_company"); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) UnlinkOpportunityFromCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	if err := client.disassociate(ctx, "deals", opportunityId, "companies", companyId, "deal_to_company"); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Associates the object with the company in place of its other companies, like the account of a Salesforce record
+func (client *Client) replaceCompany(ctx context.Context, objectPath string, objectId string, companyId string, associationType string) (bool, error) {
+	companyIds, err := client.listAssociations(ctx, objectPath, objectId, "companies")
+	if err != nil {
+		return false, err
+	}
+
+	for _, linkedCompanyId := range companyIds {
+		if linkedCompanyId == companyId {
+			continue
+		}
+		if err := client.disassociate(ctx, objectPath, objectId, "companies", linkedCompanyId, associationType); err != nil {
+			return false, err
+		}
+	}
+
+	if containsString(companyIds, companyId) {
+		return true, nil
+	}
+	if err := client.associate(ctx, objectPath, objectId, "companies", companyId, associationType); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) ListCompanyContacts(ctx context.Context, companyId string) ([]*model.Contact, error) {
+	return client.listAssociatedContacts(ctx, "companies", companyId)
+}
+
+func (client *Client) ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error) {
+	return client.listAssociatedContacts(ctx, "deals", opportunityId)
+}
+
+func (client *Client) ListCompanyOpportunities(ctx context.Context, companyId string) ([]*model.Opportunity, error) {
+	dealIds, err := client.listAssociations(ctx, "companies", companyId, "deals")
+	if err != nil {
+		return nil, err
+	}
+
+	opportunities := []*model.Opportunity{}
+	for _, chunk := range chunkIds(dealIds, HS_BATCH_READ_LIMIT) {
+		response := HSDealsListSuccessResponse{}
+		if err := client.batchRead(ctx, "deals", chunk, client.hsDealProperties(), &response); err != nil {
+			return nil, err
+		}
+
+		for i := range response.Results {
+			opportunity := client.mapOpportunity(&response.Results[i])
+			opportunity.Company = &model.Company{ID: companyId}
+			opportunities = append(opportunities, opportunity)
+		}
+	}
+
+	return opportunities, nil
+}
+
+func (client *Client) listAssociatedContacts(ctx context.Context, objectPath string, objectId string) ([]*model.Contact, error) {
+	contactIds, err := client.listAssociations(ctx, objectPath, objectId, "contacts")
+	if err != nil {
+		return nil, err
+	}
+
+	contacts := []*model.Contact{}
+	for _, chunk := range chunkIds(contactIds, HS_BATCH_READ_LIMIT) {
+		response := HSContactsListSuccessResponse{}
+		if err := client.batchRead(ctx, "contacts", chunk, client.hsContactProperties(), &response); err != nil {
+			return nil, err
+		}
+
+		for i := range response.Results {
+			contacts = append(contacts, client.mapContact(&response.Results[i]))
+		}
+	}
+
+	return contacts, nil
+}
+
+func chunkIds(ids []string, size int) [][]string {
+	chunks := [][]string{}
+	for size < len(ids) {
+		ids, chunks = ids[size:], append(chunks, ids[0:size])
+	}
+
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+
+	return chunks
+}
@@ -0,0 +1,64 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/integrations"
+
+	log "github.com/sirupsen/logrus"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	HSAuthUrl  = "https://app.hubspot.com/oauth/authorize"
+	HSTokenUrl = "https://api.hubapi.com/oauth/v1/token"
+)
+
+// Scopes of the objects the connector reads and writes, the scopes of the public app have to include them
+// https://developers.hubspot.com/docs/api/scopes
+var hsOAuth2Scopes = []string{
+	"oauth",
+	"crm.objects.contacts.read",
+	"crm.objects.contacts.write",
+	"crm.objects.companies.read",
+	"crm.objects.companies.write",
+	"crm.objects.deals.read",
+	"crm.objects.deals.write",
+	"crm.objects.owners.read",
+	"crm.schemas.contacts.read",
+	"crm.schemas.companies.read",
+	"crm.schemas.deals.read",
+}
+
+var oauth2Flow = connectors.OAuth2Flow{
+	Config: getOAuthConfig,
+}
+
+// HubSpot keeps the refresh token when the access token is refreshed
+func (client *Client) refreshToken() error {
+	log.Info("HubSpot refreshing token")
+
+	accessToken, err := client.refreshAccessToken()
+	if err != nil {
+		return err
+	}
+
+	client.AccessToken = accessToken
+
+	return nil
+}
+
+// HubSpot reads the client credentials from the body of the token requests
+func getOAuthConfig(consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *oauth2.Config {
+	return &oauth2.Config{
+		RedirectURL:  consumerOAuthConfig.RedirectURL,
+		ClientID:     consumerOAuthConfig.ClientID.Raw,
+		ClientSecret: consumerOAuthConfig.ClientSecret.Raw,
+		Scopes:       hsOAuth2Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   HSAuthUrl,
+			TokenURL:  HSTokenUrl,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+}
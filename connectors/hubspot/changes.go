@@ -0,0 +1,267 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	HS_ARCHIVED_PAGE_LIMIT = 100
+)
+
+// Object with the fields common to all the object types
+type HSObject struct {
+	Id         string  `json:"id"`
+	CreatedAt  string  `json:"createdAt"`
+	UpdatedAt  string  `json:"updatedAt"`
+	ArchivedAt *string `json:"archivedAt"`
+}
+
+type HSChangesSearchSuccessResponse struct {
+	Results []json.RawMessage `json:"results"`
+}
+
+type HSArchivedObjectsListSuccessResponse struct {
+	Results []HSObject `json:"results"`
+	Paging  *struct {
+		Next *struct {
+			After string `json:"after"`
+		} `json:"next"`
+	} `json:"paging"`
+}
+
+type hsChangeSource struct {
+	objectType        model.ChangeObjectType
+	objectPath        string
+	updatedAtProperty string
+	properties        []string
+	mapChange         func(params *connectors.ChangesParams, result json.RawMessage) (*model.Change, error)
+}
+
+// Updated objects are searched by the last modification date, deleted objects are read from the archived objects.
+// HubSpot doesn't filter the archived objects, so all of them are read for every page of the feed.
+func (client *Client) ListChanges(ctx context.Context, params *connectors.ChangesParams) (*model.ChangeConnection, error) {
+	sources := []hsChangeSource{
+		{model.ChangeObjectTypeContact, "contacts", "lastmodifieddate", client.hsContactProperties(), client.mapContactChange},
+		{model.ChangeObjectTypeNote, "notes", "hs_lastmodifieddate", []string{"hs_note_body", "hs_createdate"}, client.mapNoteChange},
+		{model.ChangeObjectTypeOpportunity, "deals", "hs_lastmodifieddate", client.hsDealProperties(), client.mapOpportunityChange},
+	}
+
+	batches := []*connectors.ChangeBatch{}
+	for _, source := range sources {
+		if !params.Includes(source.objectType) {
+			continue
+		}
+
+		batch, err := client.listUpdatedChanges(ctx, source, params)
+		if err != nil {
+			return nil, err
+		}
+
+		archivedBatch, err := client.listArchivedChanges(ctx, source, params)
+		if err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, batch, archivedBatch)
+	}
+
+	return connectors.NewChangeConnection(params, batches), nil
+}
+
+func (client *Client) listUpdatedChanges(ctx context.Context, source hsChangeSource, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	bound := params.Bound(source.objectType)
+	payload := HSSearchPayload{
+		FilterGroups: hsChangesFilterGroups(source.updatedAtProperty, bound),
+		Sorts:        []HSSearchSort{{PropertyName: source.updatedAtProperty, Direction: "ASCENDING"}},
+		Properties:   source.properties,
+	}
+
+	changes, err := client.searchChanges(ctx, source, params, &payload)
+	if err != nil || len(changes) <= params.First {
+		return &connectors.ChangeBatch{Changes: changes}, err
+	}
+
+	// search sorts by a single property, objects modified at the same time come in any order,
+	// so the objects modified at the time of the last one may be missing from the results
+	lastChangedAt := changes[len(changes)-1].ChangedAt
+	complete := []*model.Change{}
+	for _, change := range changes {
+		if change.ChangedAt.Before(lastChangedAt) {
+			complete = append(complete, change)
+		}
+	}
+
+	if len(complete) > 0 {
+		return &connectors.ChangeBatch{Changes: complete, HasMore: true}, nil
+	}
+
+	// all of the objects were modified at the same time, they're read in the order of the IDs then
+	tieBound := connectors.ChangeBound{Time: lastChangedAt}
+	if bound.Time.Equal(lastChangedAt) {
+		tieBound.After = bound.After
+	}
+	payload.FilterGroups = []HSSearchFilterGroup{hsChangesTieFilterGroup(source.updatedAtProperty, tieBound)}
+	payload.Sorts = []HSSearchSort{{PropertyName: "hs_object_id", Direction: "ASCENDING"}}
+
+	changes, err = client.searchChanges(ctx, source, params, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+// Reads a single page of the search results, the page has one more object to see if there are more
+func (client *Client) searchChanges(ctx context.Context, source hsChangeSource, params *connectors.ChangesParams, payload *HSSearchPayload) ([]*model.Change, error) {
+	response := HSChangesSearchSuccessResponse{}
+	if _, err := client.search(ctx, source.objectPath, &connectors.ListParams{First: params.First}, payload, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Results))
+	for i, result := range response.Results {
+		change, err := source.mapChange(params, result)
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = change
+	}
+
+	return changes, nil
+}
+
+func (client *Client) listArchivedChanges(ctx context.Context, source hsChangeSource, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	bound := params.Bound(source.objectType)
+	changes := []*model.Change{}
+
+	query := url.Values{}
+	query.Set("archived", "true")
+	query.Set("limit", fmt.Sprint(HS_ARCHIVED_PAGE_LIMIT))
+
+	for {
+		url := fmt.Sprintf("%s/%s?%s", client.BaseURL, source.objectPath, query.Encode())
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := HSArchivedObjectsListSuccessResponse{}
+
+		req = req.WithContext(ctx)
+		if err := client.sendRequest(req, &response); err != nil {
+			return nil, err
+		}
+
+		for _, hsObject := range response.Results {
+			archivedAt := parseHSDateTime(hsObject.ArchivedAt)
+			if archivedAt == nil || archivedAt.Before(bound.Time) {
+				continue
+			}
+
+			changes = append(changes, &model.Change{
+				ObjectType: source.objectType,
+				ObjectID:   hsObject.Id,
+				ChangeType: model.ChangeTypeDeleted,
+				ChangedAt:  *archivedAt,
+			})
+		}
+
+		if response.Paging == nil || response.Paging.Next == nil {
+			return &connectors.ChangeBatch{Changes: changes}, nil
+		}
+		query.Set("after", response.Paging.Next.After)
+	}
+}
+
+// Filter groups matching the objects modified after the bound
+func hsChangesFilterGroups(updatedAtProperty string, bound connectors.ChangeBound) []HSSearchFilterGroup {
+	boundTime := hsTimestamp(bound.Time)
+
+	if bound.After != nil {
+		return []HSSearchFilterGroup{
+			{Filters: []HSSearchFilter{{PropertyName: updatedAtProperty, Operator: "GT", Value: &boundTime}}},
+			hsChangesTieFilterGroup(updatedAtProperty, bound),
+		}
+	}
+
+	operator := "GTE"
+	if bound.Exclusive {
+		operator = "GT"
+	}
+
+	return []HSSearchFilterGroup{
+		{Filters: []HSSearchFilter{{PropertyName: updatedAtProperty, Operator: operator, Value: &boundTime}}},
+	}
+}
+
+// Filter group matching the objects modified at the bound time, with greater IDs when the bound has one
+func hsChangesTieFilterGroup(updatedAtProperty string, bound connectors.ChangeBound) HSSearchFilterGroup {
+	boundTime := hsTimestamp(bound.Time)
+	group := HSSearchFilterGroup{
+		Filters: []HSSearchFilter{{PropertyName: updatedAtProperty, Operator: "EQ", Value: &boundTime}},
+	}
+
+	if bound.After != nil {
+		group.Filters = append(group.Filters, HSSearchFilter{PropertyName: "hs_object_id", Operator: "GT", Value: bound.After})
+	}
+
+	return group
+}
+
+// Date properties are compared using Unix timestamps in milliseconds
+func hsTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+func (client *Client) mapContactChange(params *connectors.ChangesParams, result json.RawMessage) (*model.Change, error) {
+	hsContact := HSContact{}
+	if err := json.Unmarshal(result, &hsContact); err != nil {
+		return nil, err
+	}
+
+	contact := client.mapContact(&hsContact)
+	change := params.NewChange(model.ChangeObjectTypeContact, contact.ID, contact.CreatedAt, contact.UpdatedAt)
+	change.Contact = contact
+
+	return change, nil
+}
+
+func (client *Client) mapNoteChange(params *connectors.ChangesParams, result json.RawMessage) (*model.Change, error) {
+	hsNote := HSNote{}
+	if err := json.Unmarshal(result, &hsNote); err != nil {
+		return nil, err
+	}
+
+	if hsNote.Properties.HsNoteBody == nil {
+		emptyBody := ""
+		hsNote.Properties.HsNoteBody = &emptyBody
+	}
+
+	note := client.mapNoteProperties(&hsNote)
+	change := params.NewChange(model.ChangeObjectTypeNote, note.ID, note.CreatedAt, note.UpdatedAt)
+	change.Note = note
+
+	return change, nil
+}
+
+func (client *Client) mapOpportunityChange(params *connectors.ChangesParams, result json.RawMessage) (*model.Change, error) {
+	hsDeal := HSDeal{}
+	if err := json.Unmarshal(result, &hsDeal); err != nil {
+		return nil, err
+	}
+
+	opportunity := client.mapOpportunity(&hsDeal)
+	change := params.NewChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.CreatedAt, opportunity.UpdatedAt)
+	change.Opportunity = opportunity
+
+	return change, nil
+}
@@ -0,0 +1,176 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strconv"
+)
+
+type HSCompany struct {
+	Id        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Archived  bool   `json:"archived"`
+
+	Properties struct {
+		Name              string  `json:"name"`
+		Domain            string  `json:"domain"`
+		Website           string  `json:"website"`
+		Phone             string  `json:"phone"`
+		Industry          string  `json:"industry"`
+		Description       string  `json:"description"`
+		City              string  `json:"city"`
+		Country           string  `json:"country"`
+		NumberOfEmployees *string `json:"numberofemployees"`
+		AnnualRevenue     *string `json:"annualrevenue"`
+		HubspotOwnerId    *string `json:"hubspot_owner_id"`
+	} `json:"properties"`
+}
+
+type HSCompanyCreateUpdatePayload struct {
+	Properties struct {
+		Name              string  `json:"name"`
+		Website           *string `json:"website,omitempty"`
+		Phone             *string `json:"phone,omitempty"`
+		Industry          *string `json:"industry,omitempty"`
+		Description       *string `json:"description,omitempty"`
+		City              *string `json:"city,omitempty"`
+		Country           *string `json:"country,omitempty"`
+		NumberOfEmployees *string `json:"numberofemployees,omitempty"`
+		AnnualRevenue     *string `json:"annualrevenue,omitempty"`
+		HubspotOwnerId    *string `json:"hubspot_owner_id,omitempty"`
+	} `json:"properties"`
+}
+
+type HSCompaniesListSuccessResponse struct {
+	Results []HSCompany `json:"results"`
+}
+
+var hsCompanyProperties = []string{
+	"name", "domain", "website", "phone", "industry", "description", "city", "country", "numberofemployees", "annualrevenue", "hubspot_owner_id",
+}
+
+// List companies from Hubspot API
+func (client *Client) ListCompanies(ctx context.Context, params *connectors.ListParams) (*model.CompanyConnection, error) {
+	response := HSCompaniesListSuccessResponse{}
+	offset, err := client.listOrSearch(ctx, "companies", params, hsCompanyProperties, nil, hsCompanyFilterFields, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	companyEdges := make([]*model.CompanyEdge, len(response.Results))
+
+	var company *model.Company
+	for i, hsCompany := range response.Results {
+		company = hsCompany.mapCompanyProperties()
+		companyEdges[i] = &model.CompanyEdge{
+			Node:   company,
+			Cursor: connectors.EncodeCursor(company.ID),
+		}
+
+		if offset != nil {
+			companyEdges[i].Cursor = searchCursor(*offset + i)
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &companyEdges)
+
+	return &model.CompanyConnection{
+		Edges:    recordsValue.Interface().([]*model.CompanyEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetCompany(ctx context.Context, companyId string) (*model.Company, error) {
+	response := HSCompany{}
+	if err := client.get(ctx, "companies", companyId, hsCompanyProperties, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.mapCompanyProperties(), nil
+}
+
+func (client *Client) CreateCompany(ctx context.Context, input *model.CompanyInput) (*model.Company, error) {
+	payload := createHSCompanyPayload(input)
+
+	response := HSCompany{}
+	if err := client.create(ctx, "companies", payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response.mapCompanyProperties(), nil
+}
+
+func (client *Client) UpdateCompany(ctx context.Context, companyId string, input *model.CompanyInput) (bool, error) {
+	payload := createHSCompanyPayload(input)
+
+	response := HSCompany{}
+	if err := client.update(ctx, "companies", companyId, payload, &response); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteCompany(ctx context.Context, companyId string) (bool, error) {
+	response := HSCompany{}
+	if err := client.delete(ctx, "companies", companyId, &response); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Creates Hubspot Company Update/Create payload from GraphQL input
+func createHSCompanyPayload(input *model.CompanyInput) *HSCompanyCreateUpdatePayload {
+	payload := HSCompanyCreateUpdatePayload{}
+
+	payload.Properties.Name = input.Name
+	payload.Properties.Website = input.Website
+	payload.Properties.Phone = input.Phone
+	payload.Properties.Industry = input.Industry
+	payload.Properties.Description = input.Description
+	payload.Properties.City = input.City
+	payload.Properties.Country = input.Country
+	payload.Properties.AnnualRevenue = input.AnnualRevenue
+	payload.Properties.HubspotOwnerId = input.OwnerID
+
+	if input.NumberOfEmployees != nil {
+		numberOfEmployees := strconv.Itoa(*input.NumberOfEmployees)
+		payload.Properties.NumberOfEmployees = &numberOfEmployees
+	}
+
+	return &payload
+}
+
+func (hsCompany HSCompany) mapCompanyProperties() *model.Company {
+	website := hsCompany.Properties.Website
+	if website == "" {
+		website = hsCompany.Properties.Domain
+	}
+
+	company := model.Company{
+		ID:            hsCompany.Id,
+		Name:          hsCompany.Properties.Name,
+		Website:       &website,
+		Phone:         &hsCompany.Properties.Phone,
+		Industry:      &hsCompany.Properties.Industry,
+		Description:   &hsCompany.Properties.Description,
+		City:          &hsCompany.Properties.City,
+		Country:       &hsCompany.Properties.Country,
+		AnnualRevenue: hsCompany.Properties.AnnualRevenue,
+		Owner:         hsOwnerReference(hsCompany.Properties.HubspotOwnerId),
+		CreatedAt:     parseHSDateTime(&hsCompany.CreatedAt),
+		UpdatedAt:     parseHSDateTime(&hsCompany.UpdatedAt),
+		Archived:      &hsCompany.Archived,
+	}
+
+	if hsCompany.Properties.NumberOfEmployees != nil {
+		if numberOfEmployees, err := strconv.Atoi(*hsCompany.Properties.NumberOfEmployees); err == nil {
+			company.NumberOfEmployees = &numberOfEmployees
+		}
+	}
+
+	return &company
+}
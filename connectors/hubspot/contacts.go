@@ -22,22 +22,38 @@ type HSContact struct {
 		LastName         string `json:"lastname"`
 		HSObjectID       string `json:"hs_object_id"`
 		LastModifiedDate string `json:"lastmodifieddate"`
+		HubspotOwnerId   string `json:"hubspot_owner_id"`
 	} `json:"properties"`
+
+	Associations *HSObjectAssociations `json:"associations"`
+
+	RawProperties map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (hsContact *HSContact) UnmarshalJSON(data []byte) error {
+	type plainHSContact HSContact
+	rawFields := struct {
+		Properties *map[string]interface{} `json:"properties"`
+	}{&hsContact.RawProperties}
+
+	return connectors.UnmarshalWithRawFields(data, (*plainHSContact)(hsContact), &rawFields)
 }
 
 type HSContactCreateUpdatePayload struct {
 	Properties struct {
-		Company   *string `json:"company,omitempty"`
-		FirstName *string `json:"firstname,omitempty"`
-		LastName  *string `json:"lastname,omitempty"`
-		Email     *string `json:"email,omitempty"`
-		Phone     *string `json:"phone,omitempty"`
-		Website   *string `json:"website,omitempty"`
+		Company        *string `json:"company,omitempty"`
+		FirstName      *string `json:"firstname,omitempty"`
+		LastName       *string `json:"lastname,omitempty"`
+		Email          *string `json:"email,omitempty"`
+		Phone          *string `json:"phone,omitempty"`
+		Website        *string `json:"website,omitempty"`
+		HubspotOwnerId *string `json:"hubspot_owner_id,omitempty"`
 	} `json:"properties"`
 }
 
 type HSContactsListSuccessResponse struct {
 	Results []HSContact `json:"results"`
+	Total   int         `json:"total"` // only returned by the search endpoint
 }
 
 type HSContactUpdateSuccessResponse struct {
@@ -48,10 +64,19 @@ type HSContactUpdateSuccessResponse struct {
 	Archived   bool      `json:"archived"`
 }
 
+var hsContactProperties = []string{
+	"company", "phone", "website", "createddate", "email", "firstname", "lastname", "hs_object_id", "lastmodifieddate", "hubspot_owner_id",
+}
+
+// Properties of the contacts to read, including the custom fields
+func (client *Client) hsContactProperties() []string {
+	return client.FieldMapping.QueryFields(model.CrmObjectContact, hsContactProperties)
+}
+
 // List contacts from Hubspot API
-func (client *Client) ListContacts(ctx context.Context, first int, after *string) (*model.ContactConnection, error) {
+func (client *Client) ListContacts(ctx context.Context, params *connectors.ListParams) (*model.ContactConnection, error) {
 	response := HSContactsListSuccessResponse{}
-	err := client.list(ctx, "contacts", first, after, connectors.StructFieldNames(HSContact{}.Properties), &response)
+	offset, err := client.listOrSearch(ctx, "contacts", params, client.hsContactProperties(), []string{"companies"}, hsContactFilterFields, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -59,31 +84,41 @@ func (client *Client) ListContacts(ctx context.Context, first int, after *string
 	contactEdges := make([]*model.ContactEdge, len(response.Results))
 
 	var contact *model.Contact
-	for i, hsContact := range response.Results {
-		contact = hsContact.mapContactProperties()
+	for i := range response.Results {
+		contact = client.mapContact(&response.Results[i])
 		contactEdges[i] = &model.ContactEdge{
 			Node:   contact,
 			Cursor: connectors.EncodeCursor(contact.ID),
 		}
+
+		if offset != nil {
+			contactEdges[i].Cursor = searchCursor(*offset + i)
+		}
 	}
 
-	recordsValue, pageInfo := client.prepareListResults(first, after, &contactEdges)
+	recordsValue, pageInfo := client.prepareListResults(params, &contactEdges)
 
-	return &model.ContactConnection{
+	connection := model.ContactConnection{
 		Edges:    recordsValue.Interface().([]*model.ContactEdge),
 		PageInfo: pageInfo,
-	}, nil
+	}
+
+	if params.IncludeTotalCount {
+		connection.TotalCount = &response.Total
+	}
+
+	return &connection, nil
 }
 
 // --------------------------------------------------
 // Contact:Get contact by ID
 func (client *Client) GetContact(ctx context.Context, contactId string) (*model.Contact, error) {
 	response := HSContact{}
-	if err := client.get(ctx, "contacts", contactId, connectors.StructFieldNames(HSContact{}.Properties), &response); err != nil {
+	if err := client.get(ctx, "contacts", contactId, client.hsContactProperties(), []string{"companies"}, &response); err != nil {
 		return nil, err
 	}
 
-	contact := response.mapContactProperties()
+	contact := client.mapContact(&response)
 
 	return contact, nil
 }
@@ -91,26 +126,45 @@ func (client *Client) GetContact(ctx context.Context, contactId string) (*model.
 // --------------------------------------------------
 // Contact:Create a contact in Hubspot API
 func (client *Client) CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error) {
-	payload := createHSContactPayload(input)
+	payload, err := client.createHSContactPayload(input)
+	if err != nil {
+		return nil, err
+	}
 
 	response := HSContact{}
 	if err := client.create(ctx, "contacts", payload, &response); err != nil {
 		return nil, err
 	}
 
-	return response.mapContactProperties(), nil
+	contact := client.mapContact(&response)
+
+	if input.CompanyID != nil {
+		if _, err := client.LinkContactToCompany(ctx, contact.ID, *input.CompanyID); err != nil {
+			return nil, err
+		}
+		contact.Company = &model.Company{ID: *input.CompanyID}
+	}
+
+	return contact, nil
 }
 
 // --------------------------------------------------
 // Contact:Update a contact in Hubspot API
 func (client *Client) UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error) {
-	payload := createHSContactPayload(input)
+	payload, err := client.createHSContactPayload(input)
+	if err != nil {
+		return false, err
+	}
 
 	response := HSContact{}
 	if err := client.update(ctx, "contacts", contactId, payload, &response); err != nil {
 		return false, err
 	}
 
+	if input.CompanyID != nil {
+		return client.replaceCompany(ctx, "contacts", contactId, *input.CompanyID, "contact_to_company")
+	}
+
 	return true, nil
 }
 
@@ -126,7 +180,7 @@ func (client *Client) DeleteContact(ctx context.Context, contactId string) (bool
 }
 
 // Creates Hubspot Contact Update/Create payload from GraphQL input
-func createHSContactPayload(input *model.ContactInput) *HSContactCreateUpdatePayload {
+func (client *Client) createHSContactPayload(input *model.ContactInput) (map[string]interface{}, error) {
 	hsContactPayload := HSContactCreateUpdatePayload{}
 
 	if input.FirstName != nil {
@@ -153,7 +207,24 @@ func createHSContactPayload(input *model.ContactInput) *HSContactCreateUpdatePay
 		hsContactPayload.Properties.Company = input.CompanyName
 	}
 
-	return &hsContactPayload
+	if input.OwnerID != nil {
+		hsContactPayload.Properties.HubspotOwnerId = input.OwnerID
+	}
+
+	properties, err := connectors.MergePayload(&hsContactPayload.Properties, client.FieldMapping.CrmFields(model.CrmObjectContact, input.CustomFields))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"properties": properties}, nil
+}
+
+// Maps the contact with its custom fields
+func (client *Client) mapContact(hsContact *HSContact) *model.Contact {
+	contact := hsContact.mapContactProperties()
+	contact.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectContact, hsContact.RawProperties)
+
+	return contact
 }
 
 func (hsContact HSContact) mapContactProperties() *model.Contact {
@@ -171,5 +242,7 @@ func (hsContact HSContact) mapContactProperties() *model.Contact {
 		CreatedAt:   parseHSDateTime(&hsContact.CreatedAt),
 		UpdatedAt:   parseHSDateTime(&hsContact.UpdatedAt),
 		Archived:    &hsContact.Archived,
+		Company:     hsContact.Associations.companyReference(),
+		Owner:       hsOwnerReference(&hsContact.Properties.HubspotOwnerId),
 	}
 }
@@ -0,0 +1,105 @@
+package hubspot
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Objects of HubSpot the Omni API objects are stored in, the leads are contacts
+var hsDescribedObjects = map[model.CrmObject]string{
+	model.CrmObjectContact:     "contacts",
+	model.CrmObjectOpportunity: "deals",
+	model.CrmObjectCompany:     "companies",
+	model.CrmObjectLead:        "contacts",
+	model.CrmObjectNote:        "notes",
+	model.CrmObjectTask:        "tasks",
+}
+
+// https://developers.hubspot.com/docs/api/crm/properties
+type HSPropertiesListSuccessResponse struct {
+	Results []HSProperty `json:"results"`
+}
+
+type HSProperty struct {
+	Name                 string             `json:"name"`
+	Label                string             `json:"label"`
+	Type                 string             `json:"type"`
+	Calculated           bool               `json:"calculated"`
+	Hidden               bool               `json:"hidden"`
+	HubspotDefined       bool               `json:"hubspotDefined"`
+	Options              []HSPropertyOption `json:"options"`
+	ModificationMetadata struct {
+		ReadOnlyValue bool `json:"readOnlyValue"`
+	} `json:"modificationMetadata"`
+}
+
+type HSPropertyOption struct {
+	Value  string `json:"value"`
+	Label  string `json:"label"`
+	Hidden bool   `json:"hidden"`
+}
+
+// The hidden properties are internal to HubSpot, they're left out
+func (client *Client) DescribeObject(ctx context.Context, object model.CrmObject) (*model.ObjectDescription, error) {
+	objectPath, found := hsDescribedObjects[object]
+	if !found {
+		return nil, fmt.Errorf("describing %s is not supported", object)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/%s", client.propertiesUrl(), objectPath), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := HSPropertiesListSuccessResponse{}
+	if err := client.sendRequest(req, &response); err != nil {
+		log.Errorf("Error listing the properties of %s: %s", objectPath, err)
+		return nil, err
+	}
+
+	description := model.ObjectDescription{
+		Object: object,
+		Name:   objectPath,
+		Fields: []*model.FieldDescription{},
+	}
+	for i := range response.Results {
+		if !response.Results[i].Hidden {
+			description.Fields = append(description.Fields, response.Results[i].mapProperties())
+		}
+	}
+
+	return &description, nil
+}
+
+// The properties API is next to the objects API
+func (client *Client) propertiesUrl() string {
+	return strings.TrimSuffix(client.BaseURL, "/objects") + "/properties"
+}
+
+func (hsProperty *HSProperty) mapProperties() *model.FieldDescription {
+	field := model.FieldDescription{
+		Name:  hsProperty.Name,
+		Label: hsProperty.Label,
+		Type:  hsProperty.Type,
+		// HubSpot requires no property to create the records, the required properties are set by the forms
+		Required: false,
+		Readonly: hsProperty.Calculated || hsProperty.ModificationMetadata.ReadOnlyValue,
+		Custom:   !hsProperty.HubspotDefined,
+	}
+
+	if hsProperty.Type == "enumeration" {
+		field.PicklistValues = []*model.PicklistValue{}
+		for _, option := range hsProperty.Options {
+			if !option.Hidden {
+				field.PicklistValues = append(field.PicklistValues, &model.PicklistValue{Value: option.Value, Label: option.Label})
+			}
+		}
+	}
+
+	return &field
+}
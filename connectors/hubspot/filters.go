@@ -0,0 +1,267 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HubSpot properties of the unified filter fields
+var hsContactFilterFields = map[string]string{
+	"firstName": "firstname",
+	"lastName":  "lastname",
+	"email":     "email",
+	"phone":     "phone",
+	"companyId": "associations.company",
+	"ownerId":   "hubspot_owner_id",
+	"createdAt": "createdate",
+	"updatedAt": "lastmodifieddate",
+}
+
+var hsDealFilterFields = map[string]string{
+	"name":       "dealname",
+	"stageName":  "dealstage",
+	"pipelineId": "pipeline",
+	"companyId":  "associations.company",
+	"ownerId":    "hubspot_owner_id",
+	"amount":     "amount",
+	"closeDate":  "closedate",
+	"createdAt":  "createdate",
+	"updatedAt":  "hs_lastmodifieddate",
+}
+
+var hsCompanyFilterFields = map[string]string{
+	"name":              "name",
+	"ownerId":           "hubspot_owner_id",
+	"numberOfEmployees": "numberofemployees",
+	"annualRevenue":     "annualrevenue",
+	"createdAt":         "createdate",
+	"updatedAt":         "hs_lastmodifieddate",
+}
+
+// Leads can't be filtered yet, the fields are used for sorting
+var hsLeadFilterFields = map[string]string{
+	"firstName":   "firstname",
+	"lastName":    "lastname",
+	"email":       "email",
+	"companyName": "company",
+	"status":      "hs_lead_status",
+	"createdAt":   "createdate",
+	"updatedAt":   "lastmodifieddate",
+}
+
+// Limits of the search requests, the filter trees expanded beyond them are rejected before the request
+// https://developers.hubspot.com/docs/api/crm/search#filter-search-results
+const (
+	HS_SEARCH_MAX_FILTER_GROUPS = 5
+	HS_SEARCH_MAX_GROUP_FILTERS = 6
+	HS_SEARCH_MAX_FILTERS       = 18
+)
+
+var hsSearchOperators = map[string]string{
+	connectors.FILTER_OPERATOR_EQ:       "EQ",
+	connectors.FILTER_OPERATOR_IN:       "IN",
+	connectors.FILTER_OPERATOR_CONTAINS: "CONTAINS_TOKEN",
+	connectors.FILTER_OPERATOR_GTE:      "GTE",
+	connectors.FILTER_OPERATOR_LTE:      "LTE",
+}
+
+// Lists objects with the list endpoint or, when the params can't be handled by it, with the search endpoint.
+// Returns the offset of the first record when the search was paginated with an offset, the records have offset
+// cursors then, the other records have the object ID cursors of the list endpoint.
+func (client *Client) listOrSearch(ctx context.Context, objectPath string, params *connectors.ListParams, props []string, associations []string, filterFields map[string]string, response interface{}) (*int, error) {
+	hasQuery := params.Query != nil && *params.Query != ""
+	if params.Filter == nil && !hasQuery && len(params.OrderBy) == 0 {
+		if !params.Backward() && !params.IncludeTotalCount {
+			return nil, client.list(ctx, objectPath, params.First, params.After, props, associations, response)
+		}
+
+		// the pages of the list endpoint are paginated back and counted by searching the object IDs
+		// around their cursors, the offset cursors of the searched lists keep the offset pagination
+		if !isSearchCursor(params.After) && !isSearchCursor(params.Before) {
+			if err := client.searchByID(ctx, objectPath, params, props, response); err != nil {
+				return nil, err
+			}
+			return nil, client.readSearchAssociations(ctx, objectPath, associations, response)
+		}
+	}
+
+	offset := 0
+
+	payload := HSSearchPayload{Properties: props, FilterGroups: []HSSearchFilterGroup{}}
+	if hasQuery {
+		payload.Query = *params.Query
+	}
+
+	sorts, err := hsSearchSorts(params.OrderBy, filterFields)
+	if err != nil {
+		return &offset, err
+	}
+	payload.Sorts = sorts
+
+	if params.Filter != nil {
+		filterGroups, err := hsSearchFilterGroups(params.Filter, filterFields)
+		if err != nil {
+			return &offset, err
+		}
+
+		if len(filterGroups) == 0 {
+			// the filter can't match anything
+			return &offset, nil
+		}
+
+		// a single group without filters matches everything
+		if len(filterGroups) > 1 || len(filterGroups[0].Filters) > 0 {
+			if err := validateHSSearchFilterGroups(filterGroups); err != nil {
+				return &offset, err
+			}
+			payload.FilterGroups = filterGroups
+		}
+	}
+
+	offset, err = client.search(ctx, objectPath, params, &payload, response)
+	if err != nil {
+		return &offset, err
+	}
+
+	return &offset, client.readSearchAssociations(ctx, objectPath, associations, response)
+}
+
+// Search API accepts filter groups combined with OR, each of them having filters combined with AND,
+// so the filter tree is expanded to the disjunctive normal form.
+// No groups means nothing matches, a single empty group means everything matches.
+func hsSearchFilterGroups(filter *connectors.Filter, filterFields map[string]string) ([]HSSearchFilterGroup, error) {
+	group := HSSearchFilterGroup{Filters: []HSSearchFilter{}}
+	for _, condition := range filter.Conditions {
+		hsFilter, err := compileHSSearchFilter(condition, filterFields)
+		if err != nil {
+			return nil, err
+		}
+
+		if hsFilter == nil {
+			return []HSSearchFilterGroup{}, nil
+		}
+		group.Filters = append(group.Filters, *hsFilter)
+	}
+
+	groups := []HSSearchFilterGroup{group}
+	for _, and := range filter.And {
+		andGroups, err := hsSearchFilterGroups(and, filterFields)
+		if err != nil {
+			return nil, err
+		}
+		groups = crossFilterGroups(groups, andGroups)
+	}
+
+	if len(filter.Or) > 0 {
+		orGroups := []HSSearchFilterGroup{}
+		for _, or := range filter.Or {
+			groups, err := hsSearchFilterGroups(or, filterFields)
+			if err != nil {
+				return nil, err
+			}
+			orGroups = append(orGroups, groups...)
+		}
+		groups = crossFilterGroups(groups, orGroups)
+	}
+
+	return groups, nil
+}
+
+// The filter tree is expanded to more groups and filters than the filter has conditions, e.g. "and" of two "or"
+// with two conditions each is expanded to four groups
+func validateHSSearchFilterGroups(groups []HSSearchFilterGroup) error {
+	if len(groups) > HS_SEARCH_MAX_FILTER_GROUPS {
+		return fmt.Errorf("the filter is too complex for HubSpot: %d filter groups once expanded, the limit is %d", len(groups), HS_SEARCH_MAX_FILTER_GROUPS)
+	}
+
+	filtersCount := 0
+	for _, group := range groups {
+		if len(group.Filters) > HS_SEARCH_MAX_GROUP_FILTERS {
+			return fmt.Errorf("the filter is too complex for HubSpot: %d filters in a filter group once expanded, the limit is %d", len(group.Filters), HS_SEARCH_MAX_GROUP_FILTERS)
+		}
+		filtersCount += len(group.Filters)
+	}
+
+	if filtersCount > HS_SEARCH_MAX_FILTERS {
+		return fmt.Errorf("the filter is too complex for HubSpot: %d filters once expanded, the limit is %d", filtersCount, HS_SEARCH_MAX_FILTERS)
+	}
+
+	return nil
+}
+
+// Combines two alternatives of filter groups with AND
+func crossFilterGroups(left []HSSearchFilterGroup, right []HSSearchFilterGroup) []HSSearchFilterGroup {
+	groups := []HSSearchFilterGroup{}
+	for _, leftGroup := range left {
+		for _, rightGroup := range right {
+			filters := make([]HSSearchFilter, 0, len(leftGroup.Filters)+len(rightGroup.Filters))
+			filters = append(filters, leftGroup.Filters...)
+			filters = append(filters, rightGroup.Filters...)
+			groups = append(groups, HSSearchFilterGroup{Filters: filters})
+		}
+	}
+
+	return groups
+}
+
+// Returns nil filter when the condition can't match anything
+func compileHSSearchFilter(condition connectors.FilterCondition, filterFields map[string]string) (*HSSearchFilter, error) {
+	propertyName, ok := filterFields[condition.Field]
+	if !ok {
+		return nil, fmt.Errorf("filtering by %s is not supported", condition.Field)
+	}
+
+	operator, ok := hsSearchOperators[condition.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported filter operator %s", condition.Operator)
+	}
+
+	hsFilter := HSSearchFilter{PropertyName: propertyName, Operator: operator}
+
+	switch value := condition.Value.(type) {
+	case []string:
+		if len(value) == 0 {
+			return nil, nil
+		}
+		hsFilter.Values = value
+	case time.Time:
+		timestamp := hsTimestamp(value)
+		hsFilter.Value = &timestamp
+	case string:
+		if condition.Operator == connectors.FILTER_OPERATOR_CONTAINS {
+			value = "*" + value + "*"
+		}
+		hsFilter.Value = &value
+	default:
+		return nil, fmt.Errorf("unsupported filter value %v", value)
+	}
+
+	return &hsFilter, nil
+}
+
+// Search API sorts by a single property, the offset cursors don't depend on the sorted values
+func hsSearchSorts(orderBy []*model.SortInput, filterFields map[string]string) ([]HSSearchSort, error) {
+	if len(orderBy) == 0 {
+		return nil, nil
+	}
+	if len(orderBy) > 1 {
+		return nil, errors.New("sorting by more than one field is not supported")
+	}
+
+	propertyName, ok := filterFields[orderBy[0].Field]
+	if !ok || strings.HasPrefix(propertyName, "associations.") {
+		return nil, fmt.Errorf("sorting by %s is not supported", orderBy[0].Field)
+	}
+
+	direction := "ASCENDING"
+	if connectors.IsDescending(orderBy[0]) {
+		direction = "DESCENDING"
+	}
+
+	return []HSSearchSort{{PropertyName: propertyName, Direction: direction}}, nil
+}
@@ -1,8 +1,10 @@
 package hubspot
 
 import (
+	"blendbase/config"
 	"blendbase/connectors"
 	"blendbase/graph/model"
+	"blendbase/integrations"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -11,20 +13,29 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
 
 const (
 	HSBaseUrlV3 = "https://api.hubapi.com/crm/v3/objects"
+
+	HS_OFFSET_CURSOR_PREFIX = "offset:"
 )
 
+// The access token is the token of a private app or the OAuth2 token of a public app,
+// the OAuth2 tokens are refreshed when the API rejects them
 type Client struct {
-	BaseURL     string
-	AccessToken string
-	HTTPClient  *http.Client
+	BaseURL      string
+	AccessToken  string
+	HTTPClient   *http.Client
+	FieldMapping connectors.FieldMapping
+
+	refreshAccessToken func() (string, error) // refreshes and stores the OAuth2 token, nil for the private apps
 }
 
 type ErrorResponse struct {
@@ -48,6 +59,60 @@ type HSAssociationsListItem struct {
 	Type string `json:"type"`
 }
 
+// Associations returned with an object when requested with the "associations" query parameter
+type HSObjectAssociations struct {
+	Companies *HSAssociationListSuccessResponse `json:"companies"`
+}
+
+// https://developers.hubspot.com/docs/api/crm/associations/v3
+type HSAssociationBatchReadPayload struct {
+	Inputs []HSBatchReadPayloadInput `json:"inputs"`
+}
+
+type HSAssociationBatchReadSuccessResponse struct {
+	Results []struct {
+		From struct {
+			Id string `json:"id"`
+		} `json:"from"`
+		To []HSAssociationsListItem `json:"to"`
+	} `json:"results"`
+}
+
+// https://developers.hubspot.com/docs/api/crm/search
+type HSSearchPayload struct {
+	FilterGroups []HSSearchFilterGroup `json:"filterGroups"`
+	Sorts        []HSSearchSort        `json:"sorts,omitempty"`
+	Properties   []string              `json:"properties"`
+	Query        string                `json:"query,omitempty"`
+	Limit        int                   `json:"limit"`
+	After        string                `json:"after,omitempty"`
+}
+
+type HSSearchFilterGroup struct {
+	Filters []HSSearchFilter `json:"filters"`
+}
+
+type HSSearchFilter struct {
+	PropertyName string   `json:"propertyName"`
+	Operator     string   `json:"operator"`
+	Value        *string  `json:"value,omitempty"`
+	Values       []string `json:"values,omitempty"`
+}
+
+type HSSearchSort struct {
+	PropertyName string `json:"propertyName"`
+	Direction    string `json:"direction"`
+}
+
+type HSBatchReadPayload struct {
+	Properties []string                  `json:"properties"`
+	Inputs     []HSBatchReadPayloadInput `json:"inputs"`
+}
+
+type HSBatchReadPayloadInput struct {
+	Id string `json:"id"`
+}
+
 func (e *HubspotError) Error() string {
 	return e.Err.Error()
 }
@@ -62,6 +127,31 @@ func HubspotClient(accessToken string) *Client {
 	}
 }
 
+// Client of a public app authorized with OAuth2
+func HubspotOAuth2Client(app *config.App, consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *Client {
+	client := HubspotClient(oauth2Flow.ValidToken(app, consumerOAuthConfig).AccessToken)
+	client.refreshAccessToken = func() (string, error) {
+		newToken, err := oauth2Flow.RefreshToken(app, consumerOAuthConfig)
+		if err != nil {
+			return "", err
+		}
+
+		return newToken.AccessToken, nil
+	}
+
+	return client
+}
+
+// Loads the OAuth2 client of the integration of the consumer, the latest integration of the connector unless an integration ID is given
+func LoadClientFromDB(app *config.App, consumer *integrations.Consumer, integrationID *uuid.UUID) (*Client, error) {
+	consumerOAuthConfig, err := connectors.LoadOAuth2Configuration(app, consumer, connectors.CONNECTOR_CRM_HUBSPOT, integrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return HubspotOAuth2Client(app, consumerOAuthConfig), nil
+}
+
 func (client *Client) sendRequest(req *http.Request, response interface{}) *HubspotError {
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Accept", "application/json; charset=utf-8")
@@ -75,6 +165,24 @@ func (client *Client) sendRequest(req *http.Request, response interface{}) *Hubs
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode == http.StatusUnauthorized && client.refreshAccessToken != nil {
+		// the OAuth2 access tokens expire after 30 minutes, they're refreshed ahead of their expiration and when the API rejects them
+		if err := client.refreshToken(); err != nil {
+			return &HubspotError{StatusCode: res.StatusCode, Err: err}
+		}
+
+		// retry with the refreshed token and the body read again
+		if err := connectors.RewindRequestBody(req); err != nil {
+			return &HubspotError{Err: err}
+		}
+		req.Header.Set("authorization", "Bearer "+client.AccessToken)
+		res, err = client.HTTPClient.Do(req)
+		if err != nil {
+			return &HubspotError{Err: err}
+		}
+		defer res.Body.Close()
+	}
+
 	url := strings.Split(res.Request.URL.String(), "?")[0]
 
 	if res.StatusCode >= http.StatusBadRequest {
@@ -126,9 +234,12 @@ func (client *Client) sendRequest(req *http.Request, response interface{}) *Hubs
 	return nil
 }
 
-func (client *Client) get(ctx context.Context, objectPath, objectId string, props []string, obj interface{}) error {
+func (client *Client) get(ctx context.Context, objectPath, objectId string, props []string, associations []string, obj interface{}) error {
 	query := url.Values{}
 	query.Set("properties", strings.Join(props, ","))
+	if len(associations) > 0 {
+		query.Set("associations", strings.Join(associations, ","))
+	}
 
 	url := fmt.Sprintf("%s/%s/%s?%s", client.BaseURL, objectPath, objectId, query.Encode())
 
@@ -203,10 +314,13 @@ func (client *Client) delete(ctx context.Context, objectPath string, objectId st
 	return nil
 }
 
-func (client *Client) list(ctx context.Context, objectPath string, first int, after *string, props []string, response interface{}) error {
+func (client *Client) list(ctx context.Context, objectPath string, first int, after *string, props []string, associations []string, response interface{}) error {
 	query := url.Values{}
 
 	query.Set("properties", strings.Join(props, ","))
+	if len(associations) > 0 {
+		query.Set("associations", strings.Join(associations, ","))
+	}
 
 	limitBuffer := 1 // +1 to see if there are more pages
 	if after != nil {
@@ -231,31 +345,347 @@ func (client *Client) list(ctx context.Context, objectPath string, first int, af
 	return nil
 }
 
-func (client *Client) prepareListResults(first int, after *string, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
+// Searches objects using the CRM search API.
+// Search API paginates with an offset, so the cursors hold the offsets of the records.
+// Returns the offset of the first returned record.
+func (client *Client) search(ctx context.Context, objectPath string, params *connectors.ListParams, payload *HSSearchPayload, response interface{}) (int, error) {
+	offset, limit, err := searchPage(params)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == 0 {
+		// nothing is before the first record
+		return offset, nil
+	}
+
+	payload.Limit = limit
+	if offset > 0 {
+		payload.After = strconv.Itoa(offset)
+	}
+
+	if len(payload.Sorts) == 0 {
+		// stable order is required for the offset pagination
+		payload.Sorts = []HSSearchSort{{PropertyName: "hs_object_id", Direction: "ASCENDING"}}
+	}
+
+	if err := client.sendSearchRequest(ctx, objectPath, payload, response); err != nil {
+		return 0, err
+	}
+
+	return offset, nil
+}
+
+// Searches objects ordered by ID from the object ID cursors of the list endpoint, like the list endpoint
+// the "after" record is returned too. The records before the "before" record are read from the closest one
+// and reversed, the last page is read when no cursor is given.
+func (client *Client) searchByID(ctx context.Context, objectPath string, params *connectors.ListParams, props []string, response interface{}) error {
+	payload := HSSearchPayload{
+		Properties:   props,
+		FilterGroups: []HSSearchFilterGroup{},
+		Sorts:        []HSSearchSort{{PropertyName: "hs_object_id", Direction: "ASCENDING"}},
+	}
+
+	cursor, operator := params.After, "GTE"
+	// +1 to see if there are more pages
+	payload.Limit = params.First + 1
+	if params.After != nil {
+		payload.Limit += 1
+	}
+
+	if params.Backward() {
+		cursor, operator = params.Before, "LT"
+		payload.Sorts[0].Direction = "DESCENDING"
+		payload.Limit = *params.Last + 1
+	}
+
+	if cursor != nil {
+		objectId := connectors.DecodeCursor(*cursor)
+		payload.FilterGroups = []HSSearchFilterGroup{{
+			Filters: []HSSearchFilter{{PropertyName: "hs_object_id", Operator: operator, Value: &objectId}},
+		}}
+	}
+
+	if err := client.sendSearchRequest(ctx, objectPath, &payload, response); err != nil {
+		return err
+	}
+
+	if params.Backward() {
+		reverseResults(response)
+	}
+
+	// the total of the search only counts the records around the cursor
+	totalValue := reflect.ValueOf(response).Elem().FieldByName("Total")
+	if params.IncludeTotalCount && cursor != nil && totalValue.IsValid() {
+		total, err := client.count(ctx, objectPath)
+		if err != nil {
+			return err
+		}
+		totalValue.SetInt(int64(total))
+	}
+
+	return nil
+}
+
+// Counts all the objects of the type
+func (client *Client) count(ctx context.Context, objectPath string) (int, error) {
+	payload := HSSearchPayload{Properties: []string{"hs_object_id"}, FilterGroups: []HSSearchFilterGroup{}, Limit: 1}
+
+	response := struct {
+		Total int `json:"total"`
+	}{}
+	if err := client.sendSearchRequest(ctx, objectPath, &payload, &response); err != nil {
+		return 0, err
+	}
+
+	return response.Total, nil
+}
+
+// Search results don't include the associations, the companies of the searched records are read in batches
+// so that the records reference their company like the records of the list endpoint
+func (client *Client) readSearchAssociations(ctx context.Context, objectPath string, associations []string, response interface{}) error {
+	if !containsString(associations, "companies") {
+		return nil
+	}
+
+	results := reflect.ValueOf(response).Elem().FieldByName("Results")
+	objectIds := make([]string, results.Len())
+	for i := range objectIds {
+		objectIds[i] = results.Index(i).FieldByName("Id").String()
+	}
+
+	companies := map[string][]HSAssociationsListItem{}
+	for _, chunk := range chunkIds(objectIds, HS_BATCH_READ_LIMIT) {
+		payload := HSAssociationBatchReadPayload{Inputs: make([]HSBatchReadPayloadInput, len(chunk))}
+		for i, objectId := range chunk {
+			payload.Inputs[i].Id = objectId
+		}
+
+		url := fmt.Sprintf("%s/associations/%s/companies/batch/read", strings.TrimSuffix(client.BaseURL, "/objects"), objectPath)
+		payloadString, _ := json.Marshal(payload)
+
+		req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadString))
+		if err != nil {
+			return err
+		}
+
+		batchResponse := HSAssociationBatchReadSuccessResponse{}
+		req = req.WithContext(ctx)
+		if err := client.sendRequest(req, &batchResponse); err != nil {
+			return err
+		}
+
+		for _, result := range batchResponse.Results {
+			companies[result.From.Id] = append(companies[result.From.Id], result.To...)
+		}
+	}
+
+	for i, objectId := range objectIds {
+		associations := HSObjectAssociations{Companies: &HSAssociationListSuccessResponse{Results: companies[objectId]}}
+		results.Index(i).FieldByName("Associations").Set(reflect.ValueOf(&associations))
+	}
+
+	return nil
+}
+
+func (client *Client) sendSearchRequest(ctx context.Context, objectPath string, payload *HSSearchPayload, response interface{}) error {
+	url := fmt.Sprintf("%s/%s/search", client.BaseURL, objectPath)
+	payloadString, _ := json.Marshal(payload)
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadString))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	if err := client.sendRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reverses the results of a list response
+func reverseResults(response interface{}) {
+	results := reflect.ValueOf(response).Elem().FieldByName("Results")
+	swap := reflect.Swapper(results.Interface())
+	for i, j := 0, results.Len()-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
+
+// Offset and number of the records to read for the page, including the records
+// that prepareListResults uses to see if there are more pages
+func searchPage(params *connectors.ListParams) (int, int, error) {
+	if params.Backward() {
+		if params.Before == nil {
+			// the last page is unknown without the total count
+			return 0, 0, errors.New("last can only be used with before")
+		}
+
+		before, err := searchCursorOffset(*params.Before)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// +1 to see if there are more pages
+		offset := before - *params.Last - 1
+		if offset < 0 {
+			offset = 0
+		}
+		return offset, before - offset, nil
+	}
+
+	if params.After == nil {
+		// +1 to see if there are more pages
+		return 0, params.First + 1, nil
+	}
+
+	after, err := searchCursorOffset(*params.After)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// the record at the "after" offset is returned too, it's going to be dropped
+	return after, params.First + 2, nil
+}
+
+// Returns cursor of the search result record at the given offset
+func searchCursor(offset int) string {
+	return connectors.EncodeCursor(HS_OFFSET_CURSOR_PREFIX + strconv.Itoa(offset))
+}
+
+// Whether the cursor is an offset cursor of a searched list
+func isSearchCursor(cursor *string) bool {
+	return cursor != nil && strings.HasPrefix(connectors.DecodeCursor(*cursor), HS_OFFSET_CURSOR_PREFIX)
+}
+
+// Offset cursors can't be mixed with the object ID cursors of the list endpoint
+func searchCursorOffset(cursor string) (int, error) {
+	decoded := connectors.DecodeCursor(cursor)
+	if !strings.HasPrefix(decoded, HS_OFFSET_CURSOR_PREFIX) {
+		return 0, errors.New("invalid cursor, the cursor doesn't belong to a searched list")
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(decoded, HS_OFFSET_CURSOR_PREFIX))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid cursor")
+	}
+
+	return offset, nil
+}
+
+// Reads objects by their IDs, the response is expected to have the list response structure
+func (client *Client) batchRead(ctx context.Context, objectPath string, objectIds []string, props []string, response interface{}) error {
+	payload := HSBatchReadPayload{
+		Properties: props,
+		Inputs:     make([]HSBatchReadPayloadInput, len(objectIds)),
+	}
+	for i, objectId := range objectIds {
+		payload.Inputs[i].Id = objectId
+	}
+
+	url := fmt.Sprintf("%s/%s/batch/read", client.BaseURL, objectPath)
+	payloadString, _ := json.Marshal(payload)
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadString))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	if err := client.sendRequest(req, &response); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Lists IDs of the objects of the "toObjectPath" type associated with the object
+func (client *Client) listAssociations(ctx context.Context, objectPath string, objectId string, toObjectPath string) ([]string, error) {
+	url := fmt.Sprintf("%s/%s/%s/associations/%s", client.BaseURL, objectPath, objectId, toObjectPath)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	associationResponse := HSAssociationListSuccessResponse{}
+
+	req = req.WithContext(ctx)
+	if err := client.sendRequest(req, &associationResponse); err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, hsAssociation := range associationResponse.Results {
+		// the same object can be listed multiple times with different association types
+		if !containsString(ids, hsAssociation.Id) {
+			ids = append(ids, hsAssociation.Id)
+		}
+	}
+
+	return ids, nil
+}
+
+// Associates two objects using association type, e.g. "contact_to_company"
+func (client *Client) associate(ctx context.Context, objectPath string, objectId string, toObjectPath string, toObjectId string, associationType string) error {
+	return client.sendAssociationRequest(ctx, "PUT", objectPath, objectId, toObjectPath, toObjectId, associationType)
+}
+
+func (client *Client) disassociate(ctx context.Context, objectPath string, objectId string, toObjectPath string, toObjectId string, associationType string) error {
+	return client.sendAssociationRequest(ctx, "DELETE", objectPath, objectId, toObjectPath, toObjectId, associationType)
+}
+
+func (client *Client) sendAssociationRequest(ctx context.Context, method string, objectPath string, objectId string, toObjectPath string, toObjectId string, associationType string) error {
+	url := fmt.Sprintf("%s/%s/%s/associations/%s/%s/%s",
+		client.BaseURL, objectPath, objectId, toObjectPath, toObjectId, associationType)
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	if err := client.sendRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (client *Client) prepareListResults(params *connectors.ListParams, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
 	recordsValue := reflect.ValueOf(edgesPtr).Elem()
 	recordsLenght := recordsValue.Len()
 
 	start := 0
 	end := recordsLenght
-	hasNextPage := false
-
-	// remove the "after" item
-	if after != nil && recordsLenght > 0 {
-		start = 1
-	}
+	pageInfo := &model.PageInfo{}
 
-	if (recordsLenght - start) > first {
+	if params.Backward() {
 		// remove the item that was added to see if there are more pages
-		end = first + start
-		hasNextPage = true
+		if recordsLenght > *params.Last {
+			start = recordsLenght - *params.Last
+			pageInfo.HasPreviousPage = true
+		}
+		pageInfo.HasNextPage = params.Before != nil
+	} else {
+		// remove the "after" item
+		if params.After != nil && recordsLenght > 0 {
+			start = 1
+		}
+
+		if (recordsLenght - start) > params.First {
+			// remove the item that was added to see if there are more pages
+			end = params.First + start
+			pageInfo.HasNextPage = true
+		}
+		pageInfo.HasPreviousPage = params.After != nil
 	}
 
 	ret := recordsValue.Slice(start, end)
-	pageInfo := &model.PageInfo{
-		HasNextPage: hasNextPage,
-	}
 
-	if recordsLenght > 0 {
+	if ret.Len() > 0 {
 		startCursor := ret.Index(0).Elem().FieldByName("Cursor").String()
 		endCursor := ret.Index(ret.Len() - 1).Elem().FieldByName("Cursor").String()
 
@@ -266,6 +696,25 @@ func (client *Client) prepareListResults(first int, after *string, edgesPtr inte
 	return ret, pageInfo
 }
 
+// Returns a reference to the first associated company, if any
+func (associations *HSObjectAssociations) companyReference() *model.Company {
+	if associations == nil || associations.Companies == nil || len(associations.Companies.Results) == 0 {
+		return nil
+	}
+
+	return &model.Company{ID: associations.Companies.Results[0].Id}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
 func parseHSDateTime(dateTime *string) *time.Time {
 	if dateTime == nil {
 		return nil
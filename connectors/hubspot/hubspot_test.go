@@ -1,22 +1,86 @@
 package hubspot
 
 import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
 	"blendbase/misc/test_utils"
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 )
 
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{BaseURL: server.URL, HTTPClient: server.Client()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func TestSendRequestRefreshesRejectedToken(t *testing.T) {
+	var authorizations, bodies []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		bodies = append(bodies, string(body))
+
+		if r.Header.Get("Authorization") != "Bearer refreshed-token" {
+			writeJSON(w, http.StatusUnauthorized, ErrorResponse{Status: "error", Message: "The OAuth token used to make this call expired", Category: "EXPIRED_AUTHENTICATION"})
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"id": "101", "properties": map[string]string{"firstname": "Ada"}})
+	})
+	c.AccessToken = "expired-token"
+
+	refreshes := 0
+	c.refreshAccessToken = func() (string, error) {
+		refreshes++
+		return "refreshed-token", nil
+	}
+
+	firstName := "Ada"
+	contact, err := c.CreateContact(context.Background(), &model.ContactInput{FirstName: &firstName})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "101", contact.ID, "expecting the contact of the retried request")
+	assert.Equal(t, 1, refreshes, "expecting the token to be refreshed once")
+	assert.Equal(t, []string{"Bearer expired-token", "Bearer refreshed-token"}, authorizations, "expecting the request to be retried with the refreshed token")
+	assert.Equal(t, bodies[0], bodies[1], "expecting the retried request to send the body again")
+	assert.Equal(t, "refreshed-token", c.AccessToken, "expecting the client to keep the refreshed token")
+}
+
+func TestSendRequestDoesNotRefreshPrivateAppToken(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		writeJSON(w, http.StatusUnauthorized, ErrorResponse{Status: "error", Message: "Authentication credentials not found"})
+	})
+	c.AccessToken = "private-app-token"
+
+	_, err := c.GetContact(context.Background(), "101")
+	assert.NotNil(t, err, "expecting the error of the rejected token")
+	assert.Equal(t, 1, requests, "expecting the private app requests not to be retried")
+}
+
 func TestListContacts(t *testing.T) {
 	godotenv.Load("../../.env")
 	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
 
 	ctx := context.Background()
-	contactConnection, err := c.ListContacts(ctx, 10, nil)
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 10})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, contactConnection.Edges, "expecting non-nil contacts")
@@ -29,7 +93,7 @@ func TestListContactsPagination(t *testing.T) {
 	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
 
 	ctx := context.Background()
-	contactConnection, err := c.ListContacts(ctx, 1, nil)
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 1})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, contactConnection.Edges, "expecting non-nil result")
@@ -47,7 +111,7 @@ func TestListContactsPagination(t *testing.T) {
 		contactConnection.Edges[0].Node.ID,
 		"expecting EndCursor to be equal to the last contact ID")
 
-	contactConnection, err = c.ListContacts(ctx, 1, afterParam)
+	contactConnection, err = c.ListContacts(ctx, &connectors.ListParams{First: 1, After: afterParam})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, contactConnection.Edges, "expecting non-nil result")
@@ -149,7 +213,7 @@ func TestListOpportunities(t *testing.T) {
 	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
 
 	ctx := context.Background()
-	opportunityConnection, err := c.ListOpportunities(ctx, 10, nil)
+	opportunityConnection, err := c.ListOpportunities(ctx, &connectors.ListParams{First: 10})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, opportunityConnection.Edges, "expecting non-nil opportunities")
@@ -202,3 +266,624 @@ func TestCreateOpportunityAndAddNote(t *testing.T) {
 	assert.NotEmpty(t, note.ID, "expecting a non-empty ID for the note")
 	assert.Equal(t, note.Content, noteInput.Content, "expecting a content for the note equal to the content requested")
 }
+
+func TestCompanyCRUD(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+	input := test_utils.GenerateCompanyInput()
+
+	company, err := c.CreateCompany(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, company.ID, "expecting a non-empty ID for the company")
+
+	foundCompany, err := c.GetCompany(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, company.ID, foundCompany.ID, "expecting a ID for the company equal to the ID requested")
+	assert.Equal(t, input.Name, foundCompany.Name, "expecting a name for the company equal to the created one")
+
+	input = test_utils.GenerateCompanyInput()
+
+	success, err := c.UpdateCompany(ctx, company.ID, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	success, err = c.DeleteCompany(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true archive result")
+}
+
+func TestLinkOpportunityToCompany(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	company, err := c.CreateCompany(ctx, test_utils.GenerateCompanyInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	opportunity, err := c.CreateOpportunity(ctx, test_utils.GenerateOpportunityInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	success, err := c.LinkOpportunityToCompany(ctx, opportunity.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true link result")
+
+	linkedOpportunity, err := c.GetOpportunity(ctx, opportunity.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, company.ID, linkedOpportunity.Company.ID, "expecting the opportunity to reference the linked company")
+
+	companyOpportunities, err := c.ListCompanyOpportunities(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(companyOpportunities), "expecting a single opportunity of the company")
+
+	success, err = c.UnlinkOpportunityFromCompany(ctx, opportunity.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true unlink result")
+
+	c.DeleteOpportunity(ctx, opportunity.ID)
+	c.DeleteCompany(ctx, company.ID)
+}
+
+func TestLeadCRUD(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+	input := test_utils.GenerateLeadInput()
+
+	lead, err := c.CreateLead(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, lead.ID, "expecting a non-empty ID for the lead")
+	assert.False(t, *lead.Converted, "expecting a new lead not to be converted")
+
+	foundLead, err := c.GetLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, lead.ID, foundLead.ID, "expecting a ID for the lead equal to the ID requested")
+
+	input = test_utils.GenerateLeadInput()
+
+	success, err := c.UpdateLead(ctx, lead.ID, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	success, err = c.DeleteLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true archive result")
+}
+
+func TestConvertLead(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	lead, err := c.CreateLead(ctx, test_utils.GenerateLeadInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	result, err := c.ConvertLead(ctx, lead.ID, &model.LeadConversionInput{})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, lead.ID, result.ContactID, "expecting the lead contact to be kept after the conversion")
+	assert.NotNil(t, result.CompanyID, "expecting a company to be created for the converted lead")
+	assert.NotNil(t, result.OpportunityID, "expecting a deal to be created for the converted lead")
+
+	foundLead, err := c.GetLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, *foundLead.Converted, "expecting the lead to be marked as converted")
+}
+
+func TestCreateContactTaskAndComplete(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	taskInput := test_utils.GenerateTaskInput()
+	task, err := c.CreateContactTask(ctx, contact.ID, taskInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, task.ID, "expecting a non-empty ID for the task")
+	assert.Equal(t, taskInput.Subject, task.Subject, "expecting a subject for the task equal to the subject requested")
+
+	completed := model.TaskStatusCompleted
+	taskInput.Status = &completed
+
+	success, err := c.UpdateTask(ctx, task.ID, taskInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	tasks, err := c.ListContactTasks(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Len(t, tasks, 1, "expecting the created task to be listed for the contact")
+	assert.Equal(t, completed, *tasks[0].Status, "expecting the task to be completed")
+}
+
+func TestLogContactActivities(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	for _, activityType := range model.AllActivityType {
+		activityInput := test_utils.GenerateActivityInput(activityType)
+		activity, err := c.CreateContactActivity(ctx, contact.ID, activityInput)
+		assert.Nil(t, err, "expecting nil error")
+		assert.NotEmpty(t, activity.ID, "expecting a non-empty ID for the activity")
+		assert.Equal(t, activityType, activity.Type, "expecting a type for the activity equal to the type requested")
+	}
+
+	activities, err := c.ListContactActivities(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Len(t, activities, len(model.AllActivityType), "expecting all the logged activities to be listed for the contact")
+}
+
+func TestListUsersAndAssignOwner(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	connection, err := c.ListUsers(ctx, &connectors.ListParams{First: 10})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(connection.Edges), 0, "expecting more than zero users")
+
+	owner := connection.Edges[0].Node
+	foundUser, err := c.GetUser(ctx, owner.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, owner.ID, foundUser.ID, "expecting a ID for the user equal to the ID requested")
+
+	input := test_utils.GenerateContactInput()
+	input.OwnerID = &owner.ID
+
+	contact, err := c.CreateContact(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+
+	foundContact, err := c.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotNil(t, foundContact.Owner, "expecting the contact to have an owner")
+	assert.Equal(t, owner.ID, foundContact.Owner.ID, "expecting the owner of the contact to be the assigned user")
+}
+
+func TestListPipelines(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	pipelines, err := c.ListPipelines(ctx)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(pipelines), 0, "expecting more than zero pipelines")
+	assert.Greater(t, len(pipelines[0].Stages), 0, "expecting more than zero stages in the pipeline")
+
+	wonStages := 0
+	for _, stage := range pipelines[0].Stages {
+		if stage.IsWon {
+			assert.True(t, stage.IsClosed, "expecting a won stage to be closed")
+			wonStages += 1
+		}
+	}
+	assert.Greater(t, wonStages, 0, "expecting the pipeline to have a won stage")
+}
+
+func TestListContactsWithFilter(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+	input := test_utils.GenerateContactInput()
+
+	contact, err := c.CreateContact(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+
+	filter := connectors.NewContactFilter(&model.ContactFilter{
+		Email: &model.StringFilter{Eq: input.Email},
+	})
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 10, Filter: filter})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Len(t, contactConnection.Edges, 1, "expecting a single contact with the email")
+	assert.Equal(t, contact.ID, contactConnection.Edges[0].Node.ID, "expecting the contact with the email to be listed")
+}
+
+func TestSearchFilterGroups(t *testing.T) {
+	stageName := "closedwon"
+	pipelineId := "default"
+	amount := "1000"
+
+	filter := connectors.NewOpportunityFilter(&model.OpportunityFilter{
+		Amount: &model.DecimalFilter{Gte: &amount},
+		Or: []*model.OpportunityFilter{
+			{StageName: &model.StringFilter{Eq: &stageName}},
+			{PipelineID: &model.IDFilter{In: []string{pipelineId}}},
+		},
+	})
+
+	filterGroups, err := hsSearchFilterGroups(filter, hsDealFilterFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, []HSSearchFilterGroup{
+		{Filters: []HSSearchFilter{
+			{PropertyName: "amount", Operator: "GTE", Value: &amount},
+			{PropertyName: "dealstage", Operator: "EQ", Value: &stageName},
+		}},
+		{Filters: []HSSearchFilter{
+			{PropertyName: "amount", Operator: "GTE", Value: &amount},
+			{PropertyName: "pipeline", Operator: "IN", Values: []string{pipelineId}},
+		}},
+	}, filterGroups, "expecting the filter to be expanded to a group per alternative")
+
+	filter = connectors.NewOpportunityFilter(&model.OpportunityFilter{
+		PipelineID: &model.IDFilter{In: []string{}},
+	})
+
+	filterGroups, err = hsSearchFilterGroups(filter, hsDealFilterFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Empty(t, filterGroups, "expecting no groups for a filter that can't match anything")
+}
+
+func TestListOpportunitiesRejectsFilterBeyondSearchLimits(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	alternatives := func(first string, second string) *model.OpportunityFilter {
+		return &model.OpportunityFilter{Or: []*model.OpportunityFilter{
+			{StageName: &model.StringFilter{Eq: &first}},
+			{StageName: &model.StringFilter{Eq: &second}},
+		}}
+	}
+
+	// 2 * 2 * 2 alternatives
+	filter := connectors.NewOpportunityFilter(&model.OpportunityFilter{
+		And: []*model.OpportunityFilter{alternatives("a", "b"), alternatives("c", "d"), alternatives("e", "f")},
+	})
+
+	_, err := c.ListOpportunities(context.Background(), &connectors.ListParams{First: 10, Filter: filter})
+	assert.EqualError(t, err, "the filter is too complex for HubSpot: 8 filter groups once expanded, the limit is 5")
+
+	filterGroups, err := hsSearchFilterGroups(connectors.NewOpportunityFilter(&model.OpportunityFilter{
+		And: []*model.OpportunityFilter{alternatives("a", "b"), alternatives("c", "d")},
+	}), hsDealFilterFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Nil(t, validateHSSearchFilterGroups(filterGroups), "expecting the groups within the limits to be accepted")
+}
+
+func TestSearchSorts(t *testing.T) {
+	direction := model.SortDirectionDesc
+
+	sorts, err := hsSearchSorts([]*model.SortInput{{Field: "updatedAt", Direction: &direction}}, hsDealFilterFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, []HSSearchSort{{PropertyName: "hs_lastmodifieddate", Direction: "DESCENDING"}}, sorts)
+
+	_, err = hsSearchSorts([]*model.SortInput{{Field: "name"}, {Field: "amount"}}, hsDealFilterFields)
+	assert.NotNil(t, err, "expecting an error for more than one sort field")
+
+	_, err = hsSearchSorts([]*model.SortInput{{Field: "companyId"}}, hsDealFilterFields)
+	assert.NotNil(t, err, "expecting an error for a field that can't be sorted by")
+}
+
+func TestSearchPage(t *testing.T) {
+	after := searchCursor(20)
+	offset, limit, err := searchPage(&connectors.ListParams{First: 10, After: &after})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 20, offset, "expecting the page to start at the after record")
+	assert.Equal(t, 12, limit, "expecting the after record and one more record to be read")
+
+	last := 10
+	before := searchCursor(5)
+	offset, limit, err = searchPage(&connectors.ListParams{Last: &last, Before: &before})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 0, offset, "expecting the page to start at the first record")
+	assert.Equal(t, 5, limit, "expecting the records before the cursor to be read")
+
+	before = connectors.EncodeCursor("5")
+	_, _, err = searchPage(&connectors.ListParams{Last: &last, Before: &before})
+	assert.NotNil(t, err, "expecting an error for a cursor of the list endpoint")
+}
+
+func TestListContactsSecondPageCountsAndLinksCompanies(t *testing.T) {
+	var associationsPayload HSAssociationBatchReadPayload
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		contact := func(id string) map[string]interface{} {
+			return map[string]interface{}{"id": id, "properties": map[string]string{"hs_object_id": id}}
+		}
+
+		switch r.URL.Path {
+		case "/contacts/search":
+			payload := HSSearchPayload{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			if len(payload.FilterGroups) == 0 {
+				// the count of the whole list
+				writeJSON(w, http.StatusOK, map[string]interface{}{"total": 5, "results": []interface{}{contact("1")}})
+				return
+			}
+
+			assert.Equal(t, "GTE", payload.FilterGroups[0].Filters[0].Operator, "expecting the records from the after cursor")
+			// the search only counts the records from the cursor
+			writeJSON(w, http.StatusOK, map[string]interface{}{"total": 4, "results": []interface{}{contact("2"), contact("3"), contact("4"), contact("5")}})
+		case "/associations/contacts/companies/batch/read":
+			json.NewDecoder(r.Body).Decode(&associationsPayload)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"results": []interface{}{
+				map[string]interface{}{"from": map[string]string{"id": "3"}, "to": []map[string]string{{"id": "201", "type": "contact_to_company"}}},
+			}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	after := connectors.EncodeCursor("2")
+	connection, err := c.ListContacts(context.Background(), &connectors.ListParams{First: 2, After: &after, IncludeTotalCount: true})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 5, *connection.TotalCount, "expecting the total of the whole list")
+
+	assert.Equal(t, "3", connection.Edges[0].Node.ID, "expecting the page after the cursor")
+	assert.Equal(t, "201", connection.Edges[0].Node.Company.ID, "expecting the company of the searched contact")
+	assert.Nil(t, connection.Edges[1].Node.Company, "expecting no company for the contact without one")
+	assert.Equal(t, 4, len(associationsPayload.Inputs), "expecting the companies of the searched contacts to be read at once")
+}
+
+func TestUpdateContactReplacesCompany(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == "PATCH":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": "101"})
+		case r.Method == "GET":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"results": []map[string]string{
+				{"id": "201", "type": "contact_to_company"},
+				{"id": "201", "type": "contact_to_company_unlabeled"},
+				{"id": "202", "type": "contact_to_company"},
+			}})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	companyId := "203"
+	updated, err := c.UpdateContact(context.Background(), "101", &model.ContactInput{CompanyID: &companyId})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, updated, "expecting the contact to be updated")
+	assert.Equal(t, []string{
+		"PATCH /contacts/101",
+		"GET /contacts/101/associations/companies",
+		"DELETE /contacts/101/associations/companies/201/contact_to_company",
+		"DELETE /contacts/101/associations/companies/202/contact_to_company",
+		"PUT /contacts/101/associations/companies/203/contact_to_company",
+	}, requests, "expecting the previous companies to be unlinked before the company is linked")
+
+	requests = nil
+	companyId = "202"
+	_, err = c.UpdateContact(context.Background(), "101", &model.ContactInput{CompanyID: &companyId})
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotContains(t, requests, "DELETE /contacts/101/associations/companies/202/contact_to_company", "expecting the linked company to be kept")
+	assert.NotContains(t, requests, "PUT /contacts/101/associations/companies/202/contact_to_company", "expecting the linked company not to be linked again")
+}
+
+func TestListContactsForwardThenBack(t *testing.T) {
+	var searchPayload HSSearchPayload
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		contact := func(id string) map[string]interface{} {
+			return map[string]interface{}{"id": id, "properties": map[string]string{"hs_object_id": id}}
+		}
+
+		if r.Method == "GET" {
+			assert.Equal(t, "/contacts", r.URL.Path, "expecting the plain list to use the list endpoint")
+			if r.URL.Query().Get("after") == "" {
+				writeJSON(w, http.StatusOK, map[string]interface{}{"results": []interface{}{contact("1"), contact("2"), contact("3")}})
+				return
+			}
+			assert.Equal(t, "2", r.URL.Query().Get("after"), "expecting the page after the end cursor")
+			writeJSON(w, http.StatusOK, map[string]interface{}{"results": []interface{}{contact("2"), contact("3"), contact("4"), contact("5")}})
+			return
+		}
+
+		if r.URL.Path == "/associations/contacts/companies/batch/read" {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"results": []interface{}{}})
+			return
+		}
+
+		assert.Equal(t, "/contacts/search", r.URL.Path, "expecting the page before the cursor to be searched")
+		searchPayload = HSSearchPayload{}
+		json.NewDecoder(r.Body).Decode(&searchPayload)
+		// the closest records first
+		writeJSON(w, http.StatusOK, map[string]interface{}{"total": 5, "results": []interface{}{contact("2"), contact("1")}})
+	})
+	ctx := context.Background()
+
+	connection, err := c.ListContacts(ctx, &connectors.ListParams{First: 2})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, connection.PageInfo.HasNextPage, "expecting a next page")
+
+	connection, err = c.ListContacts(ctx, &connectors.ListParams{First: 2, After: connection.PageInfo.EndCursor})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "3", connection.Edges[0].Node.ID, "expecting the page after the end cursor")
+	assert.True(t, connection.PageInfo.HasPreviousPage, "expecting a previous page")
+
+	last := 2
+	connection, err = c.ListContacts(ctx, &connectors.ListParams{Last: &last, Before: connection.PageInfo.StartCursor})
+	assert.Nil(t, err, "expecting the object ID cursor to be accepted backward")
+	startId := "3"
+	assert.Equal(t, []HSSearchFilterGroup{{Filters: []HSSearchFilter{{PropertyName: "hs_object_id", Operator: "LT", Value: &startId}}}}, searchPayload.FilterGroups, "expecting the records before the start cursor")
+	assert.Equal(t, []HSSearchSort{{PropertyName: "hs_object_id", Direction: "DESCENDING"}}, searchPayload.Sorts, "expecting the closest records first")
+	assert.Equal(t, 3, searchPayload.Limit, "expecting one more record to see if there are more pages")
+
+	ids := []string{}
+	for _, edge := range connection.Edges {
+		ids = append(ids, edge.Node.ID)
+	}
+	assert.Equal(t, []string{"1", "2"}, ids, "expecting the first page back in the list order")
+	assert.Equal(t, connectors.EncodeCursor("1"), *connection.PageInfo.StartCursor, "expecting object ID cursors")
+	assert.False(t, connection.PageInfo.HasPreviousPage, "expecting no page before the first one")
+	assert.True(t, connection.PageInfo.HasNextPage, "expecting the page the client came from")
+
+	connection, err = c.ListContacts(ctx, &connectors.ListParams{Last: &last})
+	assert.Nil(t, err, "expecting the last page to be listed without a cursor")
+	assert.Empty(t, searchPayload.FilterGroups, "expecting the last records to be searched without a cursor")
+}
+
+func TestListLeadsSortedBackward(t *testing.T) {
+	var searchPayload HSSearchPayload
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/contacts/search", r.URL.Path, "expecting the leads to be searched")
+		json.NewDecoder(r.Body).Decode(&searchPayload)
+
+		lead := func(id string) map[string]interface{} {
+			return map[string]interface{}{"id": id, "properties": map[string]string{"lifecyclestage": "lead"}}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"total": 7, "results": []interface{}{lead("101"), lead("102"), lead("103")}})
+	})
+
+	last := 2
+	before := searchCursor(3)
+	descending := model.SortDirectionDesc
+	connection, err := c.ListLeads(context.Background(), &connectors.ListParams{
+		Last:              &last,
+		Before:            &before,
+		OrderBy:           []*model.SortInput{{Field: "lastName", Direction: &descending}},
+		IncludeTotalCount: true,
+	})
+	assert.Nil(t, err, "expecting nil error")
+
+	assert.Equal(t, "lifecyclestage", searchPayload.FilterGroups[0].Filters[0].PropertyName, "expecting only the leads")
+	assert.Equal(t, []HSSearchSort{{PropertyName: "lastname", Direction: "DESCENDING"}}, searchPayload.Sorts, "expecting the requested order")
+	assert.Equal(t, 3, searchPayload.Limit, "expecting one more record to see if there are more pages")
+
+	assert.Equal(t, 2, len(connection.Edges), "expecting the last records before the cursor")
+	assert.Equal(t, "102", connection.Edges[0].Node.ID, "expecting the records before the cursor")
+	assert.Equal(t, searchCursor(2), *connection.PageInfo.EndCursor, "expecting offset cursors")
+	assert.True(t, connection.PageInfo.HasPreviousPage, "expecting a previous page")
+	assert.True(t, connection.PageInfo.HasNextPage, "expecting the page the client came from")
+	assert.Equal(t, 7, *connection.TotalCount, "expecting the total of the leads")
+}
+
+func TestListUsersSortedInMemory(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/owners", r.URL.Path, "expecting the owners to be listed")
+		writeJSON(w, http.StatusOK, map[string]interface{}{"results": []map[string]string{
+			{"id": "1", "lastName": "Young"},
+			{"id": "2", "lastName": "Adams"},
+			{"id": "3", "lastName": "Miller"},
+		}})
+	})
+	ctx := context.Background()
+	orderBy := []*model.SortInput{{Field: "lastName"}}
+
+	connection, err := c.ListUsers(ctx, &connectors.ListParams{First: 2, OrderBy: orderBy, IncludeTotalCount: true})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "2", connection.Edges[0].Node.ID, "expecting the users in the requested order")
+	assert.Equal(t, "3", connection.Edges[1].Node.ID, "expecting the users in the requested order")
+	assert.True(t, connection.PageInfo.HasNextPage, "expecting a next page")
+	assert.Equal(t, 3, *connection.TotalCount, "expecting all the owners to be counted")
+
+	last := 1
+	connection, err = c.ListUsers(ctx, &connectors.ListParams{Last: &last, Before: connection.PageInfo.EndCursor, OrderBy: orderBy})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(connection.Edges), "expecting the user before the cursor")
+	assert.Equal(t, "2", connection.Edges[0].Node.ID, "expecting the user before the cursor")
+	assert.False(t, connection.PageInfo.HasPreviousPage, "expecting no page before the first one")
+
+	_, err = c.ListUsers(ctx, &connectors.ListParams{First: 2, OrderBy: []*model.SortInput{{Field: "active"}}})
+	assert.EqualError(t, err, "sorting by active is not supported")
+}
+
+func TestListChangesSince(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+	since := time.Now().UTC().Add(-time.Minute)
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	_, err = c.DeleteContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+
+	params, err := connectors.NewChangesParams(&since, 100, nil, []model.ChangeObjectType{model.ChangeObjectTypeContact})
+	assert.Nil(t, err, "expecting nil error")
+
+	changeConnection, err := c.ListChanges(ctx, params)
+	assert.Nil(t, err, "expecting nil error")
+
+	deleted := false
+	for _, edge := range changeConnection.Edges {
+		if edge.Node.ObjectID == contact.ID && edge.Node.ChangeType == model.ChangeTypeDeleted {
+			deleted = true
+		}
+	}
+	assert.True(t, deleted, "expecting the deleted contact in the changes")
+}
+
+func TestChangesFilterGroups(t *testing.T) {
+	changedAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	timestamp := "1646128800000"
+	objectId := "1001"
+
+	filterGroups := hsChangesFilterGroups("lastmodifieddate", connectors.ChangeBound{Time: changedAt, After: &objectId})
+	assert.Equal(t, []HSSearchFilterGroup{
+		{Filters: []HSSearchFilter{{PropertyName: "lastmodifieddate", Operator: "GT", Value: &timestamp}}},
+		{Filters: []HSSearchFilter{
+			{PropertyName: "lastmodifieddate", Operator: "EQ", Value: &timestamp},
+			{PropertyName: "hs_object_id", Operator: "GT", Value: &objectId},
+		}},
+	}, filterGroups, "expecting the objects modified later or at the same time with greater IDs")
+
+	filterGroups = hsChangesFilterGroups("lastmodifieddate", connectors.ChangeBound{Time: changedAt})
+	assert.Equal(t, []HSSearchFilterGroup{
+		{Filters: []HSSearchFilter{{PropertyName: "lastmodifieddate", Operator: "GTE", Value: &timestamp}}},
+	}, filterGroups, "expecting the objects modified since the bound")
+}
+
+func TestMergeChangeBatches(t *testing.T) {
+	since := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	change := func(objectType model.ChangeObjectType, objectId string, minutes int) *model.Change {
+		return &model.Change{ObjectType: objectType, ObjectID: objectId, ChangedAt: since.Add(time.Duration(minutes) * time.Minute)}
+	}
+
+	params, err := connectors.NewChangesParams(&since, 3, nil, nil)
+	assert.Nil(t, err, "expecting nil error")
+
+	connection := connectors.NewChangeConnection(params, []*connectors.ChangeBatch{
+		{Changes: []*model.Change{change(model.ChangeObjectTypeContact, "9", 1), change(model.ChangeObjectTypeContact, "10", 1), change(model.ChangeObjectTypeContact, "11", 5)}, HasMore: true},
+		{Changes: []*model.Change{change(model.ChangeObjectTypeOpportunity, "1", 1), change(model.ChangeObjectTypeOpportunity, "2", 6)}},
+	})
+
+	ids := []string{}
+	for _, edge := range connection.Edges {
+		ids = append(ids, edge.Node.ObjectID)
+	}
+	assert.Equal(t, []string{"9", "10", "1"}, ids, "expecting the changes ordered by the time, the type and the ID")
+	assert.True(t, connection.PageInfo.HasNextPage, "expecting more changes")
+
+	params, err = connectors.NewChangesParams(nil, 3, connection.PageInfo.EndCursor, nil)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, since, params.Since, "expecting the cursor to keep the start of the feed")
+	assert.True(t, params.Bound(model.ChangeObjectTypeContact).Exclusive, "expecting contacts modified at the cursor time to be skipped")
+	assert.Equal(t, "1", *params.Bound(model.ChangeObjectTypeOpportunity).After, "expecting opportunities after the cursor ID")
+}
+
+func TestDescribeObject(t *testing.T) {
+	godotenv.Load("../../.env")
+	c := HubspotClient(os.Getenv("HUBSPOT_ACCESS_TOKEN"))
+
+	ctx := context.Background()
+
+	description, err := c.DescribeObject(ctx, model.CrmObjectOpportunity)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "deals", description.Name)
+
+	var dealStage *model.FieldDescription
+	for _, field := range description.Fields {
+		if field.Name == "dealstage" {
+			dealStage = field
+		}
+	}
+	assert.NotNil(t, dealStage, "expecting the deal stage to be described")
+	assert.Greater(t, len(dealStage.PicklistValues), 0, "expecting the stages to be listed as the values of the deal stage")
+}
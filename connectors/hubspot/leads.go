@@ -0,0 +1,257 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"time"
+)
+
+// HubSpot has no lead object, leads are contacts with the "lead" lifecycle stage
+// https://knowledge.hubspot.com/contacts/use-lifecycle-stages
+
+const (
+	HS_LIFECYCLE_STAGE_LEAD                 = "lead"
+	HS_LIFECYCLE_STAGE_SALES_QUALIFIED_LEAD = "salesqualifiedlead"
+	HS_LIFECYCLE_STAGE_OPPORTUNITY          = "opportunity"
+	HS_DEFAULT_PIPELINE_FIRST_STAGE         = "appointmentscheduled"
+)
+
+type HSLead struct {
+	Id        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Archived  bool   `json:"archived"`
+
+	Properties struct {
+		Company        string `json:"company"`
+		Phone          string `json:"phone"`
+		Website        string `json:"website"`
+		Email          string `json:"email"`
+		FirstName      string `json:"firstname"`
+		LastName       string `json:"lastname"`
+		JobTitle       string `json:"jobtitle"`
+		HsLeadStatus   string `json:"hs_lead_status"`
+		LifecycleStage string `json:"lifecyclestage"`
+	} `json:"properties"`
+}
+
+type HSLeadCreateUpdatePayload struct {
+	Properties struct {
+		Company        *string `json:"company,omitempty"`
+		FirstName      *string `json:"firstname,omitempty"`
+		LastName       *string `json:"lastname,omitempty"`
+		Email          *string `json:"email,omitempty"`
+		Phone          *string `json:"phone,omitempty"`
+		Website        *string `json:"website,omitempty"`
+		JobTitle       *string `json:"jobtitle,omitempty"`
+		HsLeadStatus   *string `json:"hs_lead_status,omitempty"`
+		LifecycleStage *string `json:"lifecyclestage,omitempty"`
+	} `json:"properties"`
+}
+
+type HSLeadsSearchSuccessResponse struct {
+	Total   int      `json:"total"`
+	Results []HSLead `json:"results"`
+}
+
+var hsLeadProperties = []string{
+	"company", "phone", "website", "email", "firstname", "lastname", "jobtitle", "hs_lead_status", "lifecyclestage",
+}
+
+// Leads are always searched by their lifecycle stage, so they have the offset cursors of the searched lists
+func (client *Client) ListLeads(ctx context.Context, params *connectors.ListParams) (*model.LeadConnection, error) {
+	sorts, err := hsSearchSorts(params.OrderBy, hsLeadFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycleStage := HS_LIFECYCLE_STAGE_LEAD
+	payload := HSSearchPayload{
+		FilterGroups: []HSSearchFilterGroup{{
+			Filters: []HSSearchFilter{{PropertyName: "lifecyclestage", Operator: "EQ", Value: &lifecycleStage}},
+		}},
+		Properties: hsLeadProperties,
+		Sorts:      sorts,
+	}
+
+	response := HSLeadsSearchSuccessResponse{}
+	offset, err := client.search(ctx, "contacts", params, &payload, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	leadEdges := make([]*model.LeadEdge, len(response.Results))
+	for i, hsLead := range response.Results {
+		leadEdges[i] = &model.LeadEdge{
+			Node:   hsLead.mapLeadProperties(),
+			Cursor: searchCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &leadEdges)
+
+	connection := model.LeadConnection{
+		Edges:    recordsValue.Interface().([]*model.LeadEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		connection.TotalCount = &response.Total
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetLead(ctx context.Context, leadId string) (*model.Lead, error) {
+	response := HSLead{}
+	if err := client.get(ctx, "contacts", leadId, hsLeadProperties, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.mapLeadProperties(), nil
+}
+
+func (client *Client) CreateLead(ctx context.Context, input *model.LeadInput) (*model.Lead, error) {
+	payload := createHSLeadPayload(input)
+	lifecycleStage := HS_LIFECYCLE_STAGE_LEAD
+	payload.Properties.LifecycleStage = &lifecycleStage
+
+	response := HSLead{}
+	if err := client.create(ctx, "contacts", payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response.mapLeadProperties(), nil
+}
+
+func (client *Client) UpdateLead(ctx context.Context, leadId string, input *model.LeadInput) (bool, error) {
+	payload := createHSLeadPayload(input)
+
+	response := HSLead{}
+	if err := client.update(ctx, "contacts", leadId, payload, &response); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteLead(ctx context.Context, leadId string) (bool, error) {
+	return client.DeleteContact(ctx, leadId)
+}
+
+// Converting a lead moves the contact forward in the lifecycle,
+// associates it with a company and (optionally) creates a deal for it
+func (client *Client) ConvertLead(ctx context.Context, leadId string, input *model.LeadConversionInput) (*model.LeadConversionResult, error) {
+	hsLead := HSLead{}
+	if err := client.get(ctx, "contacts", leadId, hsLeadProperties, nil, &hsLead); err != nil {
+		return nil, err
+	}
+
+	if hsLead.Properties.LifecycleStage != HS_LIFECYCLE_STAGE_LEAD {
+		return nil, errors.New("contact is not a lead")
+	}
+
+	result := model.LeadConversionResult{ContactID: leadId}
+
+	if input.CompanyID != nil {
+		result.CompanyID = input.CompanyID
+	} else if hsLead.Properties.Company != "" {
+		company, err := client.CreateCompany(ctx, &model.CompanyInput{Name: hsLead.Properties.Company, Website: &hsLead.Properties.Website})
+		if err != nil {
+			return nil, err
+		}
+		result.CompanyID = &company.ID
+	}
+
+	if result.CompanyID != nil {
+		if _, err := client.LinkContactToCompany(ctx, leadId, *result.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+
+	lifecycleStage := HS_LIFECYCLE_STAGE_SALES_QUALIFIED_LEAD
+	if input.CreateOpportunity == nil || *input.CreateOpportunity {
+		opportunityID, err := client.createLeadConversionDeal(ctx, &hsLead, result.CompanyID, input.OpportunityName)
+		if err != nil {
+			return nil, err
+		}
+
+		result.OpportunityID = &opportunityID
+		lifecycleStage = HS_LIFECYCLE_STAGE_OPPORTUNITY
+	}
+
+	payload := HSLeadCreateUpdatePayload{}
+	payload.Properties.LifecycleStage = &lifecycleStage
+	payload.Properties.HsLeadStatus = input.ConvertedStatus
+	if err := client.update(ctx, "contacts", leadId, payload, &HSLead{}); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (client *Client) createLeadConversionDeal(ctx context.Context, hsLead *HSLead, companyId *string, opportunityName *string) (string, error) {
+	dealName := hsLead.Properties.Company
+	if dealName == "" {
+		dealName = hsLead.Properties.FirstName + " " + hsLead.Properties.LastName
+	}
+	if opportunityName != nil {
+		dealName = *opportunityName
+	}
+
+	opportunity, err := client.CreateOpportunity(ctx, &model.OpportunityInput{
+		Name:      dealName,
+		StageName: HS_DEFAULT_PIPELINE_FIRST_STAGE,
+		CloseDate: time.Now().UTC().AddDate(0, 1, 0),
+		CompanyID: companyId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := client.associate(ctx, "deals", opportunity.ID, "contacts", hsLead.Id, "deal_to_contact"); err != nil {
+		return "", err
+	}
+
+	return opportunity.ID, nil
+}
+
+// Creates Hubspot Contact Update/Create payload for a lead from GraphQL input
+func createHSLeadPayload(input *model.LeadInput) *HSLeadCreateUpdatePayload {
+	payload := HSLeadCreateUpdatePayload{}
+
+	payload.Properties.FirstName = input.FirstName
+	payload.Properties.LastName = input.LastName
+	payload.Properties.Email = input.Email
+	payload.Properties.Phone = input.Phone
+	payload.Properties.Website = input.Website
+	payload.Properties.Company = input.CompanyName
+	payload.Properties.JobTitle = input.Title
+	payload.Properties.HsLeadStatus = input.Status
+
+	return &payload
+}
+
+func (hsLead HSLead) mapLeadProperties() *model.Lead {
+	name := hsLead.Properties.FirstName + " " + hsLead.Properties.LastName
+	converted := hsLead.Properties.LifecycleStage != HS_LIFECYCLE_STAGE_LEAD
+
+	return &model.Lead{
+		ID:          hsLead.Id,
+		Name:        &name,
+		FirstName:   &hsLead.Properties.FirstName,
+		LastName:    &hsLead.Properties.LastName,
+		Email:       &hsLead.Properties.Email,
+		Phone:       &hsLead.Properties.Phone,
+		Website:     &hsLead.Properties.Website,
+		CompanyName: &hsLead.Properties.Company,
+		Title:       &hsLead.Properties.JobTitle,
+		Status:      &hsLead.Properties.HsLeadStatus,
+		Converted:   &converted,
+		CreatedAt:   parseHSDateTime(&hsLead.CreatedAt),
+		UpdatedAt:   parseHSDateTime(&hsLead.UpdatedAt),
+		Archived:    &hsLead.Archived,
+	}
+}
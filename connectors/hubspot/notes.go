@@ -3,9 +3,6 @@ package hubspot
 import (
 	"blendbase/graph/model"
 	"context"
-	"fmt"
-	"net/http"
-	"net/url"
 	"time"
 )
 
@@ -46,25 +43,15 @@ func (client *Client) CreateOpportunityNote(ctx context.Context, opportunityId s
 }
 
 func (client *Client) listNotes(ctx context.Context, objectPath string, objectId string) ([]*model.Note, error) {
-	query := url.Values{}
-	url := fmt.Sprintf("%s/%s/%s/associations/notes?%s", client.BaseURL, objectPath, objectId, query.Encode())
-
-	req, err := http.NewRequest("GET", url, nil)
+	noteIds, err := client.listAssociations(ctx, objectPath, objectId, "notes")
 	if err != nil {
 		return nil, err
 	}
 
-	associationResponse := HSAssociationListSuccessResponse{}
-
-	req = req.WithContext(ctx)
-	if err := client.sendRequest(req, &associationResponse); err != nil {
-		return nil, err
-	}
-
 	hsNote := HSNote{}
-	notes := make([]*model.Note, len(associationResponse.Results))
-	for i, hsAssociation := range associationResponse.Results {
-		if err := client.get(ctx, "notes", hsAssociation.Id, []string{"hs_note_body"}, &hsNote); err != nil {
+	notes := make([]*model.Note, len(noteIds))
+	for i, noteId := range noteIds {
+		if err := client.get(ctx, "notes", noteId, []string{"hs_note_body"}, nil, &hsNote); err != nil {
 			return nil, err
 		}
 
@@ -85,14 +72,7 @@ func (client *Client) createNoteAndAssociate(ctx context.Context, relatedObjectN
 		return nil, err
 	}
 
-	query := url.Values{}
-	url := fmt.Sprintf("%s/notes/%s/associations/%s/%s/%s?%s",
-		client.BaseURL, hsNote.Id, relatedObjectName, relatedObjectId, associationName, query.Encode())
-	req, err := http.NewRequest("PUT", url, nil)
-	if err != nil {
-		return nil, err
-	}
-	if err := client.sendRequest(req, nil); err != nil {
+	if err := client.associate(ctx, "notes", hsNote.Id, relatedObjectName, relatedObjectId, associationName); err != nil {
 		return nil, err
 	}
 
@@ -21,10 +21,41 @@ type HSDeal struct {
 		HubspotOwnerId *string `json:"hubspot_owner_id"`
 		Pipeline       *string `json:"pipeline"`
 	} `json:"properties"`
+
+	Associations *HSObjectAssociations `json:"associations"`
+
+	RawProperties map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (hsDeal *HSDeal) UnmarshalJSON(data []byte) error {
+	type plainHSDeal HSDeal
+	rawFields := struct {
+		Properties *map[string]interface{} `json:"properties"`
+	}{&hsDeal.RawProperties}
+
+	return connectors.UnmarshalWithRawFields(data, (*plainHSDeal)(hsDeal), &rawFields)
 }
 
 type HSDealsListSuccessResponse struct {
 	Results []HSDeal `json:"results"`
+	Total   int      `json:"total"` // only returned by the search endpoint
+}
+
+var hsDealProperties = []string{
+	"amount", "closedate", "dealname", "dealstage", "hubspot_owner_id", "pipeline",
+}
+
+// Properties of the deals to read, including the custom fields
+func (client *Client) hsDealProperties() []string {
+	return client.FieldMapping.QueryFields(model.CrmObjectOpportunity, hsDealProperties)
+}
+
+// Maps the opportunity with its custom fields
+func (client *Client) mapOpportunity(hsDeal *HSDeal) *model.Opportunity {
+	opportunity := hsDeal.mapOpportunityProperties()
+	opportunity.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectOpportunity, hsDeal.RawProperties)
+
+	return opportunity
 }
 
 func (hsDeal *HSDeal) mapOpportunityProperties() *model.Opportunity {
@@ -36,11 +67,16 @@ func (hsDeal *HSDeal) mapOpportunityProperties() *model.Opportunity {
 	}
 
 	return &model.Opportunity{
-		ID:        hsDeal.Id,
-		Name:      hsDeal.Properties.DealName,
-		StageName: hsDeal.Properties.DealStage,
-		CloseDate: closeDatePtr,
-		Amount:    hsDeal.Properties.Amount,
+		ID:         hsDeal.Id,
+		Name:       hsDeal.Properties.DealName,
+		StageName:  hsDeal.Properties.DealStage,
+		PipelineID: hsDeal.Properties.Pipeline,
+		CloseDate:  closeDatePtr,
+		Amount:     hsDeal.Properties.Amount,
+		Company:    hsDeal.Associations.companyReference(),
+		Owner:      hsOwnerReference(hsDeal.Properties.HubspotOwnerId),
+		CreatedAt:  parseHSDateTime(&hsDeal.CreatedAt),
+		UpdatedAt:  parseHSDateTime(&hsDeal.UpdatedAt),
 	}
 }
 
@@ -50,14 +86,14 @@ type HSDealCreateUpdatePayload struct {
 		CloseDate      *string `json:"closedate"`
 		DealName       string  `json:"dealname"`
 		DealStage      *string `json:"dealstage"`
-		HubspotOwnerId *string `json:"hubspot_owner_id"`
-		Pipeline       *string `json:"pipeline"`
+		HubspotOwnerId *string `json:"hubspot_owner_id,omitempty"`
+		Pipeline       *string `json:"pipeline,omitempty"`
 	} `json:"properties"`
 }
 
-func (client *Client) ListOpportunities(ctx context.Context, first int, after *string) (*model.OpportunityConnection, error) {
+func (client *Client) ListOpportunities(ctx context.Context, params *connectors.ListParams) (*model.OpportunityConnection, error) {
 	response := HSDealsListSuccessResponse{}
-	err := client.list(ctx, "deals", first, after, connectors.StructFieldNames(HSContact{}.Properties), &response)
+	offset, err := client.listOrSearch(ctx, "deals", params, client.hsDealProperties(), []string{"companies"}, hsDealFilterFields, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -65,51 +101,80 @@ func (client *Client) ListOpportunities(ctx context.Context, first int, after *s
 	opportunityEdges := make([]*model.OpportunityEdge, len(response.Results))
 
 	var opportunity *model.Opportunity
-	for i, hsOpportunity := range response.Results {
-		opportunity = hsOpportunity.mapOpportunityProperties()
+	for i := range response.Results {
+		opportunity = client.mapOpportunity(&response.Results[i])
 		opportunityEdges[i] = &model.OpportunityEdge{
 			Node:   opportunity,
 			Cursor: connectors.EncodeCursor(opportunity.ID),
 		}
+
+		if offset != nil {
+			opportunityEdges[i].Cursor = searchCursor(*offset + i)
+		}
 	}
 
-	recordsValue, pageInfo := client.prepareListResults(first, after, &opportunityEdges)
+	recordsValue, pageInfo := client.prepareListResults(params, &opportunityEdges)
 
-	return &model.OpportunityConnection{
+	connection := model.OpportunityConnection{
 		Edges:    recordsValue.Interface().([]*model.OpportunityEdge),
 		PageInfo: pageInfo,
-	}, nil
+	}
+
+	if params.IncludeTotalCount {
+		connection.TotalCount = &response.Total
+	}
+
+	return &connection, nil
 }
 
 func (client *Client) GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error) {
 	deal := &HSDeal{}
-	if err := client.get(ctx, "deals", opportunityId, connectors.StructFieldNames(HSDeal{}.Properties), deal); err != nil {
+	if err := client.get(ctx, "deals", opportunityId, client.hsDealProperties(), []string{"companies"}, deal); err != nil {
 		return nil, err
 	}
 
-	opportunity := deal.mapOpportunityProperties()
+	opportunity := client.mapOpportunity(deal)
 	return opportunity, nil
 }
 
 func (client *Client) CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error) {
-	payload := createHSDealPayload(input)
+	payload, err := client.createHSDealPayload(input)
+	if err != nil {
+		return nil, err
+	}
 
 	deal := HSDeal{}
 	if err := client.create(ctx, "deals", payload, &deal); err != nil {
 		return nil, err
 	}
 
-	return deal.mapOpportunityProperties(), nil
+	opportunity := client.mapOpportunity(&deal)
+
+	if input.CompanyID != nil {
+		if _, err := client.LinkOpportunityToCompany(ctx, opportunity.ID, *input.CompanyID); err != nil {
+			return nil, err
+		}
+		opportunity.Company = &model.Company{ID: *input.CompanyID}
+	}
+
+	return opportunity, nil
 }
 
 func (client *Client) UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error) {
-	payload := createHSDealPayload(input)
+	payload, err := client.createHSDealPayload(input)
+	if err != nil {
+		return false, err
+	}
 
 	deal := HSDeal{}
 	if err := client.update(ctx, "deals", opportunityId, payload, &deal); err != nil {
 		return false, err
 	}
 
+	if input.CompanyID != nil {
+		return client.replaceCompany(ctx, "deals", opportunityId, *input.CompanyID, "deal_to_company")
+	}
+
 	return true, nil
 }
 
@@ -122,7 +187,7 @@ func (client *Client) DeleteOpportunity(ctx context.Context, contactId string) (
 	return true, nil
 }
 
-func createHSDealPayload(input *model.OpportunityInput) *HSDealCreateUpdatePayload {
+func (client *Client) createHSDealPayload(input *model.OpportunityInput) (map[string]interface{}, error) {
 	payload := HSDealCreateUpdatePayload{}
 
 	payload.Properties.DealName = input.Name
@@ -130,6 +195,13 @@ func createHSDealPayload(input *model.OpportunityInput) *HSDealCreateUpdatePaylo
 	formattedDate := input.CloseDate.Format(time.RFC3339)
 	payload.Properties.CloseDate = &formattedDate
 	payload.Properties.Amount = input.Amount
+	payload.Properties.HubspotOwnerId = input.OwnerID
+	payload.Properties.Pipeline = input.PipelineID
+
+	properties, err := connectors.MergePayload(&payload.Properties, client.FieldMapping.CrmFields(model.CrmObjectOpportunity, input.CustomFields))
+	if err != nil {
+		return nil, err
+	}
 
-	return &payload
+	return map[string]interface{}{"properties": properties}, nil
 }
@@ -0,0 +1,94 @@
+package hubspot
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// https://developers.hubspot.com/docs/api/crm/pipelines
+type HSPipeline struct {
+	Id           string            `json:"id"`
+	Label        string            `json:"label"`
+	DisplayOrder int               `json:"displayOrder"`
+	Archived     bool              `json:"archived"`
+	Stages       []HSPipelineStage `json:"stages"`
+}
+
+type HSPipelineStage struct {
+	Id           string `json:"id"`
+	Label        string `json:"label"`
+	DisplayOrder int    `json:"displayOrder"`
+	Archived     bool   `json:"archived"`
+
+	// metadata values are strings, e.g. {"isClosed": "true", "probability": "1.0"}
+	Metadata struct {
+		IsClosed    string `json:"isClosed"`
+		Probability string `json:"probability"`
+	} `json:"metadata"`
+}
+
+type HSPipelinesListSuccessResponse struct {
+	Results []HSPipeline `json:"results"`
+}
+
+func (client *Client) ListPipelines(ctx context.Context) ([]*model.Pipeline, error) {
+	url := fmt.Sprintf("%s/pipelines/deals", client.crmBaseUrl())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := HSPipelinesListSuccessResponse{}
+
+	req = req.WithContext(ctx)
+	if err := client.sendRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	pipelines := []*model.Pipeline{}
+	for i := range response.Results {
+		if !response.Results[i].Archived {
+			pipelines = append(pipelines, response.Results[i].mapPipelineProperties())
+		}
+	}
+
+	return pipelines, nil
+}
+
+func (hsPipeline *HSPipeline) mapPipelineProperties() *model.Pipeline {
+	pipeline := model.Pipeline{
+		ID:           hsPipeline.Id,
+		Label:        hsPipeline.Label,
+		DisplayOrder: &hsPipeline.DisplayOrder,
+		Stages:       []*model.PipelineStage{},
+	}
+
+	for i := range hsPipeline.Stages {
+		if !hsPipeline.Stages[i].Archived {
+			pipeline.Stages = append(pipeline.Stages, hsPipeline.Stages[i].mapPipelineStageProperties())
+		}
+	}
+
+	return &pipeline
+}
+
+// HubSpot has no "won" flag, closed stages with 100% probability are the won ones
+func (hsStage *HSPipelineStage) mapPipelineStageProperties() *model.PipelineStage {
+	stage := model.PipelineStage{
+		ID:           hsStage.Id,
+		Label:        hsStage.Label,
+		DisplayOrder: &hsStage.DisplayOrder,
+		IsClosed:     hsStage.Metadata.IsClosed == "true",
+	}
+
+	if probability, err := strconv.ParseFloat(hsStage.Metadata.Probability, 64); err == nil {
+		stage.Probability = &probability
+		stage.IsWon = stage.IsClosed && probability == 1
+	}
+
+	return &stage
+}
@@ -0,0 +1,49 @@
+package hubspot
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+)
+
+func init() {
+	connectors.Register(connectors.Registration{
+		Connector: connectors.Connector{
+			ServiceCode: connectors.CONNECTOR_CRM_HUBSPOT,
+			Type:        connectors.CONNECTOR_TYPE_CRM,
+			Name:        "Hubspot",
+			Description: "HubSpot’s CRM platform also offers enterprise software for marketing, sales, customer service, content management, and operations.",
+			AuthType:    connectors.AUTH_TYPE_SECRET,
+			// the public apps are authorized with OAuth2
+			OtherAuthTypes: []string{connectors.AUTH_TYPE_OAUTH2},
+		},
+		Position: 2,
+		// the integrations authorized with OAuth2 use the tokens of the public app,
+		// the other integrations use the access token of a private app stored as their secret
+		NewCrmConnector: func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping connectors.FieldMapping) connectors.CrmConnector {
+			var client *Client
+			if oauthConfig != nil && oauthConfig.AccessToken.Raw != "" {
+				client = HubspotOAuth2Client(app, oauthConfig)
+			} else {
+				client = HubspotClient(integration.Secret.Raw)
+			}
+			client.FieldMapping = fieldMapping
+			return client
+		},
+		OAuth2: &oauth2Flow,
+		// leads are the contacts in the lead lifecycle stage
+		Capabilities: []*model.ObjectCapabilities{
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectContact, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "company", "owner", "customFields"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectOpportunity, "id", "createdAt", "updatedAt", "name", "amount", "stageName", "pipelineId", "closeDate", "stage", "company", "owner", "customFields"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectCompany, "id", "createdAt", "updatedAt", "archived", "name", "website", "phone", "industry", "description", "city", "country", "numberOfEmployees", "annualRevenue", "owner"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectLead, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "title", "status", "converted"), model.CrmOperationDescribe),
+			connectors.ObjectCapabilities(model.CrmObjectUser, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "active"),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectNote, "id", "createdAt", "updatedAt", "content"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectTask, "id", "createdAt", "updatedAt", "subject", "description", "status", "priority", "dueDate"), model.CrmOperationDescribe),
+			connectors.ObjectCapabilities(model.CrmObjectActivity, "id", "createdAt", "updatedAt", "type", "subject", "description", "startTime", "endTime"),
+			connectors.ObjectCapabilities(model.CrmObjectPipeline, connectors.PipelineFields...),
+			connectors.ObjectCapabilities(model.CrmObjectChange, connectors.ChangeFields...),
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package hubspot
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"time"
+)
+
+// HubSpot uses MEDIUM instead of NORMAL priority, the status values match the unified ones
+var hsTaskPriorities = map[model.TaskPriority]string{
+	model.TaskPriorityLow:    "LOW",
+	model.TaskPriorityNormal: "MEDIUM",
+	model.TaskPriorityHigh:   "HIGH",
+}
+
+type HSTask struct {
+	Id        string  `json:"id"`
+	CreatedAt *string `json:"createdAt"`
+	UpdatedAt *string `json:"updatedAt"`
+	Archived  bool    `json:"archived"`
+
+	Properties struct {
+		HsTaskSubject  string  `json:"hs_task_subject"`
+		HsTaskBody     string  `json:"hs_task_body"` // can contain HTML
+		HsTaskStatus   string  `json:"hs_task_status"`
+		HsTaskPriority string  `json:"hs_task_priority"`
+		HsTimestamp    *string `json:"hs_timestamp"` // due date
+	} `json:"properties"`
+}
+
+type HSTaskCreateUpdatePayload struct {
+	Properties struct {
+		HsTaskSubject  string  `json:"hs_task_subject"`
+		HsTaskBody     *string `json:"hs_task_body,omitempty"`
+		HsTaskStatus   *string `json:"hs_task_status,omitempty"`
+		HsTaskPriority *string `json:"hs_task_priority,omitempty"`
+		HsTimestamp    *string `json:"hs_timestamp,omitempty"`
+	} `json:"properties"`
+}
+
+type HSTasksListSuccessResponse struct {
+	Results []HSTask `json:"results"`
+}
+
+var hsTaskProperties = []string{
+	"hs_task_subject", "hs_task_body", "hs_task_status", "hs_task_priority", "hs_timestamp",
+}
+
+func (client *Client) ListContactTasks(ctx context.Context, contactId string) ([]*model.Task, error) {
+	return client.listTasks(ctx, "contacts", contactId)
+}
+
+func (client *Client) CreateContactTask(ctx context.Context, contactId string, input *model.TaskInput) (*model.Task, error) {
+	return client.createTaskAndAssociate(ctx, "contacts", contactId, "task_to_contact", input)
+}
+
+func (client *Client) ListOpportunityTasks(ctx context.Context, opportunityId string) ([]*model.Task, error) {
+	return client.listTasks(ctx, "deals", opportunityId)
+}
+
+func (client *Client) CreateOpportunityTask(ctx context.Context, opportunityId string, input *model.TaskInput) (*model.Task, error) {
+	return client.createTaskAndAssociate(ctx, "deals", opportunityId, "task_to_deal", input)
+}
+
+func (client *Client) UpdateTask(ctx context.Context, taskId string, input *model.TaskInput) (bool, error) {
+	payload := createHSTaskPayload(input)
+
+	response := HSTask{}
+	if err := client.update(ctx, "tasks", taskId, payload, &response); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) listTasks(ctx context.Context, objectPath string, objectId string) ([]*model.Task, error) {
+	taskIds, err := client.listAssociations(ctx, objectPath, objectId, "tasks")
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*model.Task{}
+	for _, chunk := range chunkIds(taskIds, HS_BATCH_READ_LIMIT) {
+		response := HSTasksListSuccessResponse{}
+		if err := client.batchRead(ctx, "tasks", chunk, hsTaskProperties, &response); err != nil {
+			return nil, err
+		}
+
+		for _, hsTask := range response.Results {
+			tasks = append(tasks, hsTask.mapTaskProperties())
+		}
+	}
+
+	return tasks, nil
+}
+
+func (client *Client) createTaskAndAssociate(ctx context.Context, relatedObjectName string, relatedObjectId string, associationName string, input *model.TaskInput) (*model.Task, error) {
+	payload := createHSTaskPayload(input)
+
+	// the due date is required on creation
+	if payload.Properties.HsTimestamp == nil {
+		hsTimestamp := formatHSDateTime(time.Now().UTC())
+		payload.Properties.HsTimestamp = &hsTimestamp
+	}
+
+	hsTask := HSTask{}
+	if err := client.create(ctx, "tasks", payload, &hsTask); err != nil {
+		return nil, err
+	}
+
+	if err := client.associate(ctx, "tasks", hsTask.Id, relatedObjectName, relatedObjectId, associationName); err != nil {
+		return nil, err
+	}
+
+	return hsTask.mapTaskProperties(), nil
+}
+
+// Creates Hubspot Task Update/Create payload from GraphQL input
+func createHSTaskPayload(input *model.TaskInput) *HSTaskCreateUpdatePayload {
+	payload := HSTaskCreateUpdatePayload{}
+
+	payload.Properties.HsTaskSubject = input.Subject
+	payload.Properties.HsTaskBody = input.Description
+
+	if input.Status != nil {
+		status := input.Status.String()
+		payload.Properties.HsTaskStatus = &status
+	}
+
+	if input.Priority != nil {
+		priority := hsTaskPriorities[*input.Priority]
+		payload.Properties.HsTaskPriority = &priority
+	}
+
+	if input.DueDate != nil {
+		hsTimestamp := formatHSDateTime(input.DueDate.UTC())
+		payload.Properties.HsTimestamp = &hsTimestamp
+	}
+
+	return &payload
+}
+
+func (hsTask HSTask) mapTaskProperties() *model.Task {
+	task := model.Task{
+		ID:          hsTask.Id,
+		Subject:     hsTask.Properties.HsTaskSubject,
+		Description: &hsTask.Properties.HsTaskBody,
+		DueDate:     parseHSDateTime(hsTask.Properties.HsTimestamp),
+		CreatedAt:   parseHSDateTime(hsTask.CreatedAt),
+		UpdatedAt:   parseHSDateTime(hsTask.UpdatedAt),
+	}
+
+	if status := model.TaskStatus(hsTask.Properties.HsTaskStatus); status.IsValid() {
+		task.Status = &status
+	}
+
+	for priority, hsPriority := range hsTaskPriorities {
+		if hsPriority == hsTask.Properties.HsTaskPriority {
+			priority := priority
+			task.Priority = &priority
+		}
+	}
+
+	return &task
+}
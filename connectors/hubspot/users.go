@@ -0,0 +1,170 @@
+package hubspot
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Maximum page size of the owners endpoint
+const HS_OWNERS_PAGE_LIMIT = 500
+
+// Owners aren't CRM objects, they have their own endpoint next to the objects ones
+// https://developers.hubspot.com/docs/api/crm/owners
+type HSOwner struct {
+	Id        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Archived  bool   `json:"archived"`
+}
+
+type HSOwnersListSuccessResponse struct {
+	Results []HSOwner `json:"results"`
+	Paging  *struct {
+		Next *struct {
+			After string `json:"after"`
+		} `json:"next"`
+	} `json:"paging"`
+}
+
+// Owners are paginated with an opaque token, so the whole list is loaded, sorted in memory and the
+// offset of the record is used as the cursor, the same way as for the search results
+func (client *Client) ListUsers(ctx context.Context, params *connectors.ListParams) (*model.UserConnection, error) {
+	hsOwners, err := client.listAllOwners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*model.User, len(hsOwners))
+	for i, hsOwner := range hsOwners {
+		users[i] = hsOwner.mapUserProperties()
+	}
+	if err := connectors.SortUsers(users, params.OrderBy); err != nil {
+		return nil, err
+	}
+
+	offset, limit, err := searchPage(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > len(users) {
+		offset = len(users)
+	}
+	if offset+limit > len(users) {
+		limit = len(users) - offset
+	}
+
+	userEdges := make([]*model.UserEdge, limit)
+	for i, user := range users[offset : offset+limit] {
+		userEdges[i] = &model.UserEdge{
+			Node:   user,
+			Cursor: searchCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &userEdges)
+
+	connection := model.UserConnection{
+		Edges:    recordsValue.Interface().([]*model.UserEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount := len(users)
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetUser(ctx context.Context, userId string) (*model.User, error) {
+	url := fmt.Sprintf("%s/owners/%s", client.crmBaseUrl(), userId)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hsOwner := HSOwner{}
+
+	req = req.WithContext(ctx)
+	if err := client.sendRequest(req, &hsOwner); err != nil {
+		if err.StatusCode == 404 {
+			return nil, errors.New("not found")
+		}
+
+		return nil, err
+	}
+
+	return hsOwner.mapUserProperties(), nil
+}
+
+func (client *Client) listAllOwners(ctx context.Context) ([]HSOwner, error) {
+	hsOwners := []HSOwner{}
+
+	query := url.Values{}
+	query.Set("limit", fmt.Sprint(HS_OWNERS_PAGE_LIMIT))
+
+	for {
+		url := fmt.Sprintf("%s/owners?%s", client.crmBaseUrl(), query.Encode())
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := HSOwnersListSuccessResponse{}
+
+		req = req.WithContext(ctx)
+		if err := client.sendRequest(req, &response); err != nil {
+			return nil, err
+		}
+
+		hsOwners = append(hsOwners, response.Results...)
+
+		if response.Paging == nil || response.Paging.Next == nil {
+			return hsOwners, nil
+		}
+		query.Set("after", response.Paging.Next.After)
+	}
+}
+
+// Base URL of the CRM API without the objects path
+func (client *Client) crmBaseUrl() string {
+	return strings.TrimSuffix(client.BaseURL, "/objects")
+}
+
+// Returns a reference to the owner, if any
+func hsOwnerReference(ownerId *string) *model.User {
+	if ownerId == nil || *ownerId == "" {
+		return nil
+	}
+
+	return &model.User{ID: *ownerId}
+}
+
+func (hsOwner HSOwner) mapUserProperties() *model.User {
+	name := strings.TrimSpace(hsOwner.FirstName + " " + hsOwner.LastName)
+	active := !hsOwner.Archived
+
+	return &model.User{
+		ID:        hsOwner.Id,
+		Name:      &name,
+		FirstName: &hsOwner.FirstName,
+		LastName:  &hsOwner.LastName,
+		Email:     &hsOwner.Email,
+		Active:    &active,
+		Archived:  &hsOwner.Archived,
+		CreatedAt: parseHSDateTime(&hsOwner.CreatedAt),
+		UpdatedAt: parseHSDateTime(&hsOwner.UpdatedAt),
+	}
+}
@@ -0,0 +1,200 @@
+package connectors
+
+import (
+	"blendbase/config"
+	"blendbase/integrations"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// Query parameter of the login URL selecting the integration to authorize
+const OAUTH2_INTEGRATION_ID_PARAM = "integrationId"
+
+// Returns the integration selected by the login URL, nil when the URL doesn't select one
+func OAuth2LoginIntegrationID(r *http.Request) (*uuid.UUID, error) {
+	integrationID := r.URL.Query().Get(OAUTH2_INTEGRATION_ID_PARAM)
+	if integrationID == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(integrationID)
+	if err != nil {
+		return nil, errors.New("invalid integration ID")
+	}
+
+	return &id, nil
+}
+
+// Loads the consumer the ConsumerCtx middleware put into the context of the request
+func LoadConsumerFromRequestContext(app *config.App, r *http.Request) (*integrations.Consumer, error) {
+	consumerID, ok := r.Context().Value("consumerID").(string)
+	if !ok {
+		return nil, errors.New("Missing consumer ID")
+	}
+
+	consumer := integrations.Consumer{}
+	if err := app.DB.Where("id = ?", consumerID).First(&consumer).Error; err != nil {
+		return nil, err
+	}
+
+	return &consumer, nil
+}
+
+// Loads the OAuth2 configuration of the integration of the consumer, the latest integration of the connector unless an integration ID is given
+func LoadOAuth2Configuration(app *config.App, consumer *integrations.Consumer, serviceCode string, integrationID *uuid.UUID) (*integrations.ConsumerOauth2Configuration, error) {
+	query := app.DB.Where("consumer_id = ?", consumer.ID)
+	if integrationID != nil {
+		query = query.Where("id = ?", *integrationID)
+	}
+
+	var consumerIntegration integrations.ConsumerIntegration
+	if err := query.Where("service_code = ?", serviceCode).Order("created_at DESC").First(&consumerIntegration).Error; err != nil {
+		return nil, err
+	}
+
+	var oauthConfig integrations.ConsumerOauth2Configuration
+	if err := app.DB.Where("consumer_integration_id = ?", consumerIntegration.ID).First(&oauthConfig).Error; err != nil {
+		return nil, err
+	}
+
+	return &oauthConfig, nil
+}
+
+// Redirects the consumer to the authorization URL of the connector
+func HandleOAuth2Login(app *config.App, registration *Registration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		consumer, err := LoadConsumerFromRequestContext(app, r)
+		if err != nil {
+			errorMessage := fmt.Sprintf("Error finding consumer: %s", err)
+			http.Error(w, errorMessage, http.StatusUnprocessableEntity)
+			return
+		}
+
+		integrationID, err := OAuth2LoginIntegrationID(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+
+		oauthConfig, err := LoadOAuth2Configuration(app, consumer, registration.ServiceCode, integrationID)
+		if err != nil {
+			errorMessage := fmt.Sprintf("Error loading the %s OAuth2 configuration: %s", registration.Name, err)
+			log.Error(errorMessage)
+			http.Error(w, errorMessage, http.StatusUnprocessableEntity)
+			return
+		}
+
+		codeVerifier := ""
+		if registration.OAuth2.PKCE {
+			if codeVerifier, err = NewCodeVerifier(); err != nil {
+				app.Logger.Errorf("Error generating the PKCE code verifier: %s", err)
+				http.Error(w, "Error starting the authorization", http.StatusInternalServerError)
+				return
+			}
+		}
+
+		state, err := NewOAuth2State(app, consumer, oauthConfig, codeVerifier)
+		if err != nil {
+			app.Logger.Errorf("Error creating the %s OAuth2 state: %s", registration.Name, err)
+			http.Error(w, "Error starting the authorization", http.StatusInternalServerError)
+			return
+		}
+
+		url := registration.OAuth2.AuthCodeURL(oauthConfig, state, codeVerifier)
+		http.Redirect(w, r, url, http.StatusTemporaryRedirect)
+	}
+}
+
+// Exchanges the code for the token of the integration selected by the state, stores it and
+// redirects the consumer back to the integrations page of the client app
+func HandleOAuth2Callback(app *config.App, registration *Registration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flow := registration.OAuth2
+		clientIntegrationsPageURL := os.Getenv("CLIENT_APP_INTEGRATIONS_PAGE_URL")
+
+		redirectWithError := func(message string) {
+			redirectUrl := fmt.Sprintf("%s?blendbaseErrorMessage=%s", clientIntegrationsPageURL, url.QueryEscape(message))
+			http.Redirect(w, r, redirectUrl, http.StatusTemporaryRedirect)
+		}
+
+		consumer, err := LoadConsumerFromRequestContext(app, r)
+		if err != nil {
+			app.Logger.Errorf("Error finding consumer: %s", err)
+			redirectWithError("Error finding consumer.")
+			return
+		}
+
+		// the state is checked before the error of the provider, only the authorizations started by the consumer are answered
+		state, err := ConsumeOAuth2State(app, consumer, r.FormValue("state"))
+		if err != nil {
+			app.Logger.Errorf("Error checking the %s OAuth2 state: %s", registration.Name, err)
+			redirectWithError("The authorization expired or is invalid. Please try again.")
+			return
+		}
+
+		if providerError := r.FormValue("error"); providerError != "" {
+			app.Logger.Errorf("%s OAuth2 authorization failed: %s %s", registration.Name, providerError, r.FormValue("error_description"))
+			redirectWithError(fmt.Sprintf("%s authorization failed: %s.", registration.Name, providerError))
+			return
+		}
+
+		// the state selects the integration the consumer authorized
+		integrationID := state.ConsumerIntegrationID
+		oauthConfig, err := LoadOAuth2Configuration(app, consumer, registration.ServiceCode, &integrationID)
+		if err != nil {
+			app.Logger.Errorf("Error loading the %s OAuth2 configuration: %s", registration.Name, err)
+			redirectWithError("Error finding the integration.")
+			return
+		}
+
+		if flow.PrepareCallback != nil {
+			if err := flow.PrepareCallback(r, oauthConfig); err != nil {
+				app.Logger.Errorf("Error reading the %s OAuth2 callback: %s", registration.Name, err)
+				redirectWithError(fmt.Sprintf("Error getting OAuth token from %s: %s.", registration.Name, err))
+				return
+			}
+		}
+
+		exchangeOptions := []oauth2.AuthCodeOption{}
+		if state.CodeVerifier.Raw != "" {
+			exchangeOptions = append(exchangeOptions, CodeVerifierOption(state.CodeVerifier.Raw))
+		}
+
+		token, err := flow.Config(oauthConfig).Exchange(r.Context(), r.FormValue("code"), exchangeOptions...)
+		if err != nil {
+			app.Logger.Errorf("Error getting OAuth token from %s: %s", registration.Name, err)
+			redirectWithError(fmt.Sprintf("Error getting OAuth token from %s.", registration.Name))
+			return
+		}
+
+		if err := flow.saveToken(app.DB, oauthConfig, token); err != nil {
+			app.Logger.Errorf("Error updating %s OAuth2 configuration: %s", registration.ServiceCode, err)
+			redirectWithError("Error updating OAuth2 token. Please try again.")
+			return
+		}
+		app.Logger.Infof("%s OAuth2 configuration updated", registration.ServiceCode)
+
+		// the integration may be connected to another account of the CRM with other fields
+		Descriptions.Invalidate(integrationID)
+
+		redirectUrl := fmt.Sprintf("%s?blendbaseSuccessMessage=%s", clientIntegrationsPageURL, url.QueryEscape(registration.Name+" OAuth2 token was updated"))
+		http.Redirect(w, r, redirectUrl, http.StatusTemporaryRedirect)
+	}
+}
+
+// Authorization URL of the integration of the OAuth2 configuration, the code verifier is empty without PKCE
+func (flow *OAuth2Flow) AuthCodeURL(oauthConfig *integrations.ConsumerOauth2Configuration, state string, codeVerifier string) string {
+	options := append([]oauth2.AuthCodeOption{}, flow.AuthCodeOptions...)
+	if codeVerifier != "" {
+		options = append(options, CodeChallengeOptions(codeVerifier)...)
+	}
+
+	return flow.Config(oauthConfig).AuthCodeURL(state, options...)
+}
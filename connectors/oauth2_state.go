@@ -0,0 +1,126 @@
+package connectors
+
+import (
+	"blendbase/config"
+	"blendbase/integrations"
+	"blendbase/misc/gormext"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+)
+
+// How long the consumer has to authorize the integration once redirected by the login URL
+const OAUTH2_STATE_TTL = 10 * time.Minute
+
+var ErrInvalidOAuth2State = errors.New("invalid oauth state")
+
+// Starts the authorization of the integration of the OAuth2 configuration, the state is signed for the consumer
+// and stored with the PKCE code verifier until the callback consumes it, e.g. "<state ID>.<signature>"
+func NewOAuth2State(app *config.App, consumer *integrations.Consumer, oauthConfig *integrations.ConsumerOauth2Configuration, codeVerifier string) (string, error) {
+	secret, err := oauth2StateSecret()
+	if err != nil {
+		return "", err
+	}
+
+	// the states of the authorizations that were never completed are dropped with the next ones
+	if err := app.DB.Where("expires_at < ?", time.Now()).Delete(&integrations.ConsumerOauth2State{}).Error; err != nil {
+		return "", err
+	}
+
+	state := integrations.ConsumerOauth2State{
+		ConsumerID:            consumer.ID,
+		ConsumerIntegrationID: oauthConfig.ConsumerIntegrationID,
+		CodeVerifier:          gormext.EncryptedValue{Raw: codeVerifier},
+		ExpiresAt:             time.Now().Add(OAUTH2_STATE_TTL),
+	}
+	if err := app.DB.Create(&state).Error; err != nil {
+		return "", err
+	}
+
+	return state.ID.String() + "." + signOAuth2State(secret, state.ID, consumer.ID), nil
+}
+
+// Returns the authorization of the state and deletes it, the state has to be signed for the consumer,
+// unexpired and not consumed by another callback
+func ConsumeOAuth2State(app *config.App, consumer *integrations.Consumer, stateString string) (*integrations.ConsumerOauth2State, error) {
+	secret, err := oauth2StateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.SplitN(stateString, ".", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidOAuth2State
+	}
+
+	stateID, err := uuid.Parse(parts[0])
+	if err != nil || !hmac.Equal([]byte(parts[1]), []byte(signOAuth2State(secret, stateID, consumer.ID))) {
+		return nil, ErrInvalidOAuth2State
+	}
+
+	var state integrations.ConsumerOauth2State
+	if err := app.DB.Where("id = ? AND consumer_id = ?", stateID, consumer.ID).First(&state).Error; err != nil {
+		return nil, ErrInvalidOAuth2State
+	}
+
+	// only the callback deleting the state accepts it, a replayed callback finds nothing to delete
+	result := app.DB.Delete(&integrations.ConsumerOauth2State{}, "id = ?", state.ID)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected != 1 || time.Now().After(state.ExpiresAt) {
+		return nil, ErrInvalidOAuth2State
+	}
+
+	return &state, nil
+}
+
+// Random PKCE code verifier (RFC 7636), 43 characters of the URL safe alphabet
+func NewCodeVerifier() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Parameters of the authorization URL sending the S256 challenge of the code verifier
+func CodeChallengeOptions(codeVerifier string) []oauth2.AuthCodeOption {
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	return []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+}
+
+// Parameter of the token request proving the authorization was started with the code verifier
+func CodeVerifierOption(codeVerifier string) oauth2.AuthCodeOption {
+	return oauth2.SetAuthURLParam("code_verifier", codeVerifier)
+}
+
+// The states are signed with the OAUTH_STATE_SECRET env var
+func oauth2StateSecret() ([]byte, error) {
+	secret := os.Getenv("OAUTH_STATE_SECRET")
+	if secret == "" {
+		return nil, errors.New("missing OAUTH_STATE_SECRET env var")
+	}
+
+	return []byte(secret), nil
+}
+
+func signOAuth2State(secret []byte, stateID uuid.UUID, consumerID uuid.UUID) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(stateID.String() + ":" + consumerID.String()))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,32 @@
+package connectors
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestCodeChallenge(t *testing.T) {
+	// https://datatracker.ietf.org/doc/html/rfc7636#appendix-B
+	config := oauth2.Config{Endpoint: oauth2.Endpoint{AuthURL: "https://login.example.com/authorize"}}
+	authCodeUrl, _ := url.Parse(config.AuthCodeURL("state", CodeChallengeOptions("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")...))
+
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", authCodeUrl.Query().Get("code_challenge"), "expecting the S256 challenge of the verifier")
+	assert.Equal(t, "S256", authCodeUrl.Query().Get("code_challenge_method"), "expecting the S256 method")
+
+	codeVerifier, err := NewCodeVerifier()
+	assert.Nil(t, err)
+	assert.Len(t, codeVerifier, 43, "expecting the verifier to be 43 characters long")
+}
+
+func TestOAuth2StateSignature(t *testing.T) {
+	stateID, consumerID := uuid.New(), uuid.New()
+	signature := signOAuth2State([]byte("secret"), stateID, consumerID)
+
+	assert.Equal(t, signature, signOAuth2State([]byte("secret"), stateID, consumerID), "expecting the signature to be stable")
+	assert.NotEqual(t, signature, signOAuth2State([]byte("secret"), stateID, uuid.New()), "expecting the signature to be bound to the consumer")
+	assert.NotEqual(t, signature, signOAuth2State([]byte("other secret"), stateID, consumerID), "expecting the signature to depend on the secret")
+}
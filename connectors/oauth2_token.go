@@ -0,0 +1,207 @@
+package connectors
+
+import (
+	"blendbase/config"
+	"blendbase/integrations"
+	"blendbase/misc/gormext"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// The tokens expiring within the margin are refreshed before they're used
+	OAUTH2_TOKEN_REFRESH_MARGIN = 5 * time.Minute
+
+	// How often the background refresh looks for the expiring tokens
+	OAUTH2_TOKEN_REFRESH_INTERVAL = time.Minute
+)
+
+// Token stored in the OAuth2 configuration, the expiration is left out so that the clients of the oauth2
+// package never refresh it without storing it
+func (flow *OAuth2Flow) Token(oauthConfig *integrations.ConsumerOauth2Configuration) *oauth2.Token {
+	return flow.withTokenType(&oauth2.Token{
+		AccessToken:  oauthConfig.AccessToken.Raw,
+		RefreshToken: oauthConfig.RefreshToken.Raw,
+		TokenType:    oauthConfig.TokenType,
+	})
+}
+
+// Token of the OAuth2 configuration refreshed first when it expires soon, the stored token is returned
+// when the refresh fails so that the requests still get the error of the CRM
+func (flow *OAuth2Flow) ValidToken(app *config.App, oauthConfig *integrations.ConsumerOauth2Configuration) *oauth2.Token {
+	if !TokenExpiresSoon(oauthConfig) {
+		return flow.Token(oauthConfig)
+	}
+
+	token, err := flow.RefreshToken(app, oauthConfig)
+	if err != nil {
+		log.Errorf("Error refreshing the expiring token of %s: %s", oauthConfig.ConsumerIntegrationID, err)
+		return flow.Token(oauthConfig)
+	}
+
+	return token
+}
+
+// HTTP client sending the valid token of the OAuth2 configuration, the token is refreshed by RefreshToken
+func (flow *OAuth2Flow) Client(ctx context.Context, app *config.App, oauthConfig *integrations.ConsumerOauth2Configuration) *http.Client {
+	return flow.Config(oauthConfig).Client(ctx, flow.ValidToken(app, oauthConfig))
+}
+
+// Refreshes the token of the OAuth2 configuration and stores it, the refresh token is kept when the CRM doesn't
+// issue a new one. The configuration is locked while the token is refreshed, when another request or server refreshed
+// it in the meantime its token is returned instead of refreshing it again.
+func (flow *OAuth2Flow) RefreshToken(app *config.App, oauthConfig *integrations.ConsumerOauth2Configuration) (*oauth2.Token, error) {
+	var newToken *oauth2.Token
+
+	err := app.DB.Transaction(func(tx *gorm.DB) error {
+		var lockedConfig integrations.ConsumerOauth2Configuration
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", oauthConfig.ID).First(&lockedConfig).Error; err != nil {
+			return err
+		}
+
+		if lockedConfig.AccessToken.Raw != oauthConfig.AccessToken.Raw {
+			copyToken(oauthConfig, &lockedConfig)
+			newToken = flow.Token(oauthConfig)
+			return nil
+		}
+
+		// omitting AccessToken to force a refresh
+		expiredToken := flow.Token(&lockedConfig)
+		expiredToken.AccessToken = ""
+
+		token, err := flow.Config(&lockedConfig).TokenSource(context.TODO(), expiredToken).Token()
+		if err != nil {
+			return fmt.Errorf("failed to refresh token: %s", err)
+		}
+
+		if err := flow.saveToken(tx, &lockedConfig, token); err != nil {
+			return err
+		}
+
+		copyToken(oauthConfig, &lockedConfig)
+		newToken = flow.withTokenType(token)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newToken, nil
+}
+
+// Whether the token of the OAuth2 configuration expires within the refresh margin, the tokens without expiration
+// are only refreshed when the CRM rejects them
+func TokenExpiresSoon(oauthConfig *integrations.ConsumerOauth2Configuration) bool {
+	return oauthConfig.ExpiresAt != nil && time.Now().Add(OAUTH2_TOKEN_REFRESH_MARGIN).After(*oauthConfig.ExpiresAt)
+}
+
+// Refreshes the expiring tokens of the enabled integrations until the context is done,
+// the requests then rarely wait for a refresh
+func RefreshExpiringTokens(ctx context.Context, app *config.App) {
+	ticker := time.NewTicker(OAUTH2_TOKEN_REFRESH_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		refreshExpiringTokens(app)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshExpiringTokens(app *config.App) {
+	var oauthConfigs []integrations.ConsumerOauth2Configuration
+	err := app.DB.Joins("ConsumerIntegration").
+		Where(`"ConsumerIntegration".enabled = ?`, true).
+		Where("consumer_oauth2_configurations.expires_at < ?", time.Now().Add(OAUTH2_TOKEN_REFRESH_MARGIN)).
+		Find(&oauthConfigs).Error
+	if err != nil {
+		log.Errorf("Error listing the expiring tokens: %s", err)
+		return
+	}
+
+	for i := range oauthConfigs {
+		oauthConfig := &oauthConfigs[i]
+
+		registration := Lookup(oauthConfig.ConsumerIntegration.ServiceCode)
+		if registration == nil || registration.OAuth2 == nil || oauthConfig.RefreshToken.Raw == "" {
+			continue
+		}
+
+		if _, err := registration.OAuth2.RefreshToken(app, oauthConfig); err != nil {
+			log.Errorf("Error refreshing the token of %s: %s", oauthConfig.ConsumerIntegrationID, err)
+		}
+	}
+}
+
+// Stores the token in the OAuth2 configuration along with the custom settings of the OnToken hook, the
+// expiration defaults to the lifetime of the tokens of the flow when the CRM doesn't return it
+func (flow *OAuth2Flow) saveToken(db *gorm.DB, oauthConfig *integrations.ConsumerOauth2Configuration, token *oauth2.Token) error {
+	if flow.OnToken != nil {
+		if err := flow.OnToken(token, oauthConfig); err != nil {
+			return err
+		}
+	}
+
+	oauthConfig.TokenType = token.TokenType
+	oauthConfig.AccessToken = gormext.EncryptedValue{Raw: token.AccessToken}
+	oauthConfig.RefreshToken = gormext.EncryptedValue{Raw: token.RefreshToken}
+	oauthConfig.ExpiresAt = nil
+	if !token.Expiry.IsZero() {
+		oauthConfig.ExpiresAt = &token.Expiry
+	} else if flow.TokenLifetime > 0 {
+		expiresAt := time.Now().Add(flow.TokenLifetime)
+		oauthConfig.ExpiresAt = &expiresAt
+	}
+
+	return db.Model(oauthConfig).
+		Select("TokenType", "AccessToken", "RefreshToken", "ExpiresAt", "CustomSettings").
+		Updates(oauthConfig).Error
+}
+
+func (flow *OAuth2Flow) withTokenType(token *oauth2.Token) *oauth2.Token {
+	if flow.TokenType != "" {
+		token.TokenType = flow.TokenType
+	}
+
+	return token
+}
+
+func copyToken(oauthConfig *integrations.ConsumerOauth2Configuration, source *integrations.ConsumerOauth2Configuration) {
+	oauthConfig.TokenType = source.TokenType
+	oauthConfig.AccessToken = source.AccessToken
+	oauthConfig.RefreshToken = source.RefreshToken
+	oauthConfig.ExpiresAt = source.ExpiresAt
+	oauthConfig.CustomSettings = source.CustomSettings
+}
+
+// Reads the body of the request again before it's retried with the refreshed token, the body
+// of the first attempt is consumed
+func RewindRequestBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	if req.GetBody == nil {
+		return errors.New("the body of the request can't be sent again")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+
+	return nil
+}
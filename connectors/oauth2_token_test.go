@@ -0,0 +1,39 @@
+package connectors
+
+import (
+	"blendbase/integrations"
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenExpiresSoon(t *testing.T) {
+	oauthConfig := integrations.ConsumerOauth2Configuration{}
+	assert.False(t, TokenExpiresSoon(&oauthConfig), "expecting the tokens without expiration to be refreshed when they're rejected")
+
+	expiresAt := time.Now().Add(OAUTH2_TOKEN_REFRESH_MARGIN / 2)
+	oauthConfig.ExpiresAt = &expiresAt
+	assert.True(t, TokenExpiresSoon(&oauthConfig), "expecting a token expiring within the margin to be refreshed")
+
+	expiresAt = time.Now().Add(time.Hour)
+	assert.False(t, TokenExpiresSoon(&oauthConfig), "expecting a valid token to be kept")
+}
+
+func TestRewindRequestBody(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://api.example.com/contacts", bytes.NewBufferString(`{"name":"Ada"}`))
+	io.ReadAll(req.Body)
+
+	assert.Nil(t, RewindRequestBody(req), "expecting the body to be read again")
+	body, _ := io.ReadAll(req.Body)
+	assert.Equal(t, `{"name":"Ada"}`, string(body), "expecting the body of the first attempt")
+
+	req, _ = http.NewRequest("POST", "https://api.example.com/contacts", io.MultiReader(bytes.NewBufferString("{}")))
+	assert.NotNil(t, RewindRequestBody(req), "expecting an error for a body that can't be read again")
+
+	req, _ = http.NewRequest("GET", "https://api.example.com/contacts", nil)
+	assert.Nil(t, RewindRequestBody(req), "expecting no error without a body")
+}
@@ -0,0 +1,202 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Tasks, calls, meetings and emails are all activities in Pipedrive, the type of the activity tells them apart.
+// The due date and time of an activity are in UTC, it's the start time of calls, meetings and emails.
+// https://developers.pipedrive.com/docs/api/v1/Activities
+const (
+	PD_ACTIVITY_TYPE_TASK = "task"
+
+	PD_DUE_TIME_FORMAT = "15:04"
+)
+
+var pdActivityTypes = map[model.ActivityType]string{
+	model.ActivityTypeCall:    "call",
+	model.ActivityTypeMeeting: "meeting",
+	model.ActivityTypeEmail:   "email",
+}
+
+type PDActivity struct {
+	ID         int          `json:"id"`
+	Type       string       `json:"type"`
+	Subject    string       `json:"subject"`
+	Note       *string      `json:"note"` // can contain HTML
+	Done       bool         `json:"done"`
+	DueDate    *string      `json:"due_date"`
+	DueTime    *string      `json:"due_time"`
+	Duration   *string      `json:"duration"` // HH:MM
+	PersonID   *PDReference `json:"person_id"`
+	DealID     *PDReference `json:"deal_id"`
+	AddTime    *string      `json:"add_time"`
+	UpdateTime *string      `json:"update_time"`
+}
+
+type PDActivityCreateUpdatePayload struct {
+	Type     string  `json:"type,omitempty"`
+	Subject  string  `json:"subject,omitempty"`
+	Note     *string `json:"note,omitempty"`
+	Done     *int    `json:"done,omitempty"` // 0 or 1
+	DueDate  *string `json:"due_date,omitempty"`
+	DueTime  *string `json:"due_time,omitempty"`
+	Duration *string `json:"duration,omitempty"`
+	PersonID *int    `json:"person_id,omitempty"`
+	DealID   *int    `json:"deal_id,omitempty"`
+}
+
+func (client *Client) ListContactActivities(ctx context.Context, contactId string) ([]*model.Activity, error) {
+	return client.listActivities(ctx, "persons", contactId)
+}
+
+func (client *Client) CreateContactActivity(ctx context.Context, contactId string, input *model.ActivityInput) (*model.Activity, error) {
+	personId, err := parsePDID(contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := createPDActivityPayload(input)
+	payload.PersonID = &personId
+
+	return client.createActivity(ctx, payload)
+}
+
+func (client *Client) ListOpportunityActivities(ctx context.Context, opportunityId string) ([]*model.Activity, error) {
+	return client.listActivities(ctx, "deals", opportunityId)
+}
+
+func (client *Client) CreateOpportunityActivity(ctx context.Context, opportunityId string, input *model.ActivityInput) (*model.Activity, error) {
+	dealId, err := parsePDID(opportunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := createPDActivityPayload(input)
+	payload.DealID = &dealId
+
+	return client.createActivity(ctx, payload)
+}
+
+func (client *Client) listActivities(ctx context.Context, objectPath string, objectId string) ([]*model.Activity, error) {
+	pdActivities, err := client.listObjectActivities(ctx, objectPath, objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := []*model.Activity{}
+	for _, pdActivity := range pdActivities {
+		if activity := pdActivity.mapActivityProperties(); activity != nil {
+			activities = append(activities, activity)
+		}
+	}
+
+	connectors.SortActivities(activities)
+
+	return activities, nil
+}
+
+func (client *Client) createActivity(ctx context.Context, payload *PDActivityCreateUpdatePayload) (*model.Activity, error) {
+	pdActivity := PDActivity{}
+	if err := client.create(ctx, "activities", payload, &pdActivity); err != nil {
+		return nil, err
+	}
+
+	return pdActivity.mapActivityProperties(), nil
+}
+
+// Lists the activities of all the types related to the object
+func (client *Client) listObjectActivities(ctx context.Context, objectPath string, objectId string) ([]PDActivity, error) {
+	pdActivities := []PDActivity{}
+	if err := client.listAll(ctx, fmt.Sprintf("%s/%s/activities", objectPath, objectId), nil, &pdActivities); err != nil {
+		return nil, err
+	}
+
+	return pdActivities, nil
+}
+
+// Logged activities are done, they start now unless the start time is given
+func createPDActivityPayload(input *model.ActivityInput) *PDActivityCreateUpdatePayload {
+	done := 1
+	payload := PDActivityCreateUpdatePayload{
+		Type: pdActivityTypes[input.Type],
+		Note: input.Description,
+		Done: &done,
+	}
+
+	if input.Subject != nil {
+		payload.Subject = *input.Subject
+	}
+
+	startTime := time.Now().UTC()
+	if input.StartTime != nil {
+		startTime = input.StartTime.UTC()
+	}
+
+	dueDate := startTime.Format(PD_DATE_FORMAT)
+	dueTime := startTime.Format(PD_DUE_TIME_FORMAT)
+	payload.DueDate = &dueDate
+	payload.DueTime = &dueTime
+
+	if input.EndTime != nil && input.EndTime.After(startTime) {
+		minutes := int(input.EndTime.Sub(startTime).Minutes())
+		duration := fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+		payload.Duration = &duration
+	}
+
+	return &payload
+}
+
+// Returns nil for the activities of the other types, e.g. tasks
+func (pdActivity PDActivity) mapActivityProperties() *model.Activity {
+	var activityType *model.ActivityType
+	for unifiedType, pdType := range pdActivityTypes {
+		if pdType == pdActivity.Type {
+			unifiedType := unifiedType
+			activityType = &unifiedType
+		}
+	}
+
+	if activityType == nil {
+		return nil
+	}
+
+	activity := model.Activity{
+		ID:          formatPDID(pdActivity.ID),
+		Type:        *activityType,
+		Subject:     &pdActivity.Subject,
+		Description: pdActivity.Note,
+		StartTime:   pdActivity.dueTime(),
+		CreatedAt:   parsePDDateTime(pdActivity.AddTime),
+		UpdatedAt:   parsePDDateTime(pdActivity.UpdateTime),
+	}
+
+	if pdActivity.Duration != nil && activity.StartTime != nil {
+		if duration, err := time.Parse(PD_DUE_TIME_FORMAT, *pdActivity.Duration); err == nil {
+			endTime := activity.StartTime.Add(time.Duration(duration.Hour())*time.Hour + time.Duration(duration.Minute())*time.Minute)
+			activity.EndTime = &endTime
+		}
+	}
+
+	return &activity
+}
+
+// Due date and time of the activity, activities without the due time are due at the start of the day
+func (pdActivity PDActivity) dueTime() *time.Time {
+	dueDate := parsePDDate(pdActivity.DueDate)
+	if dueDate == nil || pdActivity.DueTime == nil || *pdActivity.DueTime == "" {
+		return dueDate
+	}
+
+	dueTime, err := time.Parse(PD_DUE_TIME_FORMAT, *pdActivity.DueTime)
+	if err != nil {
+		return dueDate
+	}
+
+	due := dueDate.Add(time.Duration(dueTime.Hour())*time.Hour + time.Duration(dueTime.Minute())*time.Minute)
+	return &due
+}
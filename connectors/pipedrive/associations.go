@@ -0,0 +1,98 @@
+package pipedrive
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+)
+
+// Persons and deals belong to a single organization, linking sets the organization of the object
+// and unlinking clears it when the object is linked to the given organization.
+
+func (client *Client) LinkContactToCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	return client.setOrganization(ctx, "persons", contactId, companyId)
+}
+
+func (client *Client) UnlinkContactFromCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	pdPerson := PDPerson{}
+	if err := client.get(ctx, "persons", contactId, &pdPerson); err != nil {
+		return false, err
+	}
+
+	return client.clearOrganization(ctx, "persons", contactId, pdPerson.OrgID, companyId)
+}
+
+func (client *Client) LinkOpportunityToCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	return client.setOrganization(ctx, "deals", opportunityId, companyId)
+}
+
+func (client *Client) UnlinkOpportunityFromCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	pdDeal := PDDeal{}
+	if err := client.get(ctx, "deals", opportunityId, &pdDeal); err != nil {
+		return false, err
+	}
+
+	return client.clearOrganization(ctx, "deals", opportunityId, pdDeal.OrgID, companyId)
+}
+
+func (client *Client) ListCompanyContacts(ctx context.Context, companyId string) ([]*model.Contact, error) {
+	return client.listRelatedContacts(ctx, "organizations", companyId)
+}
+
+func (client *Client) ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error) {
+	return client.listRelatedContacts(ctx, "deals", opportunityId)
+}
+
+func (client *Client) ListCompanyOpportunities(ctx context.Context, companyId string) ([]*model.Opportunity, error) {
+	pdDeals := []PDDeal{}
+	if err := client.listAll(ctx, fmt.Sprintf("organizations/%s/deals", companyId), nil, &pdDeals); err != nil {
+		return nil, err
+	}
+
+	opportunities := make([]*model.Opportunity, len(pdDeals))
+	for i := range pdDeals {
+		opportunities[i] = client.mapOpportunity(&pdDeals[i])
+	}
+
+	return opportunities, nil
+}
+
+func (client *Client) listRelatedContacts(ctx context.Context, objectPath string, objectId string) ([]*model.Contact, error) {
+	pdPersons := []PDPerson{}
+	if err := client.listAll(ctx, fmt.Sprintf("%s/%s/persons", objectPath, objectId), nil, &pdPersons); err != nil {
+		return nil, err
+	}
+
+	contacts := make([]*model.Contact, len(pdPersons))
+	for i := range pdPersons {
+		contacts[i] = client.mapContact(&pdPersons[i])
+	}
+
+	return contacts, nil
+}
+
+func (client *Client) setOrganization(ctx context.Context, objectPath string, objectId string, companyId string) (bool, error) {
+	orgId, err := parsePDID(companyId)
+	if err != nil {
+		return false, err
+	}
+
+	if err := client.update(ctx, objectPath, objectId, map[string]interface{}{"org_id": orgId}, nil); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) clearOrganization(ctx context.Context, objectPath string, objectId string, orgId *PDReference, companyId string) (bool, error) {
+	if id := orgId.id(); id == nil || *id != companyId {
+		// the object isn't linked to the organization
+		return true, nil
+	}
+
+	if err := client.update(ctx, objectPath, objectId, map[string]interface{}{"org_id": nil}, nil); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,146 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// Change of an object, the data is the object as it's returned by its own endpoints
+// https://developers.pipedrive.com/docs/api/v1/Recents
+type PDRecent struct {
+	Item string          `json:"item"`
+	ID   int             `json:"id"`
+	Data json.RawMessage `json:"data"`
+}
+
+type pdChangeSource struct {
+	objectType model.ChangeObjectType
+	item       string
+	mapChange  func(params *connectors.ChangesParams, data json.RawMessage) (*model.Change, error)
+}
+
+// Changes are read from the recents, deleted objects are in the recents too, they're marked inactive.
+// Recents are paginated by an offset without a defined order, so all the changes since the bound
+// are read for every page of the feed.
+func (client *Client) ListChanges(ctx context.Context, params *connectors.ChangesParams) (*model.ChangeConnection, error) {
+	sources := []pdChangeSource{
+		{model.ChangeObjectTypeContact, "person", client.mapContactChange},
+		{model.ChangeObjectTypeNote, "note", mapNoteChange},
+		{model.ChangeObjectTypeOpportunity, "deal", client.mapOpportunityChange},
+	}
+
+	batches := []*connectors.ChangeBatch{}
+	for _, source := range sources {
+		if !params.Includes(source.objectType) {
+			continue
+		}
+
+		batch, err := client.listRecentChanges(ctx, source, params)
+		if err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return connectors.NewChangeConnection(params, batches), nil
+}
+
+func (client *Client) listRecentChanges(ctx context.Context, source pdChangeSource, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	// the timestamp has a second precision, the changes before the bound are dropped when the batches are merged
+	since := params.Bound(source.objectType).Time.Add(-time.Second)
+
+	query := url.Values{}
+	query.Set("since_timestamp", formatPDDateTime(since))
+	query.Set("items", source.item)
+
+	pdRecents := []PDRecent{}
+	if err := client.listAll(ctx, "recents", query, &pdRecents); err != nil {
+		return nil, err
+	}
+
+	changes := []*model.Change{}
+	for _, pdRecent := range pdRecents {
+		if pdRecent.Item != source.item || len(pdRecent.Data) == 0 || string(pdRecent.Data) == "null" {
+			continue
+		}
+
+		change, err := source.mapChange(params, pdRecent.Data)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return &connectors.ChangeBatch{Changes: changes}, nil
+}
+
+func (client *Client) mapContactChange(params *connectors.ChangesParams, data json.RawMessage) (*model.Change, error) {
+	pdPerson := PDPerson{}
+	if err := json.Unmarshal(data, &pdPerson); err != nil {
+		return nil, err
+	}
+
+	contact := client.mapContact(&pdPerson)
+	if !pdPerson.ActiveFlag {
+		return pdDeletedChange(model.ChangeObjectTypeContact, contact.ID, contact.UpdatedAt), nil
+	}
+
+	change := params.NewChange(model.ChangeObjectTypeContact, contact.ID, contact.CreatedAt, contact.UpdatedAt)
+	change.Contact = contact
+
+	return change, nil
+}
+
+func mapNoteChange(params *connectors.ChangesParams, data json.RawMessage) (*model.Change, error) {
+	pdNote := PDNote{}
+	if err := json.Unmarshal(data, &pdNote); err != nil {
+		return nil, err
+	}
+
+	note := pdNote.mapNoteProperties()
+	if !pdNote.ActiveFlag {
+		return pdDeletedChange(model.ChangeObjectTypeNote, note.ID, note.UpdatedAt), nil
+	}
+
+	change := params.NewChange(model.ChangeObjectTypeNote, note.ID, note.CreatedAt, note.UpdatedAt)
+	change.Note = note
+
+	return change, nil
+}
+
+func (client *Client) mapOpportunityChange(params *connectors.ChangesParams, data json.RawMessage) (*model.Change, error) {
+	pdDeal := PDDeal{}
+	if err := json.Unmarshal(data, &pdDeal); err != nil {
+		return nil, err
+	}
+
+	opportunity := client.mapOpportunity(&pdDeal)
+	if pdDeal.isDeleted() {
+		return pdDeletedChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.UpdatedAt), nil
+	}
+
+	change := params.NewChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.CreatedAt, opportunity.UpdatedAt)
+	change.Opportunity = opportunity
+
+	return change, nil
+}
+
+// Deleted objects keep their data, the deletion is their last update
+func pdDeletedChange(objectType model.ChangeObjectType, objectId string, updatedAt *time.Time) *model.Change {
+	change := model.Change{
+		ObjectType: objectType,
+		ObjectID:   objectId,
+		ChangeType: model.ChangeTypeDeleted,
+	}
+
+	if updatedAt != nil {
+		change.ChangedAt = *updatedAt
+	}
+
+	return &change
+}
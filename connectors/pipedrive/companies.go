@@ -0,0 +1,135 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strings"
+)
+
+// Companies are organizations in Pipedrive. Organizations only have the name and the address,
+// the other company fields are custom fields in Pipedrive, so they aren't mapped.
+// https://developers.pipedrive.com/docs/api/v1/Organizations
+type PDOrganization struct {
+	ID              int          `json:"id"`
+	Name            string       `json:"name"`
+	Address         string       `json:"address"`
+	AddressLocality string       `json:"address_locality"` // city, derived from the address by Pipedrive
+	AddressCountry  string       `json:"address_country"`
+	OwnerID         *PDReference `json:"owner_id"`
+	AddTime         *string      `json:"add_time"`
+	UpdateTime      *string      `json:"update_time"`
+	ActiveFlag      bool         `json:"active_flag"`
+}
+
+type PDOrganizationCreateUpdatePayload struct {
+	Name    string  `json:"name"`
+	Address *string `json:"address,omitempty"`
+	OwnerID *int    `json:"owner_id,omitempty"`
+}
+
+func (client *Client) ListCompanies(ctx context.Context, params *connectors.ListParams) (*model.CompanyConnection, error) {
+	pdOrganizations := []PDOrganization{}
+	offset, err := client.listOrSearch(ctx, "organizations", params, pdOrganizationFilterParams, pdOrganizationSortFields, &pdOrganizations)
+	if err != nil {
+		return nil, err
+	}
+
+	companyEdges := make([]*model.CompanyEdge, len(pdOrganizations))
+	for i, pdOrganization := range pdOrganizations {
+		companyEdges[i] = &model.CompanyEdge{
+			Node:   pdOrganization.mapCompanyProperties(),
+			Cursor: pdCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &companyEdges)
+
+	return &model.CompanyConnection{
+		Edges:    recordsValue.Interface().([]*model.CompanyEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetCompany(ctx context.Context, companyId string) (*model.Company, error) {
+	pdOrganization := PDOrganization{}
+	if err := client.get(ctx, "organizations", companyId, &pdOrganization); err != nil {
+		return nil, err
+	}
+
+	return pdOrganization.mapCompanyProperties(), nil
+}
+
+func (client *Client) CreateCompany(ctx context.Context, input *model.CompanyInput) (*model.Company, error) {
+	payload, err := createPDOrganizationPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pdOrganization := PDOrganization{}
+	if err := client.create(ctx, "organizations", payload, &pdOrganization); err != nil {
+		return nil, err
+	}
+
+	return pdOrganization.mapCompanyProperties(), nil
+}
+
+func (client *Client) UpdateCompany(ctx context.Context, companyId string, input *model.CompanyInput) (bool, error) {
+	payload, err := createPDOrganizationPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := client.update(ctx, "organizations", companyId, payload, &PDOrganization{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteCompany(ctx context.Context, companyId string) (bool, error) {
+	if err := client.delete(ctx, "organizations", companyId); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Creates Pipedrive Organization Update/Create payload from GraphQL input.
+// The city and the country are written to the address, Pipedrive splits it into the address components.
+func createPDOrganizationPayload(input *model.CompanyInput) (*PDOrganizationCreateUpdatePayload, error) {
+	payload := PDOrganizationCreateUpdatePayload{Name: input.Name}
+
+	addressParts := []string{}
+	for _, part := range []*string{input.City, input.Country} {
+		if part != nil && *part != "" {
+			addressParts = append(addressParts, *part)
+		}
+	}
+	if len(addressParts) > 0 {
+		address := strings.Join(addressParts, ", ")
+		payload.Address = &address
+	}
+
+	var err error
+	if payload.OwnerID, err = parseOptionalPDID(input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+func (pdOrganization PDOrganization) mapCompanyProperties() *model.Company {
+	archived := !pdOrganization.ActiveFlag
+
+	return &model.Company{
+		ID:        formatPDID(pdOrganization.ID),
+		Name:      pdOrganization.Name,
+		City:      &pdOrganization.AddressLocality,
+		Country:   &pdOrganization.AddressCountry,
+		Owner:     pdOwnerReference(pdOrganization.OwnerID),
+		CreatedAt: parsePDDateTime(pdOrganization.AddTime),
+		UpdatedAt: parsePDDateTime(pdOrganization.UpdateTime),
+		Archived:  &archived,
+	}
+}
@@ -0,0 +1,227 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strings"
+)
+
+// Contacts are persons in Pipedrive
+// https://developers.pipedrive.com/docs/api/v1/Persons
+type PDPerson struct {
+	ID         int               `json:"id"`
+	Name       string            `json:"name"`
+	FirstName  string            `json:"first_name"`
+	LastName   string            `json:"last_name"`
+	Email      []PDContactDetail `json:"email"`
+	Phone      []PDContactDetail `json:"phone"`
+	OrgID      *PDReference      `json:"org_id"`
+	OrgName    string            `json:"org_name"`
+	OwnerID    *PDReference      `json:"owner_id"`
+	AddTime    *string           `json:"add_time"`
+	UpdateTime *string           `json:"update_time"`
+	ActiveFlag bool              `json:"active_flag"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields, they're keyed by hashes
+}
+
+func (pdPerson *PDPerson) UnmarshalJSON(data []byte) error {
+	type plainPDPerson PDPerson
+	return connectors.UnmarshalWithRawFields(data, (*plainPDPerson)(pdPerson), &pdPerson.RawFields)
+}
+
+// Persons have lists of emails and phones, one of them is the primary one
+type PDContactDetail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+	Label   string `json:"label,omitempty"`
+}
+
+// Persons only have the full name, the first and last names are derived from it
+type PDPersonCreateUpdatePayload struct {
+	Name    string            `json:"name,omitempty"`
+	Email   []PDContactDetail `json:"email,omitempty"`
+	Phone   []PDContactDetail `json:"phone,omitempty"`
+	OrgID   *int              `json:"org_id,omitempty"`
+	OwnerID *int              `json:"owner_id,omitempty"`
+}
+
+func (client *Client) ListContacts(ctx context.Context, params *connectors.ListParams) (*model.ContactConnection, error) {
+	pdPersons := []PDPerson{}
+	offset, err := client.listOrSearch(ctx, "persons", params, pdPersonFilterParams, pdPersonSortFields, &pdPersons)
+	if err != nil {
+		return nil, err
+	}
+
+	contactEdges := make([]*model.ContactEdge, len(pdPersons))
+	for i := range pdPersons {
+		contactEdges[i] = &model.ContactEdge{
+			Node:   client.mapContact(&pdPersons[i]),
+			Cursor: pdCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &contactEdges)
+
+	return &model.ContactConnection{
+		Edges:    recordsValue.Interface().([]*model.ContactEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetContact(ctx context.Context, contactId string) (*model.Contact, error) {
+	pdPerson := PDPerson{}
+	if err := client.get(ctx, "persons", contactId, &pdPerson); err != nil {
+		return nil, err
+	}
+
+	return client.mapContact(&pdPerson), nil
+}
+
+func (client *Client) CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error) {
+	payload, err := createPDPersonPayload(input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	customPayload, err := connectors.MergePayload(payload, client.FieldMapping.CrmFields(model.CrmObjectContact, input.CustomFields))
+	if err != nil {
+		return nil, err
+	}
+
+	pdPerson := PDPerson{}
+	if err := client.create(ctx, "persons", customPayload, &pdPerson); err != nil {
+		return nil, err
+	}
+
+	return client.mapContact(&pdPerson), nil
+}
+
+func (client *Client) UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error) {
+	var pdPerson *PDPerson
+
+	// the name is updated as a whole, so the current name is needed when only one part of it changes
+	if (input.FirstName == nil) != (input.LastName == nil) {
+		pdPerson = &PDPerson{}
+		if err := client.get(ctx, "persons", contactId, pdPerson); err != nil {
+			return false, err
+		}
+	}
+
+	payload, err := createPDPersonPayload(input, pdPerson)
+	if err != nil {
+		return false, err
+	}
+
+	customPayload, err := connectors.MergePayload(payload, client.FieldMapping.CrmFields(model.CrmObjectContact, input.CustomFields))
+	if err != nil {
+		return false, err
+	}
+
+	if err := client.update(ctx, "persons", contactId, customPayload, &PDPerson{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteContact(ctx context.Context, contactId string) (bool, error) {
+	if err := client.delete(ctx, "persons", contactId); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Creates Pipedrive Person Update/Create payload from GraphQL input, the current person is used to complete the name.
+// Persons have no company name of their own, it's the name of the organization they're linked to.
+func createPDPersonPayload(input *model.ContactInput, current *PDPerson) (*PDPersonCreateUpdatePayload, error) {
+	payload := PDPersonCreateUpdatePayload{}
+
+	if input.FirstName != nil || input.LastName != nil {
+		firstName, lastName := "", ""
+		if current != nil {
+			firstName, lastName = current.FirstName, current.LastName
+		}
+		if input.FirstName != nil {
+			firstName = *input.FirstName
+		}
+		if input.LastName != nil {
+			lastName = *input.LastName
+		}
+
+		payload.Name = strings.TrimSpace(firstName + " " + lastName)
+	}
+
+	if input.Email != nil {
+		payload.Email = []PDContactDetail{{Value: *input.Email, Primary: true, Label: "work"}}
+	}
+
+	if input.Phone != nil {
+		payload.Phone = []PDContactDetail{{Value: *input.Phone, Primary: true, Label: "work"}}
+	}
+
+	var err error
+	if payload.OrgID, err = parseOptionalPDID(input.CompanyID); err != nil {
+		return nil, err
+	}
+
+	if payload.OwnerID, err = parseOptionalPDID(input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+// Maps the contact with its custom fields
+func (client *Client) mapContact(pdPerson *PDPerson) *model.Contact {
+	contact := pdPerson.mapContactProperties()
+	contact.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectContact, pdPerson.RawFields)
+
+	return contact
+}
+
+func (pdPerson PDPerson) mapContactProperties() *model.Contact {
+	archived := !pdPerson.ActiveFlag
+
+	contact := model.Contact{
+		ID:          formatPDID(pdPerson.ID),
+		Name:        &pdPerson.Name,
+		FirstName:   &pdPerson.FirstName,
+		LastName:    &pdPerson.LastName,
+		Email:       primaryContactDetail(pdPerson.Email),
+		Phone:       primaryContactDetail(pdPerson.Phone),
+		CompanyName: &pdPerson.OrgName,
+		CreatedAt:   parsePDDateTime(pdPerson.AddTime),
+		UpdatedAt:   parsePDDateTime(pdPerson.UpdateTime),
+		Archived:    &archived,
+		Owner:       pdOwnerReference(pdPerson.OwnerID),
+	}
+
+	if orgId := pdPerson.OrgID.id(); orgId != nil {
+		contact.Company = &model.Company{ID: *orgId}
+		if pdPerson.OrgName == "" {
+			contact.CompanyName = &pdPerson.OrgID.Name
+		}
+	}
+
+	return &contact
+}
+
+// Returns the primary value, or the first one when none of them is primary
+func primaryContactDetail(details []PDContactDetail) *string {
+	for _, detail := range details {
+		if detail.Primary && detail.Value != "" {
+			return &detail.Value
+		}
+	}
+
+	for _, detail := range details {
+		if detail.Value != "" {
+			return &detail.Value
+		}
+	}
+
+	return nil
+}
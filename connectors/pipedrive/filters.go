@@ -0,0 +1,203 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// Query parameters of the list endpoints for the unified filter fields.
+// The list endpoints only filter by a few fields and only by equality.
+var pdPersonFilterParams = map[string]string{
+	"ownerId": "user_id",
+}
+
+var pdDealFilterParams = map[string]string{
+	"ownerId":   "user_id",
+	"stageName": "stage_id",
+}
+
+var pdOrganizationFilterParams = map[string]string{
+	"ownerId": "user_id",
+}
+
+// Pipedrive fields of the unified sort fields
+var pdPersonSortFields = map[string]string{
+	"firstName": "first_name",
+	"lastName":  "last_name",
+	"ownerId":   "owner_id",
+	"createdAt": "add_time",
+	"updatedAt": "update_time",
+}
+
+var pdDealSortFields = map[string]string{
+	"name":       "title",
+	"stageName":  "stage_id",
+	"pipelineId": "pipeline_id",
+	"ownerId":    "user_id",
+	"amount":     "value",
+	"closeDate":  "expected_close_date",
+	"createdAt":  "add_time",
+	"updatedAt":  "update_time",
+}
+
+var pdOrganizationSortFields = map[string]string{
+	"name":      "name",
+	"ownerId":   "owner_id",
+	"createdAt": "add_time",
+	"updatedAt": "update_time",
+}
+
+var pdLeadSortFields = map[string]string{
+	"name":      "title",
+	"createdAt": "add_time",
+	"updatedAt": "update_time",
+}
+
+// https://developers.pipedrive.com/docs/api/v1/ItemSearch
+type PDSearchSuccessResponse struct {
+	Items []struct {
+		ResultScore float64 `json:"result_score"`
+		Item        struct {
+			ID int `json:"id"`
+		} `json:"item"`
+	} `json:"items"`
+}
+
+// Lists objects with the list endpoint or, when there's a query, with the search endpoint.
+// Both of them paginate with an offset, returns the offset of the first record.
+// Pipedrive doesn't count the records, so the total count is never set.
+func (client *Client) listOrSearch(ctx context.Context, objectPath string, params *connectors.ListParams, filterParams map[string]string, sortFields map[string]string, items interface{}) (int, error) {
+	if params.Query != nil && *params.Query != "" {
+		if params.Filter != nil || len(params.OrderBy) > 0 {
+			return 0, errors.New("filter and orderBy can't be combined with query")
+		}
+
+		return client.search(ctx, objectPath, params, *params.Query, items)
+	}
+
+	query, err := pdListQuery(params, filterParams, sortFields)
+	if err != nil {
+		return 0, err
+	}
+
+	return client.listPage(ctx, objectPath, params, query, items)
+}
+
+// Search results only have a few fields of the objects, the objects are read one by one
+func (client *Client) search(ctx context.Context, objectPath string, params *connectors.ListParams, term string, items interface{}) (int, error) {
+	query := url.Values{}
+	query.Set("term", term)
+
+	response := PDSearchSuccessResponse{}
+	offset, err := client.listPage(ctx, objectPath+"/search", params, query, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	itemsValue := reflect.ValueOf(items).Elem()
+	for _, result := range response.Items {
+		item := reflect.New(itemsValue.Type().Elem())
+		if err := client.get(ctx, objectPath, fmt.Sprint(result.Item.ID), item.Interface()); err != nil {
+			return 0, err
+		}
+
+		itemsValue.Set(reflect.Append(itemsValue, item.Elem()))
+	}
+
+	return offset, nil
+}
+
+// Query parameters of the list endpoint for the filter and the sorting
+func pdListQuery(params *connectors.ListParams, filterParams map[string]string, sortFields map[string]string) (url.Values, error) {
+	query := url.Values{}
+
+	if params.Filter != nil {
+		if err := addPDFilterParams(query, params.Filter, filterParams); err != nil {
+			return nil, err
+		}
+	}
+
+	sort, err := pdSort(params.OrderBy, sortFields)
+	if err != nil {
+		return nil, err
+	}
+	if sort != "" {
+		query.Set("sort", sort)
+	}
+
+	return query, nil
+}
+
+// The list endpoints combine the parameters with AND, so only the conditions combined with AND are supported
+func addPDFilterParams(query url.Values, filter *connectors.Filter, filterParams map[string]string) error {
+	if len(filter.Or) > 0 {
+		return errors.New("filtering with or is not supported")
+	}
+
+	for _, condition := range filter.Conditions {
+		param, ok := filterParams[condition.Field]
+		if !ok {
+			return fmt.Errorf("filtering by %s is not supported", condition.Field)
+		}
+
+		value, ok := pdFilterValue(condition)
+		if !ok {
+			return fmt.Errorf("filtering by %s only supports eq", condition.Field)
+		}
+
+		if query.Has(param) {
+			return fmt.Errorf("filtering by %s more than once is not supported", condition.Field)
+		}
+		query.Set(param, value)
+	}
+
+	for _, and := range filter.And {
+		if err := addPDFilterParams(query, and, filterParams); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Equality conditions, "in" with a single value is the same as "eq"
+func pdFilterValue(condition connectors.FilterCondition) (string, bool) {
+	switch value := condition.Value.(type) {
+	case string:
+		return value, condition.Operator == connectors.FILTER_OPERATOR_EQ
+	case []string:
+		return strings.Join(value, ","), condition.Operator == connectors.FILTER_OPERATOR_IN && len(value) == 1
+	}
+
+	return "", false
+}
+
+// Sort parameter of the list endpoint, e.g. "add_time DESC, id ASC".
+// The ID is always the last one to make the order stable for the offset pagination.
+func pdSort(orderBy []*model.SortInput, sortFields map[string]string) (string, error) {
+	if len(orderBy) == 0 {
+		return "", nil
+	}
+
+	clauses := make([]string, 0, len(orderBy)+1)
+	for _, sort := range orderBy {
+		field, ok := sortFields[sort.Field]
+		if !ok {
+			return "", fmt.Errorf("sorting by %s is not supported", sort.Field)
+		}
+
+		if connectors.IsDescending(sort) {
+			clauses = append(clauses, field+" DESC")
+		} else {
+			clauses = append(clauses, field+" ASC")
+		}
+	}
+
+	return strings.Join(append(clauses, "id ASC"), ", "), nil
+}
@@ -0,0 +1,423 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Pipedrive leads only have a title, the lead details are in the person and the organization of the lead.
+// The status of a lead is the name of its label. Leads converted to deals are removed from the leads,
+// so the listed leads are never converted.
+// https://developers.pipedrive.com/docs/api/v1/Leads
+type PDLead struct {
+	ID             string       `json:"id"` // UUID
+	Title          string       `json:"title"`
+	OwnerID        *PDReference `json:"owner_id"`
+	PersonID       *PDReference `json:"person_id"`
+	OrganizationID *PDReference `json:"organization_id"`
+	LabelIDs       []string     `json:"label_ids"`
+	IsArchived     bool         `json:"is_archived"`
+	Value          *PDLeadValue `json:"value"`
+	AddTime        *string      `json:"add_time"`
+	UpdateTime     *string      `json:"update_time"`
+}
+
+type PDLeadValue struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// https://developers.pipedrive.com/docs/api/v1/LeadLabels
+type PDLeadLabel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PDLeadCreateUpdatePayload struct {
+	Title          string   `json:"title,omitempty"`
+	PersonID       *int     `json:"person_id,omitempty"`
+	OrganizationID *int     `json:"organization_id,omitempty"`
+	LabelIDs       []string `json:"label_ids,omitempty"`
+}
+
+// Maps leads with the details of their persons and organizations, the details are read once per mapper
+type pdLeadMapper struct {
+	client        *Client
+	labels        []PDLeadLabel
+	persons       map[int]*PDPerson
+	organizations map[int]*PDOrganization
+}
+
+func (client *Client) ListLeads(ctx context.Context, params *connectors.ListParams) (*model.LeadConnection, error) {
+	query, err := pdListQuery(params, nil, pdLeadSortFields)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("archived_status", "not_archived")
+
+	pdLeads := []PDLead{}
+	offset, err := client.listPage(ctx, "leads", params, query, &pdLeads)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, err := client.newLeadMapper(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leadEdges := make([]*model.LeadEdge, len(pdLeads))
+	for i := range pdLeads {
+		lead, err := mapper.mapLeadProperties(ctx, &pdLeads[i])
+		if err != nil {
+			return nil, err
+		}
+
+		leadEdges[i] = &model.LeadEdge{
+			Node:   lead,
+			Cursor: pdCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &leadEdges)
+
+	return &model.LeadConnection{
+		Edges:    recordsValue.Interface().([]*model.LeadEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetLead(ctx context.Context, leadId string) (*model.Lead, error) {
+	pdLead := PDLead{}
+	if err := client.get(ctx, "leads", leadId, &pdLead); err != nil {
+		return nil, err
+	}
+
+	mapper, err := client.newLeadMapper(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.mapLeadProperties(ctx, &pdLead)
+}
+
+// Creates the person and the organization of the lead, then the lead itself
+func (client *Client) CreateLead(ctx context.Context, input *model.LeadInput) (*model.Lead, error) {
+	mapper, err := client.newLeadMapper(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := PDLeadCreateUpdatePayload{}
+	if payload.LabelIDs, err = mapper.labelIDs(input.Status); err != nil {
+		return nil, err
+	}
+
+	if input.CompanyName != nil && *input.CompanyName != "" {
+		if payload.OrganizationID, err = mapper.createOrganization(ctx, *input.CompanyName); err != nil {
+			return nil, err
+		}
+		payload.Title = *input.CompanyName
+	}
+
+	if hasLeadPersonInput(input) || payload.OrganizationID == nil {
+		if payload.PersonID, err = mapper.createPerson(ctx, input, payload.OrganizationID); err != nil {
+			return nil, err
+		}
+		if payload.Title == "" {
+			payload.Title = mapper.persons[*payload.PersonID].Name
+		}
+	}
+
+	pdLead := PDLead{}
+	if err := client.create(ctx, "leads", &payload, &pdLead); err != nil {
+		return nil, err
+	}
+
+	return mapper.mapLeadProperties(ctx, &pdLead)
+}
+
+func (client *Client) UpdateLead(ctx context.Context, leadId string, input *model.LeadInput) (bool, error) {
+	pdLead := PDLead{}
+	if err := client.get(ctx, "leads", leadId, &pdLead); err != nil {
+		return false, err
+	}
+
+	mapper, err := client.newLeadMapper(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	payload := PDLeadCreateUpdatePayload{}
+	if payload.LabelIDs, err = mapper.labelIDs(input.Status); err != nil {
+		return false, err
+	}
+
+	organizationId := pdLead.OrganizationID.id()
+	if input.CompanyName != nil && *input.CompanyName != "" {
+		if organizationId != nil {
+			if _, err := client.UpdateCompany(ctx, *organizationId, &model.CompanyInput{Name: *input.CompanyName}); err != nil {
+				return false, err
+			}
+		} else {
+			if payload.OrganizationID, err = mapper.createOrganization(ctx, *input.CompanyName); err != nil {
+				return false, err
+			}
+			id := formatPDID(*payload.OrganizationID)
+			organizationId = &id
+		}
+	}
+
+	if personId := pdLead.PersonID.id(); personId != nil {
+		contactInput := model.ContactInput{
+			FirstName: input.FirstName,
+			LastName:  input.LastName,
+			Email:     input.Email,
+			Phone:     input.Phone,
+		}
+		if payload.OrganizationID != nil {
+			contactInput.CompanyID = organizationId
+		}
+
+		if hasLeadPersonInput(input) || contactInput.CompanyID != nil {
+			if _, err := client.UpdateContact(ctx, *personId, &contactInput); err != nil {
+				return false, err
+			}
+		}
+	} else if hasLeadPersonInput(input) {
+		if payload.PersonID, err = mapper.createPerson(ctx, input, payload.OrganizationID); err != nil {
+			return false, err
+		}
+	}
+
+	url := fmt.Sprintf("%s/leads/%s", client.BaseURL, url.PathEscape(leadId))
+	if err := client.sendPayload(ctx, "PATCH", url, &payload, &PDLead{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Deletes the lead, its person and organization are kept
+func (client *Client) DeleteLead(ctx context.Context, leadId string) (bool, error) {
+	if err := client.delete(ctx, "leads", leadId); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Converting a lead links its person with a company, (optionally) creates a deal for them
+// and removes the lead, the way Pipedrive moves the converted leads to the deals.
+// Pipedrive has no lead statuses for the converted leads, so the converted status is ignored.
+func (client *Client) ConvertLead(ctx context.Context, leadId string, input *model.LeadConversionInput) (*model.LeadConversionResult, error) {
+	pdLead := PDLead{}
+	if err := client.get(ctx, "leads", leadId, &pdLead); err != nil {
+		return nil, err
+	}
+
+	personId := pdLead.PersonID.id()
+	if personId == nil {
+		return nil, errors.New("lead has no person to convert")
+	}
+
+	result := model.LeadConversionResult{
+		ContactID: *personId,
+		CompanyID: pdLead.OrganizationID.id(),
+	}
+
+	if input.CompanyID != nil {
+		result.CompanyID = input.CompanyID
+		if _, err := client.LinkContactToCompany(ctx, result.ContactID, *input.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.CreateOpportunity == nil || *input.CreateOpportunity {
+		opportunityId, err := client.createLeadConversionDeal(ctx, &pdLead, result.CompanyID, input.OpportunityName)
+		if err != nil {
+			return nil, err
+		}
+
+		result.OpportunityID = &opportunityId
+	}
+
+	if err := client.delete(ctx, "leads", leadId); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// The deal is created in the first stage of the default pipeline
+func (client *Client) createLeadConversionDeal(ctx context.Context, pdLead *PDLead, companyId *string, opportunityName *string) (string, error) {
+	payload := PDDealCreateUpdatePayload{
+		Title:    pdLead.Title,
+		PersonID: &pdLead.PersonID.ID,
+	}
+
+	if opportunityName != nil {
+		payload.Title = *opportunityName
+	}
+
+	if pdLead.Value != nil {
+		value := strconv.FormatFloat(pdLead.Value.Amount, 'f', -1, 64)
+		payload.Value = &value
+	}
+
+	var err error
+	if payload.OrgID, err = parseOptionalPDID(companyId); err != nil {
+		return "", err
+	}
+
+	pdDeal := PDDeal{}
+	if err := client.create(ctx, "deals", &payload, &pdDeal); err != nil {
+		return "", err
+	}
+
+	return formatPDID(pdDeal.ID), nil
+}
+
+func (client *Client) newLeadMapper(ctx context.Context) (*pdLeadMapper, error) {
+	mapper := pdLeadMapper{
+		client:        client,
+		labels:        []PDLeadLabel{},
+		persons:       map[int]*PDPerson{},
+		organizations: map[int]*PDOrganization{},
+	}
+
+	if err := client.listAll(ctx, "leadLabels", nil, &mapper.labels); err != nil {
+		return nil, err
+	}
+
+	return &mapper, nil
+}
+
+func (mapper *pdLeadMapper) mapLeadProperties(ctx context.Context, pdLead *PDLead) (*model.Lead, error) {
+	converted := false
+
+	lead := model.Lead{
+		ID:        pdLead.ID,
+		Name:      &pdLead.Title,
+		Converted: &converted,
+		Archived:  &pdLead.IsArchived,
+		CreatedAt: parsePDDateTime(pdLead.AddTime),
+		UpdatedAt: parsePDDateTime(pdLead.UpdateTime),
+	}
+
+	for i := range mapper.labels {
+		if len(pdLead.LabelIDs) > 0 && mapper.labels[i].ID == pdLead.LabelIDs[0] {
+			lead.Status = &mapper.labels[i].Name
+		}
+	}
+
+	if pdLead.PersonID != nil && pdLead.PersonID.ID != 0 {
+		pdPerson, err := mapper.person(ctx, pdLead.PersonID.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		contact := pdPerson.mapContactProperties()
+		lead.Name = contact.Name
+		lead.FirstName = contact.FirstName
+		lead.LastName = contact.LastName
+		lead.Email = contact.Email
+		lead.Phone = contact.Phone
+		lead.CompanyName = contact.CompanyName
+	}
+
+	if pdLead.OrganizationID != nil && pdLead.OrganizationID.ID != 0 {
+		pdOrganization, err := mapper.organization(ctx, pdLead.OrganizationID.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		lead.CompanyName = &pdOrganization.Name
+	}
+
+	return &lead, nil
+}
+
+func (mapper *pdLeadMapper) person(ctx context.Context, personId int) (*PDPerson, error) {
+	if pdPerson, ok := mapper.persons[personId]; ok {
+		return pdPerson, nil
+	}
+
+	pdPerson := PDPerson{}
+	if err := mapper.client.get(ctx, "persons", formatPDID(personId), &pdPerson); err != nil {
+		return nil, err
+	}
+	mapper.persons[personId] = &pdPerson
+
+	return &pdPerson, nil
+}
+
+func (mapper *pdLeadMapper) organization(ctx context.Context, organizationId int) (*PDOrganization, error) {
+	if pdOrganization, ok := mapper.organizations[organizationId]; ok {
+		return pdOrganization, nil
+	}
+
+	pdOrganization := PDOrganization{}
+	if err := mapper.client.get(ctx, "organizations", formatPDID(organizationId), &pdOrganization); err != nil {
+		return nil, err
+	}
+	mapper.organizations[organizationId] = &pdOrganization
+
+	return &pdOrganization, nil
+}
+
+func (mapper *pdLeadMapper) createPerson(ctx context.Context, input *model.LeadInput, organizationId *int) (*int, error) {
+	payload, err := createPDPersonPayload(&model.ContactInput{
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+		Email:     input.Email,
+		Phone:     input.Phone,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	payload.OrgID = organizationId
+
+	pdPerson := PDPerson{}
+	if err := mapper.client.create(ctx, "persons", payload, &pdPerson); err != nil {
+		return nil, err
+	}
+	mapper.persons[pdPerson.ID] = &pdPerson
+
+	return &pdPerson.ID, nil
+}
+
+func (mapper *pdLeadMapper) createOrganization(ctx context.Context, name string) (*int, error) {
+	pdOrganization := PDOrganization{}
+	if err := mapper.client.create(ctx, "organizations", &PDOrganizationCreateUpdatePayload{Name: name}, &pdOrganization); err != nil {
+		return nil, err
+	}
+	mapper.organizations[pdOrganization.ID] = &pdOrganization
+
+	return &pdOrganization.ID, nil
+}
+
+// IDs of the label with the status as its name
+func (mapper *pdLeadMapper) labelIDs(status *string) ([]string, error) {
+	if status == nil {
+		return nil, nil
+	}
+
+	for _, label := range mapper.labels {
+		if strings.EqualFold(label.Name, *status) {
+			return []string{label.ID}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown lead status %s, the status has to be the name of a lead label", *status)
+}
+
+func hasLeadPersonInput(input *model.LeadInput) bool {
+	return input.FirstName != nil || input.LastName != nil || input.Email != nil || input.Phone != nil
+}
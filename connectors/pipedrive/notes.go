@@ -0,0 +1,86 @@
+package pipedrive
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"net/url"
+)
+
+// https://developers.pipedrive.com/docs/api/v1/Notes
+type PDNote struct {
+	ID         int          `json:"id"`
+	Content    string       `json:"content"` // can contain HTML
+	PersonID   *PDReference `json:"person_id"`
+	DealID     *PDReference `json:"deal_id"`
+	AddTime    *string      `json:"add_time"`
+	UpdateTime *string      `json:"update_time"`
+	ActiveFlag bool         `json:"active_flag"`
+}
+
+type PDNoteCreatePayload struct {
+	Content  string `json:"content"`
+	PersonID *int   `json:"person_id,omitempty"`
+	DealID   *int   `json:"deal_id,omitempty"`
+}
+
+func (client *Client) ListContactNotes(ctx context.Context, contactId string) ([]*model.Note, error) {
+	return client.listNotes(ctx, "person_id", contactId)
+}
+
+func (client *Client) CreateContactNote(ctx context.Context, contactId string, input *model.NoteInput) (*model.Note, error) {
+	personId, err := parsePDID(contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.createNote(ctx, &PDNoteCreatePayload{Content: input.Content, PersonID: &personId})
+}
+
+func (client *Client) ListOpportunityNotes(ctx context.Context, opportunityId string) ([]*model.Note, error) {
+	return client.listNotes(ctx, "deal_id", opportunityId)
+}
+
+func (client *Client) CreateOpportunityNote(ctx context.Context, opportunityId string, input *model.NoteInput) (*model.Note, error) {
+	dealId, err := parsePDID(opportunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.createNote(ctx, &PDNoteCreatePayload{Content: input.Content, DealID: &dealId})
+}
+
+// Notes are listed by the ID of the related object, e.g. "person_id"
+func (client *Client) listNotes(ctx context.Context, relatedObjectParam string, relatedObjectId string) ([]*model.Note, error) {
+	query := url.Values{}
+	query.Set(relatedObjectParam, relatedObjectId)
+
+	pdNotes := []PDNote{}
+	if err := client.listAll(ctx, "notes", query, &pdNotes); err != nil {
+		return nil, err
+	}
+
+	notes := make([]*model.Note, len(pdNotes))
+	for i, pdNote := range pdNotes {
+		notes[i] = pdNote.mapNoteProperties()
+	}
+
+	return notes, nil
+}
+
+func (client *Client) createNote(ctx context.Context, payload *PDNoteCreatePayload) (*model.Note, error) {
+	pdNote := PDNote{}
+	if err := client.create(ctx, "notes", payload, &pdNote); err != nil {
+		return nil, err
+	}
+
+	return pdNote.mapNoteProperties(), nil
+}
+
+func (pdNote PDNote) mapNoteProperties() *model.Note {
+	return &model.Note{
+		ID:        formatPDID(pdNote.ID),
+		Content:   pdNote.Content,
+		CreatedAt: parsePDDateTime(pdNote.AddTime),
+		UpdatedAt: parsePDDateTime(pdNote.UpdateTime),
+	}
+}
@@ -0,0 +1,192 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strconv"
+)
+
+const (
+	PD_DEAL_STATUS_DELETED = "deleted"
+)
+
+// Opportunities are deals in Pipedrive, the stage name of an opportunity is the ID of the deal stage
+// https://developers.pipedrive.com/docs/api/v1/Deals
+type PDDeal struct {
+	ID                int          `json:"id"`
+	Title             string       `json:"title"`
+	Value             *float64     `json:"value"`
+	Currency          string       `json:"currency"`
+	StageID           *int         `json:"stage_id"`
+	PipelineID        *int         `json:"pipeline_id"`
+	OrgID             *PDReference `json:"org_id"`
+	PersonID          *PDReference `json:"person_id"`
+	UserID            *PDReference `json:"user_id"`
+	ExpectedCloseDate *string      `json:"expected_close_date"`
+	Status            string       `json:"status"` // open, won, lost or deleted
+	AddTime           *string      `json:"add_time"`
+	UpdateTime        *string      `json:"update_time"`
+	Active            bool         `json:"active"`
+	Deleted           bool         `json:"deleted"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields, they're keyed by hashes
+}
+
+func (pdDeal *PDDeal) UnmarshalJSON(data []byte) error {
+	type plainPDDeal PDDeal
+	return connectors.UnmarshalWithRawFields(data, (*plainPDDeal)(pdDeal), &pdDeal.RawFields)
+}
+
+type PDDealCreateUpdatePayload struct {
+	Title             string  `json:"title,omitempty"`
+	Value             *string `json:"value,omitempty"`
+	StageID           *int    `json:"stage_id,omitempty"`
+	PipelineID        *int    `json:"pipeline_id,omitempty"`
+	ExpectedCloseDate *string `json:"expected_close_date,omitempty"`
+	OrgID             *int    `json:"org_id,omitempty"`
+	PersonID          *int    `json:"person_id,omitempty"`
+	UserID            *int    `json:"user_id,omitempty"`
+}
+
+func (client *Client) ListOpportunities(ctx context.Context, params *connectors.ListParams) (*model.OpportunityConnection, error) {
+	pdDeals := []PDDeal{}
+	offset, err := client.listOrSearch(ctx, "deals", params, pdDealFilterParams, pdDealSortFields, &pdDeals)
+	if err != nil {
+		return nil, err
+	}
+
+	opportunityEdges := make([]*model.OpportunityEdge, len(pdDeals))
+	for i := range pdDeals {
+		opportunityEdges[i] = &model.OpportunityEdge{
+			Node:   client.mapOpportunity(&pdDeals[i]),
+			Cursor: pdCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &opportunityEdges)
+
+	return &model.OpportunityConnection{
+		Edges:    recordsValue.Interface().([]*model.OpportunityEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error) {
+	pdDeal := PDDeal{}
+	if err := client.get(ctx, "deals", opportunityId, &pdDeal); err != nil {
+		return nil, err
+	}
+
+	return client.mapOpportunity(&pdDeal), nil
+}
+
+func (client *Client) CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error) {
+	payload, err := client.createPDDealPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pdDeal := PDDeal{}
+	if err := client.create(ctx, "deals", payload, &pdDeal); err != nil {
+		return nil, err
+	}
+
+	return client.mapOpportunity(&pdDeal), nil
+}
+
+func (client *Client) UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error) {
+	payload, err := client.createPDDealPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := client.update(ctx, "deals", opportunityId, payload, &PDDeal{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteOpportunity(ctx context.Context, opportunityId string) (bool, error) {
+	if err := client.delete(ctx, "deals", opportunityId); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Creates Pipedrive Deal Update/Create payload from GraphQL input
+func (client *Client) createPDDealPayload(input *model.OpportunityInput) (map[string]interface{}, error) {
+	payload := PDDealCreateUpdatePayload{
+		Title: input.Name,
+		Value: input.Amount,
+	}
+
+	closeDate := input.CloseDate.UTC().Format(PD_DATE_FORMAT)
+	payload.ExpectedCloseDate = &closeDate
+
+	stageId, err := parsePDID(input.StageName)
+	if err != nil {
+		return nil, err
+	}
+	payload.StageID = &stageId
+
+	if payload.PipelineID, err = parseOptionalPDID(input.PipelineID); err != nil {
+		return nil, err
+	}
+
+	if payload.OrgID, err = parseOptionalPDID(input.CompanyID); err != nil {
+		return nil, err
+	}
+
+	if payload.UserID, err = parseOptionalPDID(input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectOpportunity, input.CustomFields))
+}
+
+// Maps the opportunity with its custom fields
+func (client *Client) mapOpportunity(pdDeal *PDDeal) *model.Opportunity {
+	opportunity := pdDeal.mapOpportunityProperties()
+	opportunity.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectOpportunity, pdDeal.RawFields)
+
+	return opportunity
+}
+
+func (pdDeal *PDDeal) mapOpportunityProperties() *model.Opportunity {
+	opportunity := model.Opportunity{
+		ID:        formatPDID(pdDeal.ID),
+		Name:      pdDeal.Title,
+		CloseDate: parsePDDate(pdDeal.ExpectedCloseDate),
+		Owner:     pdOwnerReference(pdDeal.UserID),
+		CreatedAt: parsePDDateTime(pdDeal.AddTime),
+		UpdatedAt: parsePDDateTime(pdDeal.UpdateTime),
+	}
+
+	if pdDeal.Value != nil {
+		amount := strconv.FormatFloat(*pdDeal.Value, 'f', -1, 64)
+		opportunity.Amount = &amount
+	}
+
+	if pdDeal.StageID != nil {
+		stageName := formatPDID(*pdDeal.StageID)
+		opportunity.StageName = &stageName
+	}
+
+	if pdDeal.PipelineID != nil {
+		pipelineId := formatPDID(*pdDeal.PipelineID)
+		opportunity.PipelineID = &pipelineId
+	}
+
+	if orgId := pdDeal.OrgID.id(); orgId != nil {
+		opportunity.Company = &model.Company{ID: *orgId, Name: pdDeal.OrgID.Name}
+	}
+
+	return &opportunity
+}
+
+func (pdDeal *PDDeal) isDeleted() bool {
+	return pdDeal.Deleted || pdDeal.Status == PD_DEAL_STATUS_DELETED
+}
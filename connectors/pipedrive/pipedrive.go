@@ -0,0 +1,476 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	PDBaseUrlV1 = "https://api.pipedrive.com/v1"
+
+	// Maximum page size of the list endpoints
+	PD_PAGE_LIMIT = 500
+
+	PD_DATE_TIME_FORMAT = "2006-01-02 15:04:05" // UTC
+	PD_DATE_FORMAT      = "2006-01-02"
+)
+
+type Client struct {
+	BaseURL      string
+	APIToken     string
+	HTTPClient   *http.Client
+	FieldMapping connectors.FieldMapping
+}
+
+// All the responses have the same envelope, the object or the list of objects is in "data"
+// https://pipedrive.readme.io/docs/core-api-concepts-responses
+type PDResponse struct {
+	Success        bool              `json:"success"`
+	Error          string            `json:"error"`
+	Data           json.RawMessage   `json:"data"`
+	AdditionalData *PDAdditionalData `json:"additional_data"`
+}
+
+type PDAdditionalData struct {
+	Pagination *PDPagination `json:"pagination"`
+}
+
+// https://pipedrive.readme.io/docs/core-api-concepts-pagination
+type PDPagination struct {
+	Start                 int  `json:"start"`
+	Limit                 int  `json:"limit"`
+	MoreItemsInCollection bool `json:"more_items_in_collection"`
+	NextStart             int  `json:"next_start"`
+}
+
+type PipedriveError struct {
+	StatusCode int
+	Err        error
+}
+
+// Related object, e.g. the organization of a person.
+// Pipedrive returns either the ID or an object with the ID in "value" and the name of the related object.
+type PDReference struct {
+	ID   int
+	Name string
+}
+
+func (e *PipedriveError) Error() string {
+	return e.Err.Error()
+}
+
+func (reference *PDReference) UnmarshalJSON(data []byte) error {
+	var id int
+	if err := json.Unmarshal(data, &id); err == nil {
+		reference.ID = id
+		return nil
+	}
+
+	object := struct {
+		Value int    `json:"value"`
+		Name  string `json:"name"`
+	}{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	reference.ID = object.Value
+	reference.Name = object.Name
+
+	return nil
+}
+
+func PipedriveClient(apiToken string) *Client {
+	return &Client{
+		BaseURL:  PDBaseUrlV1,
+		APIToken: apiToken,
+		HTTPClient: &http.Client{
+			Timeout: time.Minute,
+		},
+	}
+}
+
+// Sends the request and reads the data of the response into the given value
+func (client *Client) sendRequest(req *http.Request, data interface{}) (*PDAdditionalData, *PipedriveError) {
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept", "application/json; charset=utf-8")
+
+	query := req.URL.Query()
+	query.Set("api_token", client.APIToken)
+	req.URL.RawQuery = query.Encode()
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, &PipedriveError{
+			Err: errors.New(strings.ReplaceAll(err.Error(), client.APIToken, "***")),
+		}
+	}
+	defer res.Body.Close()
+
+	// the query has the API token, it's never logged
+	url := strings.Split(res.Request.URL.String(), "?")[0]
+
+	response := PDResponse{}
+	if err = json.NewDecoder(res.Body).Decode(&response); err != nil {
+		log.WithFields(log.Fields{
+			"status_code": res.StatusCode,
+			"url":         url,
+			"method":      req.Method,
+			"err":         "cannot parse response",
+		}).Info("Pipedrive request")
+
+		return nil, &PipedriveError{
+			StatusCode: res.StatusCode,
+			Err:        fmt.Errorf("unexpected response with status code %d", res.StatusCode),
+		}
+	}
+
+	if res.StatusCode >= http.StatusBadRequest || !response.Success {
+		log.WithFields(log.Fields{
+			"status_code": res.StatusCode,
+			"url":         url,
+			"method":      req.Method,
+			"err":         response.Error,
+		}).Info("Pipedrive request")
+
+		message := response.Error
+		if message == "" {
+			message = fmt.Sprintf("unexpected status code %d", res.StatusCode)
+		}
+
+		return nil, &PipedriveError{
+			StatusCode: res.StatusCode,
+			Err:        errors.New(message),
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"status_code": res.StatusCode,
+		"url":         url,
+		"method":      req.Method,
+	}).Info("Pipedrive request")
+
+	if data != nil && len(response.Data) > 0 {
+		if err := json.Unmarshal(response.Data, data); err != nil {
+			return nil, &PipedriveError{
+				StatusCode: res.StatusCode,
+				Err:        err,
+			}
+		}
+	}
+
+	return response.AdditionalData, nil
+}
+
+func (client *Client) get(ctx context.Context, objectPath string, objectId string, data interface{}) error {
+	url := fmt.Sprintf("%s/%s/%s", client.BaseURL, objectPath, url.PathEscape(objectId))
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	if _, err := client.sendRequest(req, data); err != nil {
+		if err.StatusCode == 404 {
+			return errors.New("not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (client *Client) create(ctx context.Context, objectPath string, payload interface{}, data interface{}) error {
+	return client.sendPayload(ctx, "POST", fmt.Sprintf("%s/%s", client.BaseURL, objectPath), payload, data)
+}
+
+func (client *Client) update(ctx context.Context, objectPath string, objectId string, payload interface{}, data interface{}) error {
+	return client.sendPayload(ctx, "PUT", fmt.Sprintf("%s/%s/%s", client.BaseURL, objectPath, url.PathEscape(objectId)), payload, data)
+}
+
+func (client *Client) delete(ctx context.Context, objectPath string, objectId string) error {
+	url := fmt.Sprintf("%s/%s/%s", client.BaseURL, objectPath, url.PathEscape(objectId))
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	if _, err := client.sendRequest(req, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (client *Client) sendPayload(ctx context.Context, method string, url string, payload interface{}, data interface{}) error {
+	payloadString, _ := json.Marshal(payload)
+
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(payloadString))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	if _, err := client.sendRequest(req, data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reads a page of the list starting at the given offset
+func (client *Client) list(ctx context.Context, path string, query url.Values, start int, limit int, data interface{}) (*PDPagination, error) {
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+	pageQuery.Set("start", strconv.Itoa(start))
+	pageQuery.Set("limit", strconv.Itoa(limit))
+
+	url := fmt.Sprintf("%s/%s?%s", client.BaseURL, path, pageQuery.Encode())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	additionalData, pdErr := client.sendRequest(req, data)
+	if pdErr != nil {
+		return nil, pdErr
+	}
+
+	if additionalData == nil {
+		return nil, nil
+	}
+
+	return additionalData.Pagination, nil
+}
+
+// Reads all the pages of the list, the items are appended to the slice the "items" points to
+func (client *Client) listAll(ctx context.Context, path string, query url.Values, items interface{}) error {
+	itemsValue := reflect.ValueOf(items).Elem()
+
+	start := 0
+	for {
+		page := reflect.New(itemsValue.Type())
+		pagination, err := client.list(ctx, path, query, start, PD_PAGE_LIMIT, page.Interface())
+		if err != nil {
+			return err
+		}
+
+		itemsValue.Set(reflect.AppendSlice(itemsValue, page.Elem()))
+
+		if pagination == nil || !pagination.MoreItemsInCollection {
+			return nil
+		}
+		start = pagination.NextStart
+	}
+}
+
+// Lists a page of the objects. The lists are paginated with an offset, so the cursors hold the offsets of the records.
+// Returns the offset of the first returned record.
+func (client *Client) listPage(ctx context.Context, path string, params *connectors.ListParams, query url.Values, items interface{}) (int, error) {
+	start, limit, err := pdPage(params)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == 0 {
+		// nothing is before the first record
+		return start, nil
+	}
+
+	_, err = client.list(ctx, path, query, start, limit, items)
+	return start, err
+}
+
+// Offset and number of the records to read for the page, including the record
+// that prepareListResults uses to see if there are more pages
+func pdPage(params *connectors.ListParams) (int, int, error) {
+	if params.Backward() {
+		if params.Before == nil {
+			// the last page is unknown without the total count
+			return 0, 0, errors.New("last can only be used with before")
+		}
+
+		before, err := pdCursorOffset(*params.Before)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// +1 to see if there are more pages
+		start := before - *params.Last - 1
+		if start < 0 {
+			start = 0
+		}
+		return start, before - start, nil
+	}
+
+	if params.After == nil {
+		// +1 to see if there are more pages
+		return 0, params.First + 1, nil
+	}
+
+	after, err := pdCursorOffset(*params.After)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return after + 1, params.First + 1, nil
+}
+
+// Returns cursor of the record at the given offset
+func pdCursor(offset int) string {
+	return connectors.EncodeCursor(strconv.Itoa(offset))
+}
+
+func pdCursorOffset(cursor string) (int, error) {
+	offset, err := strconv.Atoi(connectors.DecodeCursor(cursor))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid cursor")
+	}
+
+	return offset, nil
+}
+
+func (client *Client) prepareListResults(params *connectors.ListParams, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
+	recordsValue := reflect.ValueOf(edgesPtr).Elem()
+	recordsLenght := recordsValue.Len()
+
+	start := 0
+	end := recordsLenght
+	pageInfo := &model.PageInfo{}
+
+	if params.Backward() {
+		// remove the item that was added to see if there are more pages
+		if recordsLenght > *params.Last {
+			start = recordsLenght - *params.Last
+			pageInfo.HasPreviousPage = true
+		}
+		pageInfo.HasNextPage = params.Before != nil
+	} else {
+		if recordsLenght > params.First {
+			// remove the item that was added to see if there are more pages
+			end = params.First
+			pageInfo.HasNextPage = true
+		}
+		pageInfo.HasPreviousPage = params.After != nil
+	}
+
+	ret := recordsValue.Slice(start, end)
+
+	if ret.Len() > 0 {
+		startCursor := ret.Index(0).Elem().FieldByName("Cursor").String()
+		endCursor := ret.Index(ret.Len() - 1).Elem().FieldByName("Cursor").String()
+
+		pageInfo.StartCursor = &startCursor
+		pageInfo.EndCursor = &endCursor
+	}
+
+	return ret, pageInfo
+}
+
+// Pipedrive IDs are integers, the unified API uses strings
+func parsePDID(id string) (int, error) {
+	pdID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %s", id)
+	}
+
+	return pdID, nil
+}
+
+func formatPDID(id int) string {
+	return strconv.Itoa(id)
+}
+
+// Same as parsePDID for the optional inputs
+func parseOptionalPDID(id *string) (*int, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	pdID, err := parsePDID(*id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pdID, nil
+}
+
+// Returns the ID of the related object, if any
+func (reference *PDReference) id() *string {
+	if reference == nil || reference.ID == 0 {
+		return nil
+	}
+
+	id := formatPDID(reference.ID)
+	return &id
+}
+
+// Returns a reference to the owner, if any
+func pdOwnerReference(reference *PDReference) *model.User {
+	if id := reference.id(); id != nil {
+		return &model.User{ID: *id}
+	}
+
+	return nil
+}
+
+// Most of the objects have times in UTC without the time zone, leads have RFC 3339 times
+func parsePDDateTime(dateTime *string) *time.Time {
+	if dateTime == nil || *dateTime == "" {
+		return nil
+	}
+
+	for _, layout := range []string{PD_DATE_TIME_FORMAT, time.RFC3339} {
+		if t, err := time.Parse(layout, *dateTime); err == nil {
+			return &t
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"dateTime": dateTime,
+	}).Error("Failed to parse dateTime")
+
+	return nil
+}
+
+func parsePDDate(date *string) *time.Time {
+	if date == nil || *date == "" {
+		return nil
+	}
+
+	t, err := time.Parse(PD_DATE_FORMAT, *date)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"date": date,
+		}).Error("Failed to parse date")
+
+		return nil
+	}
+
+	return &t
+}
+
+func formatPDDateTime(dateTime time.Time) string {
+	return dateTime.UTC().Format(PD_DATE_TIME_FORMAT)
+}
@@ -0,0 +1,433 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/misc/test_utils"
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+)
+
+// Client of the Pipedrive account of the API token, the tests against the API are skipped without a token
+func newLiveClient(t *testing.T) *Client {
+	godotenv.Load("../../.env")
+
+	apiToken := os.Getenv("PIPEDRIVE_API_TOKEN")
+	if apiToken == "" {
+		t.Skip("PIPEDRIVE_API_TOKEN is not set")
+	}
+
+	return PipedriveClient(apiToken)
+}
+
+// Generated opportunity input in the first stage of the first pipeline, Pipedrive stages are referenced by their IDs
+func generateOpportunityInput(t *testing.T, c *Client) *model.OpportunityInput {
+	pipelines, err := c.ListPipelines(context.Background())
+	assert.Nil(t, err, "expecting nil error")
+
+	input := test_utils.GenerateOpportunityInput()
+	input.StageName = pipelines[0].Stages[0].ID
+	input.PipelineID = &pipelines[0].ID
+
+	return input
+}
+
+func TestListContacts(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 10})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotNil(t, contactConnection.Edges, "expecting non-nil contacts")
+	assert.Greater(t, len(contactConnection.Edges), 0, "expecting more than zero contacts")
+	assert.NotEmpty(t, contactConnection.Edges[0].Node.ID, "expecting a non-empty ID for the first contact")
+}
+
+func TestListContactsPagination(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 1})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	firstId := contactConnection.Edges[0].Node.ID
+
+	contactConnection, err = c.ListContacts(ctx, &connectors.ListParams{First: 1, After: contactConnection.PageInfo.EndCursor})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	assert.NotEqual(t, firstId, contactConnection.Edges[0].Node.ID, "expecting a different ID")
+	assert.True(t, contactConnection.PageInfo.HasPreviousPage, "expecting a previous page")
+
+	last := 1
+	contactConnection, err = c.ListContacts(ctx, &connectors.ListParams{Last: &last, Before: contactConnection.PageInfo.StartCursor})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	assert.Equal(t, firstId, contactConnection.Edges[0].Node.ID, "expecting the first contact before the second one")
+	assert.False(t, contactConnection.PageInfo.HasPreviousPage, "expecting no page before the first contact")
+}
+
+func TestContactCRUD(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	input := test_utils.GenerateContactInput()
+
+	contact, err := c.CreateContact(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, contact.ID, "expecting a non-empty ID for the contact")
+	assert.Equal(t, *input.Email, *contact.Email, "expecting an email for the contact equal to the created one")
+
+	lastName := "Updated"
+	success, err := c.UpdateContact(ctx, contact.ID, &model.ContactInput{LastName: &lastName})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	foundContact, err := c.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, *input.FirstName+" "+lastName, *foundContact.Name, "expecting the first name to be kept")
+
+	success, err = c.DeleteContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true delete result")
+}
+
+func TestCreateContactAndAddNote(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	noteInput := test_utils.GenerateNoteInput()
+	note, err := c.CreateContactNote(ctx, contact.ID, noteInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, note.ID, "expecting a non-empty ID for the note")
+	assert.Equal(t, noteInput.Content, note.Content, "expecting a content for the note equal to the content requested")
+
+	notes, err := c.ListContactNotes(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(notes), "expecting a single note of the contact")
+
+	c.DeleteContact(ctx, contact.ID)
+}
+
+func TestOpportunityCRUD(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	input := generateOpportunityInput(t, c)
+
+	opportunity, err := c.CreateOpportunity(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, opportunity.ID, "expecting a non-empty ID for the opportunity")
+	assert.Equal(t, input.StageName, *opportunity.StageName, "expecting the stage of the opportunity to be the created one")
+
+	foundOpportunity, err := c.GetOpportunity(ctx, opportunity.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, opportunity.Name, foundOpportunity.Name, "expecting a name for the opportunity equal to the created one")
+
+	success, err := c.UpdateOpportunity(ctx, opportunity.ID, generateOpportunityInput(t, c))
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	success, err = c.DeleteOpportunity(ctx, opportunity.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true delete result")
+}
+
+func TestLinkContactToCompany(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+
+	company, err := c.CreateCompany(ctx, test_utils.GenerateCompanyInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	success, err := c.LinkContactToCompany(ctx, contact.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true link result")
+
+	companyContacts, err := c.ListCompanyContacts(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(companyContacts), "expecting a single contact of the company")
+	assert.Equal(t, company.ID, companyContacts[0].Company.ID, "expecting the contact to reference the linked company")
+
+	success, err = c.UnlinkContactFromCompany(ctx, contact.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true unlink result")
+
+	unlinkedContact, err := c.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Nil(t, unlinkedContact.Company, "expecting the contact not to reference the company")
+
+	c.DeleteContact(ctx, contact.ID)
+	c.DeleteCompany(ctx, company.ID)
+}
+
+func TestLeadCRUD(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	input := test_utils.GenerateLeadInput()
+	input.Status = nil
+
+	lead, err := c.CreateLead(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, lead.ID, "expecting a non-empty ID for the lead")
+	assert.Equal(t, *input.CompanyName, *lead.CompanyName, "expecting the organization of the lead to be created")
+	assert.Equal(t, *input.Email, *lead.Email, "expecting the person of the lead to be created")
+
+	input = test_utils.GenerateLeadInput()
+	input.Status = nil
+
+	success, err := c.UpdateLead(ctx, lead.ID, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	foundLead, err := c.GetLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, *input.Email, *foundLead.Email, "expecting the person of the lead to be updated")
+
+	success, err = c.DeleteLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true delete result")
+}
+
+func TestConvertLead(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	input := test_utils.GenerateLeadInput()
+	input.Status = nil
+
+	lead, err := c.CreateLead(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+
+	result, err := c.ConvertLead(ctx, lead.ID, &model.LeadConversionInput{})
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, result.ContactID, "expecting the person of the lead")
+	assert.NotNil(t, result.CompanyID, "expecting the organization of the lead")
+	assert.NotNil(t, result.OpportunityID, "expecting a deal to be created for the converted lead")
+
+	_, err = c.GetLead(ctx, lead.ID)
+	assert.NotNil(t, err, "expecting the converted lead to be removed")
+}
+
+func TestCreateContactTaskAndComplete(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	taskInput := test_utils.GenerateTaskInput()
+	task, err := c.CreateContactTask(ctx, contact.ID, taskInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, taskInput.Subject, task.Subject, "expecting a subject for the task equal to the subject requested")
+
+	completed := model.TaskStatusCompleted
+	taskInput.Status = &completed
+
+	success, err := c.UpdateTask(ctx, task.ID, taskInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	tasks, err := c.ListContactTasks(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(tasks), "expecting a single task of the contact")
+	assert.Equal(t, model.TaskStatusCompleted, *tasks[0].Status, "expecting the task to be completed")
+
+	c.DeleteContact(ctx, contact.ID)
+}
+
+func TestLogContactActivities(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	subject := "Discovery call"
+	startTime := time.Now().UTC().Truncate(time.Minute)
+	endTime := startTime.Add(30 * time.Minute)
+	activity, err := c.CreateContactActivity(ctx, contact.ID, &model.ActivityInput{
+		Type:      model.ActivityTypeCall,
+		Subject:   &subject,
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, model.ActivityTypeCall, activity.Type, "expecting a call")
+	assert.Equal(t, endTime, *activity.EndTime, "expecting the end time to be kept in the duration")
+
+	activities, err := c.ListContactActivities(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(activities), "expecting a single activity of the contact")
+
+	c.DeleteContact(ctx, contact.ID)
+}
+
+func TestListUsers(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+
+	userConnection, err := c.ListUsers(ctx, &connectors.ListParams{First: 10})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(userConnection.Edges), 0, "expecting more than zero users")
+
+	user, err := c.GetUser(ctx, userConnection.Edges[0].Node.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, userConnection.Edges[0].Node.Email, user.Email, "expecting the listed user")
+}
+
+func TestListPipelines(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+
+	pipelines, err := c.ListPipelines(ctx)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(pipelines), 0, "expecting more than zero pipelines")
+	assert.Greater(t, len(pipelines[0].Stages), 0, "expecting more than zero stages in the pipeline")
+}
+
+func TestListChangesSince(t *testing.T) {
+	c := newLiveClient(t)
+
+	ctx := context.Background()
+	since := time.Now().UTC().Add(-time.Minute)
+
+	contact, err := c.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	params, err := connectors.NewChangesParams(&since, 100, nil, []model.ChangeObjectType{model.ChangeObjectTypeContact})
+	assert.Nil(t, err, "expecting nil error")
+
+	changeConnection, err := c.ListChanges(ctx, params)
+	assert.Nil(t, err, "expecting nil error")
+
+	created := false
+	for _, edge := range changeConnection.Edges {
+		if edge.Node.ObjectID == contact.ID && edge.Node.ChangeType == model.ChangeTypeCreated {
+			created = true
+		}
+	}
+	assert.True(t, created, "expecting the created contact in the changes")
+
+	c.DeleteContact(ctx, contact.ID)
+}
+
+func TestPage(t *testing.T) {
+	after := pdCursor(20)
+	start, limit, err := pdPage(&connectors.ListParams{First: 10, After: &after})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 21, start, "expecting the page to start after the after record")
+	assert.Equal(t, 11, limit, "expecting one more record to be read")
+
+	last := 10
+	before := pdCursor(5)
+	start, limit, err = pdPage(&connectors.ListParams{Last: &last, Before: &before})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 0, start, "expecting the page to start at the first record")
+	assert.Equal(t, 5, limit, "expecting the records before the cursor to be read")
+
+	_, _, err = pdPage(&connectors.ListParams{Last: &last})
+	assert.NotNil(t, err, "expecting an error for the last page without a cursor")
+}
+
+func TestListQuery(t *testing.T) {
+	ownerId := "7"
+	stageName := "3"
+	direction := model.SortDirectionDesc
+
+	query, err := pdListQuery(&connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			OwnerID:   &model.IDFilter{Eq: &ownerId},
+			StageName: &model.StringFilter{In: []string{stageName}},
+		}),
+		OrderBy: []*model.SortInput{{Field: "updatedAt", Direction: &direction}},
+	}, pdDealFilterParams, pdDealSortFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, url.Values{
+		"user_id":  []string{ownerId},
+		"stage_id": []string{stageName},
+		"sort":     []string{"update_time DESC, id ASC"},
+	}, query, "expecting the filter and the sort in the query")
+
+	_, err = pdListQuery(&connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			Or: []*model.OpportunityFilter{
+				{OwnerID: &model.IDFilter{Eq: &ownerId}},
+				{StageName: &model.StringFilter{Eq: &stageName}},
+			},
+		}),
+	}, pdDealFilterParams, pdDealSortFields)
+	assert.NotNil(t, err, "expecting an error for the alternatives")
+
+	name := "Acme"
+	_, err = pdListQuery(&connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			Name: &model.StringFilter{Contains: &name},
+		}),
+	}, pdDealFilterParams, pdDealSortFields)
+	assert.NotNil(t, err, "expecting an error for a field that can't be filtered by")
+}
+
+func TestUnmarshalReference(t *testing.T) {
+	pdDeal := PDDeal{}
+	err := json.Unmarshal([]byte(`{"id": 1, "org_id": {"name": "Acme", "value": 5}, "person_id": 8, "user_id": null}`), &pdDeal)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, &PDReference{ID: 5, Name: "Acme"}, pdDeal.OrgID, "expecting the ID and the name of the related object")
+	assert.Equal(t, &PDReference{ID: 8}, pdDeal.PersonID, "expecting the ID of the related object")
+	assert.Nil(t, pdDeal.UserID, "expecting no related object")
+
+	opportunity := pdDeal.mapOpportunityProperties()
+	assert.Equal(t, "5", opportunity.Company.ID, "expecting the organization of the deal")
+	assert.Nil(t, opportunity.Owner, "expecting no owner of the deal")
+}
+
+func TestMapCustomFields(t *testing.T) {
+	pdDeal := PDDeal{}
+	err := json.Unmarshal([]byte(`{"id": 1, "title": "Big deal", "dcf558aac1ae4e8c4f849ba5e668430d8df9be12": 42}`), &pdDeal)
+	assert.Nil(t, err, "expecting nil error")
+
+	client := Client{FieldMapping: connectors.FieldMapping{
+		model.CrmObjectOpportunity: {"score": "dcf558aac1ae4e8c4f849ba5e668430d8df9be12", "region": "unknown_field"},
+	}}
+	opportunity := client.mapOpportunity(&pdDeal)
+	assert.Equal(t, "Big deal", opportunity.Name, "expecting the fields of the deal")
+	assert.Equal(t, map[string]interface{}{"score": float64(42), "region": nil}, opportunity.CustomFields, "expecting the mapped fields by their keys")
+
+	assert.Nil(t, (&Client{}).mapOpportunity(&pdDeal).CustomFields, "expecting no custom fields without a field mapping")
+}
+
+func TestMapActivity(t *testing.T) {
+	dueDate := "2022-03-01"
+	dueTime := "10:30"
+	duration := "01:15"
+
+	activity := PDActivity{ID: 1, Type: "meeting", DueDate: &dueDate, DueTime: &dueTime, Duration: &duration}.mapActivityProperties()
+	assert.Equal(t, model.ActivityTypeMeeting, activity.Type, "expecting a meeting")
+	assert.Equal(t, time.Date(2022, 3, 1, 10, 30, 0, 0, time.UTC), *activity.StartTime, "expecting the due time to be the start time")
+	assert.Equal(t, time.Date(2022, 3, 1, 11, 45, 0, 0, time.UTC), *activity.EndTime, "expecting the end time after the duration")
+
+	assert.Nil(t, PDActivity{ID: 2, Type: PD_ACTIVITY_TYPE_TASK}.mapActivityProperties(), "expecting tasks not to be activities")
+}
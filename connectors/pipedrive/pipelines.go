@@ -0,0 +1,78 @@
+package pipedrive
+
+import (
+	"blendbase/graph/model"
+	"context"
+)
+
+// https://developers.pipedrive.com/docs/api/v1/Pipelines
+type PDPipeline struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	OrderNr int    `json:"order_nr"`
+	Active  bool   `json:"active"`
+}
+
+// https://developers.pipedrive.com/docs/api/v1/Stages
+type PDStage struct {
+	ID              int      `json:"id"`
+	Name            string   `json:"name"`
+	OrderNr         int      `json:"order_nr"`
+	PipelineID      int      `json:"pipeline_id"`
+	DealProbability *float64 `json:"deal_probability"` // percents
+	ActiveFlag      bool     `json:"active_flag"`
+}
+
+func (client *Client) ListPipelines(ctx context.Context) ([]*model.Pipeline, error) {
+	pdPipelines := []PDPipeline{}
+	if err := client.listAll(ctx, "pipelines", nil, &pdPipelines); err != nil {
+		return nil, err
+	}
+
+	pdStages := []PDStage{}
+	if err := client.listAll(ctx, "stages", nil, &pdStages); err != nil {
+		return nil, err
+	}
+
+	pipelines := []*model.Pipeline{}
+	for i := range pdPipelines {
+		if pdPipelines[i].Active {
+			pipelines = append(pipelines, pdPipelines[i].mapPipelineProperties(pdStages))
+		}
+	}
+
+	return pipelines, nil
+}
+
+func (pdPipeline *PDPipeline) mapPipelineProperties(pdStages []PDStage) *model.Pipeline {
+	pipeline := model.Pipeline{
+		ID:           formatPDID(pdPipeline.ID),
+		Label:        pdPipeline.Name,
+		DisplayOrder: &pdPipeline.OrderNr,
+		Stages:       []*model.PipelineStage{},
+	}
+
+	for i := range pdStages {
+		if pdStages[i].PipelineID == pdPipeline.ID && pdStages[i].ActiveFlag {
+			pipeline.Stages = append(pipeline.Stages, pdStages[i].mapPipelineStageProperties())
+		}
+	}
+
+	return &pipeline
+}
+
+// Pipedrive deals are won or lost by their status, not by their stage, so none of the stages is closed
+func (pdStage *PDStage) mapPipelineStageProperties() *model.PipelineStage {
+	stage := model.PipelineStage{
+		ID:           formatPDID(pdStage.ID),
+		Label:        pdStage.Name,
+		DisplayOrder: &pdStage.OrderNr,
+	}
+
+	if pdStage.DealProbability != nil {
+		probability := *pdStage.DealProbability / 100
+		stage.Probability = &probability
+	}
+
+	return &stage
+}
@@ -0,0 +1,38 @@
+package pipedrive
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+)
+
+func init() {
+	connectors.Register(connectors.Registration{
+		Connector: connectors.Connector{
+			ServiceCode: connectors.CONNECTOR_CRM_PIPEDRIVE,
+			Type:        connectors.CONNECTOR_TYPE_CRM,
+			Name:        "Pipedrive",
+			Description: "Pipedrive is a sales CRM and pipeline management tool built for small sales teams.",
+			AuthType:    connectors.AUTH_TYPE_SECRET,
+		},
+		Position: 3,
+		NewCrmConnector: func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping connectors.FieldMapping) connectors.CrmConnector {
+			client := PipedriveClient(integration.Secret.Raw)
+			client.FieldMapping = fieldMapping
+			return client
+		},
+		Capabilities: []*model.ObjectCapabilities{
+			connectors.ObjectCapabilities(model.CrmObjectContact, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "companyName", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectOpportunity, "id", "createdAt", "updatedAt", "name", "amount", "stageName", "pipelineId", "closeDate", "stage", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectCompany, "id", "createdAt", "updatedAt", "archived", "name", "city", "country", "owner"),
+			connectors.ObjectCapabilities(model.CrmObjectLead, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "companyName", "title", "status", "converted"),
+			connectors.ObjectCapabilities(model.CrmObjectUser, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "active"),
+			connectors.ObjectCapabilities(model.CrmObjectNote, "id", "createdAt", "updatedAt", "content"),
+			connectors.ObjectCapabilities(model.CrmObjectTask, "id", "createdAt", "updatedAt", "subject", "description", "status", "dueDate"),
+			connectors.ObjectCapabilities(model.CrmObjectActivity, "id", "createdAt", "updatedAt", "type", "subject", "description", "startTime", "endTime"),
+			connectors.ObjectCapabilities(model.CrmObjectPipeline, connectors.PipelineFields...),
+			connectors.ObjectCapabilities(model.CrmObjectChange, connectors.ChangeFields...),
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package pipedrive
+
+import (
+	"blendbase/graph/model"
+	"context"
+)
+
+// Tasks are activities of the "task" type. Pipedrive activities are either done or not,
+// so the other statuses are written as not done, and they have no priority.
+
+func (client *Client) ListContactTasks(ctx context.Context, contactId string) ([]*model.Task, error) {
+	return client.listTasks(ctx, "persons", contactId)
+}
+
+func (client *Client) CreateContactTask(ctx context.Context, contactId string, input *model.TaskInput) (*model.Task, error) {
+	personId, err := parsePDID(contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := createPDTaskPayload(input)
+	payload.PersonID = &personId
+
+	return client.createTask(ctx, payload)
+}
+
+func (client *Client) ListOpportunityTasks(ctx context.Context, opportunityId string) ([]*model.Task, error) {
+	return client.listTasks(ctx, "deals", opportunityId)
+}
+
+func (client *Client) CreateOpportunityTask(ctx context.Context, opportunityId string, input *model.TaskInput) (*model.Task, error) {
+	dealId, err := parsePDID(opportunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := createPDTaskPayload(input)
+	payload.DealID = &dealId
+
+	return client.createTask(ctx, payload)
+}
+
+func (client *Client) UpdateTask(ctx context.Context, taskId string, input *model.TaskInput) (bool, error) {
+	payload := createPDTaskPayload(input)
+
+	if err := client.update(ctx, "activities", taskId, payload, &PDActivity{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) listTasks(ctx context.Context, objectPath string, objectId string) ([]*model.Task, error) {
+	pdActivities, err := client.listObjectActivities(ctx, objectPath, objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*model.Task{}
+	for _, pdActivity := range pdActivities {
+		if pdActivity.Type == PD_ACTIVITY_TYPE_TASK {
+			tasks = append(tasks, pdActivity.mapTaskProperties())
+		}
+	}
+
+	return tasks, nil
+}
+
+func (client *Client) createTask(ctx context.Context, payload *PDActivityCreateUpdatePayload) (*model.Task, error) {
+	pdActivity := PDActivity{}
+	if err := client.create(ctx, "activities", payload, &pdActivity); err != nil {
+		return nil, err
+	}
+
+	return pdActivity.mapTaskProperties(), nil
+}
+
+// Creates Pipedrive Activity Update/Create payload for a task from GraphQL input
+func createPDTaskPayload(input *model.TaskInput) *PDActivityCreateUpdatePayload {
+	payload := PDActivityCreateUpdatePayload{
+		Type:    PD_ACTIVITY_TYPE_TASK,
+		Subject: input.Subject,
+		Note:    input.Description,
+	}
+
+	if input.Status != nil {
+		done := 0
+		if *input.Status == model.TaskStatusCompleted {
+			done = 1
+		}
+		payload.Done = &done
+	}
+
+	if input.DueDate != nil {
+		dueDate := input.DueDate.UTC().Format(PD_DATE_FORMAT)
+		dueTime := input.DueDate.UTC().Format(PD_DUE_TIME_FORMAT)
+		payload.DueDate = &dueDate
+		payload.DueTime = &dueTime
+	}
+
+	return &payload
+}
+
+func (pdActivity PDActivity) mapTaskProperties() *model.Task {
+	status := model.TaskStatusNotStarted
+	if pdActivity.Done {
+		status = model.TaskStatusCompleted
+	}
+
+	return &model.Task{
+		ID:          formatPDID(pdActivity.ID),
+		Subject:     pdActivity.Subject,
+		Description: pdActivity.Note,
+		Status:      &status,
+		DueDate:     pdActivity.dueTime(),
+		CreatedAt:   parsePDDateTime(pdActivity.AddTime),
+		UpdatedAt:   parsePDDateTime(pdActivity.UpdateTime),
+	}
+}
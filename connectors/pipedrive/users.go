@@ -0,0 +1,100 @@
+package pipedrive
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strings"
+)
+
+// Users of the company, they own the persons, deals and organizations
+// https://developers.pipedrive.com/docs/api/v1/Users
+type PDUser struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Email      string  `json:"email"`
+	ActiveFlag bool    `json:"active_flag"`
+	Created    *string `json:"created"`
+	Modified   *string `json:"modified"`
+}
+
+// Users aren't paginated, so the whole list is sorted in memory and the page is sliced from it
+// using the offset cursors. Unlike the other lists, the users are counted.
+func (client *Client) ListUsers(ctx context.Context, params *connectors.ListParams) (*model.UserConnection, error) {
+	pdUsers := []PDUser{}
+	if err := client.listAll(ctx, "users", nil, &pdUsers); err != nil {
+		return nil, err
+	}
+
+	users := make([]*model.User, len(pdUsers))
+	for i, pdUser := range pdUsers {
+		users[i] = pdUser.mapUserProperties()
+	}
+	if err := connectors.SortUsers(users, params.OrderBy); err != nil {
+		return nil, err
+	}
+
+	offset, limit, err := pdPage(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > len(users) {
+		offset = len(users)
+	}
+	if offset+limit > len(users) {
+		limit = len(users) - offset
+	}
+
+	userEdges := make([]*model.UserEdge, limit)
+	for i, user := range users[offset : offset+limit] {
+		userEdges[i] = &model.UserEdge{
+			Node:   user,
+			Cursor: pdCursor(offset + i),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &userEdges)
+
+	connection := model.UserConnection{
+		Edges:    recordsValue.Interface().([]*model.UserEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount := len(users)
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetUser(ctx context.Context, userId string) (*model.User, error) {
+	pdUser := PDUser{}
+	if err := client.get(ctx, "users", userId, &pdUser); err != nil {
+		return nil, err
+	}
+
+	return pdUser.mapUserProperties(), nil
+}
+
+// Users only have the full name, it's split at the first space
+func (pdUser PDUser) mapUserProperties() *model.User {
+	firstName, lastName := pdUser.Name, ""
+	if i := strings.Index(pdUser.Name, " "); i >= 0 {
+		firstName, lastName = pdUser.Name[:i], pdUser.Name[i+1:]
+	}
+	archived := !pdUser.ActiveFlag
+
+	return &model.User{
+		ID:        formatPDID(pdUser.ID),
+		Name:      &pdUser.Name,
+		FirstName: &firstName,
+		LastName:  &lastName,
+		Email:     &pdUser.Email,
+		Active:    &pdUser.ActiveFlag,
+		Archived:  &archived,
+		CreatedAt: parsePDDateTime(pdUser.Created),
+		UpdatedAt: parsePDDateTime(pdUser.Modified),
+	}
+}
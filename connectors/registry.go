@@ -0,0 +1,123 @@
+package connectors
+
+import (
+	"blendbase/config"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Builds the client of a consumer integration, the OAuth2 configuration is nil for the connectors without OAuth2,
+// the field mapping selects the custom fields of the records
+type CrmConnectorFactory func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping FieldMapping) CrmConnector
+
+// OAuth2 flow of a connector, the shared login and callback handlers are mounted at
+// /connect/{consumerID}/integrations/{serviceCode}/oauth2, see HandleOAuth2Login and HandleOAuth2Callback
+type OAuth2Flow struct {
+	// Endpoints, scopes and client credentials of the OAuth2 configuration of an integration
+	Config func(oauthConfig *integrations.ConsumerOauth2Configuration) *oauth2.Config
+
+	// Parameters of the authorization URL, e.g. oauth2.AccessTypeOffline (optional)
+	AuthCodeOptions []oauth2.AuthCodeOption
+
+	// Whether the CRM supports PKCE (RFC 7636), the authorization is then bound to a code verifier
+	PKCE bool
+
+	// Lifetime of the access tokens when the CRM doesn't return their expiration, e.g. the session timeout of Salesforce (optional)
+	TokenLifetime time.Duration
+
+	// Token type of the Authorization header when the CRM doesn't accept the one of the token, e.g. "Zoho-oauthtoken" (optional)
+	TokenType string
+
+	// Reads the callback request before the code is exchanged, e.g. the data center of the account
+	// stored in the custom settings the token endpoint is selected by (optional)
+	PrepareCallback func(r *http.Request, oauthConfig *integrations.ConsumerOauth2Configuration) error
+
+	// Stores what the CRM returns with the token in the custom settings, e.g. the API domain of the account (optional)
+	OnToken func(token *oauth2.Token, oauthConfig *integrations.ConsumerOauth2Configuration) error
+
+	// Validates the connector specific settings of the OAuth2 configuration and returns the custom settings to store,
+	// optional for the connectors that only need the client credentials
+	CustomSettings func(input *model.OAuth2ConfigurationInput) (*integrations.ConsumerOauth2ConfigurationCustomSettings, error)
+}
+
+// Connector as it's registered by its package, e.g.
+//
+//	func init() {
+//		connectors.Register(connectors.Registration{
+//			Connector:       connectors.Connector{ServiceCode: "crm_acme", ...},
+//			NewCrmConnector: func(...) connectors.CrmConnector { ... },
+//		})
+//	}
+//
+// The packages of the connectors are loaded by importing blendbase/connectors/all.
+type Registration struct {
+	Connector
+	Position        int // the connectors are listed by their positions, then by their names
+	NewCrmConnector CrmConnectorFactory
+	OAuth2          *OAuth2Flow                 // required for the connectors with the oauth2 auth type
+	Capabilities    []*model.ObjectCapabilities // objects the connector supports, see ObjectCapabilities
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]*Registration{}
+)
+
+// Registers a connector, it panics when the registration is incomplete or the service code is taken
+func Register(registration Registration) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if registration.ServiceCode == "" {
+		panic("connectors: Register called without a service code")
+	}
+	if registration.NewCrmConnector == nil {
+		panic(fmt.Sprintf("connectors: Register called without a constructor for %s", registration.ServiceCode))
+	}
+	if registration.SupportsAuthType(AUTH_TYPE_OAUTH2) && registration.OAuth2 == nil {
+		panic(fmt.Sprintf("connectors: Register called without the OAuth2 flow for %s", registration.ServiceCode))
+	}
+	if registration.OAuth2 != nil && registration.OAuth2.Config == nil {
+		panic(fmt.Sprintf("connectors: Register called without the OAuth2 configuration for %s", registration.ServiceCode))
+	}
+	if _, registered := registry[registration.ServiceCode]; registered {
+		panic(fmt.Sprintf("connectors: Register called twice for %s", registration.ServiceCode))
+	}
+
+	registry[registration.ServiceCode] = &registration
+}
+
+// Returns the registration of the connector with the service code or nil when there's none
+func Lookup(serviceCode string) *Registration {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	return registry[serviceCode]
+}
+
+// Returns the registered connectors in the order they're listed to the consumers
+func Registrations() []*Registration {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	registrations := make([]*Registration, 0, len(registry))
+	for _, registration := range registry {
+		registrations = append(registrations, registration)
+	}
+
+	sort.Slice(registrations, func(i, j int) bool {
+		if registrations[i].Position != registrations[j].Position {
+			return registrations[i].Position < registrations[j].Position
+		}
+		return registrations[i].Name < registrations[j].Name
+	})
+
+	return registrations
+}
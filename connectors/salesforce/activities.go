@@ -0,0 +1,216 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	EVENT_OBJECT = "Event"
+
+	SF_DEFAULT_MEETING_DURATION = 30 * time.Minute
+)
+
+// Calls and emails are stored as tasks of the corresponding subtype, meetings are stored as events
+var sfActivityTaskSubtypes = map[model.ActivityType]string{
+	model.ActivityTypeCall:  SF_TASK_SUBTYPE_CALL,
+	model.ActivityTypeEmail: SF_TASK_SUBTYPE_EMAIL,
+}
+
+type SFEventsListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFEvent `json:"records"`
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_event.htm
+type SFEvent struct {
+	ID            string  `json:"Id"`
+	Subject       string  `json:"Subject"`
+	Description   string  `json:"Description"`
+	StartDateTime *string `json:"StartDateTime"`
+	EndDateTime   *string `json:"EndDateTime"`
+	WhoId         *string `json:"WhoId"`
+	WhatId        *string `json:"WhatId"`
+
+	IsDeleted        bool   `json:"IsDeleted"`
+	CreatedDate      string `json:"CreatedDate"`
+	LastModifiedDate string `json:"LastModifiedDate"`
+}
+
+type SFEventCreateUpdatePayload struct {
+	Subject       *string `json:"Subject,omitempty"`
+	Description   *string `json:"Description,omitempty"`
+	StartDateTime string  `json:"StartDateTime"`
+	EndDateTime   string  `json:"EndDateTime"`
+	WhoId         *string `json:"WhoId,omitempty"`
+	WhatId        *string `json:"WhatId,omitempty"`
+}
+
+func (client *Client) ListContactActivities(ctx context.Context, contactId string) ([]*model.Activity, error) {
+	return client.listActivities("WhoId", contactId)
+}
+
+func (client *Client) CreateContactActivity(ctx context.Context, contactId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivity(&contactId, nil, input)
+}
+
+func (client *Client) ListOpportunityActivities(ctx context.Context, opportunityId string) ([]*model.Activity, error) {
+	return client.listActivities("WhatId", opportunityId)
+}
+
+func (client *Client) CreateOpportunityActivity(ctx context.Context, opportunityId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivity(nil, &opportunityId, input)
+}
+
+func (client *Client) listActivities(parentField string, parentId string) ([]*model.Activity, error) {
+	tasksResponse := SFTasksListSuccessResponse{}
+	err := client.listWithWhere(TASK_OBJECT, connectors.StructFieldNames(SFTask{}),
+		fmt.Sprintf("%s = '%s' AND TaskSubtype IN ('%s', '%s')",
+			parentField, escapeSOQL(parentId), SF_TASK_SUBTYPE_CALL, SF_TASK_SUBTYPE_EMAIL), &tasksResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsResponse := SFEventsListSuccessResponse{}
+	err = client.listWithWhere(EVENT_OBJECT, connectors.StructFieldNames(SFEvent{}),
+		fmt.Sprintf("%s = '%s'", parentField, escapeSOQL(parentId)), &eventsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]*model.Activity, 0, len(tasksResponse.Records)+len(eventsResponse.Records))
+	for i := range tasksResponse.Records {
+		activities = append(activities, tasksResponse.Records[i].mapActivityProperties())
+	}
+	for i := range eventsResponse.Records {
+		activities = append(activities, eventsResponse.Records[i].mapActivityProperties())
+	}
+
+	connectors.SortActivities(activities)
+
+	return activities, nil
+}
+
+// Activities are linked to contacts with the WhoId field and to opportunities with the WhatId field
+func (client *Client) createActivity(whoId *string, whatId *string, input *model.ActivityInput) (*model.Activity, error) {
+	startTime := time.Now().UTC()
+	if input.StartTime != nil {
+		startTime = *input.StartTime
+	}
+
+	if input.Type == model.ActivityTypeMeeting {
+		return client.createEvent(whoId, whatId, startTime, input)
+	}
+
+	subtype := sfActivityTaskSubtypes[input.Type]
+	status := sfTaskStatuses[model.TaskStatusCompleted]
+	activityDate := startTime.Format(SF_DATE_FORMAT)
+
+	payload := SFTaskCreateUpdatePayload{
+		Subject:      subtype,
+		Description:  input.Description,
+		Status:       &status,
+		ActivityDate: &activityDate,
+		TaskSubtype:  &subtype,
+		WhoId:        whoId,
+		WhatId:       whatId,
+	}
+	if input.Subject != nil {
+		payload.Subject = *input.Subject
+	}
+	if input.Type == model.ActivityTypeCall && input.EndTime != nil {
+		callDuration := int(input.EndTime.Sub(startTime).Seconds())
+		payload.CallDurationInSeconds = &callDuration
+	}
+
+	objectId, err := client.create(TASK_OBJECT, &payload)
+	if err != nil {
+		log.Errorf("Error creating %s task: %s", subtype, err)
+		return nil, err
+	}
+
+	// Fetch all the fields after the creation
+	sfTask := SFTask{}
+	err = client.get(TASK_OBJECT, objectId, connectors.StructFieldNames(sfTask), &sfTask)
+	if err != nil {
+		log.Errorf("Error fetching task #%s after creation with :%s", objectId, err)
+		return nil, err
+	}
+
+	return sfTask.mapActivityProperties(), nil
+}
+
+func (client *Client) createEvent(whoId *string, whatId *string, startTime time.Time, input *model.ActivityInput) (*model.Activity, error) {
+	// Salesforce requires either the end time or the duration of an event
+	endTime := startTime.Add(SF_DEFAULT_MEETING_DURATION)
+	if input.EndTime != nil {
+		endTime = *input.EndTime
+	}
+
+	payload := SFEventCreateUpdatePayload{
+		Subject:       input.Subject,
+		Description:   input.Description,
+		StartDateTime: formatSFDateTime(startTime.UTC()),
+		EndDateTime:   formatSFDateTime(endTime.UTC()),
+		WhoId:         whoId,
+		WhatId:        whatId,
+	}
+
+	objectId, err := client.create(EVENT_OBJECT, &payload)
+	if err != nil {
+		log.Errorf("Error creating event: %s", err)
+		return nil, err
+	}
+
+	// Fetch all the fields after the creation
+	sfEvent := SFEvent{}
+	err = client.get(EVENT_OBJECT, objectId, connectors.StructFieldNames(sfEvent), &sfEvent)
+	if err != nil {
+		log.Errorf("Error fetching event #%s after creation with :%s", objectId, err)
+		return nil, err
+	}
+
+	return sfEvent.mapActivityProperties(), nil
+}
+
+func (sfTask *SFTask) mapActivityProperties() *model.Activity {
+	activityType := model.ActivityTypeCall
+	if sfTask.TaskSubtype == SF_TASK_SUBTYPE_EMAIL {
+		activityType = model.ActivityTypeEmail
+	}
+
+	activity := model.Activity{
+		ID:          sfTask.ID,
+		Type:        activityType,
+		Subject:     &sfTask.Subject,
+		Description: &sfTask.Description,
+		StartTime:   parseSFDate(sfTask.ActivityDate),
+		CreatedAt:   parseSFDateTime(&sfTask.CreatedDate),
+		UpdatedAt:   parseSFDateTime(&sfTask.LastModifiedDate),
+	}
+
+	if activity.StartTime != nil && sfTask.CallDurationInSeconds != nil {
+		endTime := activity.StartTime.Add(time.Duration(*sfTask.CallDurationInSeconds) * time.Second)
+		activity.EndTime = &endTime
+	}
+
+	return &activity
+}
+
+func (sfEvent *SFEvent) mapActivityProperties() *model.Activity {
+	return &model.Activity{
+		ID:          sfEvent.ID,
+		Type:        model.ActivityTypeMeeting,
+		Subject:     &sfEvent.Subject,
+		Description: &sfEvent.Description,
+		StartTime:   parseSFDateTime(sfEvent.StartDateTime),
+		EndTime:     parseSFDateTime(sfEvent.EndDateTime),
+		CreatedAt:   parseSFDateTime(&sfEvent.CreatedDate),
+		UpdatedAt:   parseSFDateTime(&sfEvent.LastModifiedDate),
+	}
+}
@@ -0,0 +1,89 @@
+package salesforce
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	OPPORTUNITY_CONTACT_ROLE_OBJECT = "OpportunityContactRole"
+)
+
+// Contacts and opportunities are linked to an account through their AccountId lookup field
+func (client *Client) LinkContactToCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	return client.update(CONTACT_OBJECT, contactId, map[string]interface{}{"AccountId": companyId})
+}
+
+func (client *Client) UnlinkContactFromCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	return client.unlinkAccount(CONTACT_OBJECT, contactId, companyId)
+}
+
+func (client *Client) LinkOpportunityToCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	return client.update(OPPORTUNITY_OBJECT, opportunityId, map[string]interface{}{"AccountId": companyId})
+}
+
+func (client *Client) UnlinkOpportunityFromCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	return client.unlinkAccount(OPPORTUNITY_OBJECT, opportunityId, companyId)
+}
+
+func (client *Client) ListCompanyContacts(ctx context.Context, companyId string) ([]*model.Contact, error) {
+	return client.listContactsWhere(fmt.Sprintf("AccountId = '%s'", escapeSOQL(companyId)))
+}
+
+func (client *Client) ListCompanyOpportunities(ctx context.Context, companyId string) ([]*model.Opportunity, error) {
+	response := SFOpportunityListSuccessResponse{}
+	err := client.listWithWhere(OPPORTUNITY_OBJECT, client.sfOpportunityQueryFields(),
+		fmt.Sprintf("AccountId = '%s'", escapeSOQL(companyId)), &response)
+
+	if err != nil {
+		log.Errorf("Error listing opportunities of account #%s: %s", companyId, err)
+		return nil, err
+	}
+
+	opportunities := make([]*model.Opportunity, len(response.Records))
+	for i := range response.Records {
+		opportunities[i] = client.mapOpportunity(&response.Records[i])
+	}
+
+	return opportunities, nil
+}
+
+// Contacts are linked to opportunities through OpportunityContactRole junction records
+func (client *Client) ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error) {
+	return client.listContactsWhere(fmt.Sprintf("Id IN (SELECT ContactId FROM %s WHERE OpportunityId = '%s')",
+		OPPORTUNITY_CONTACT_ROLE_OBJECT, escapeSOQL(opportunityId)))
+}
+
+func (client *Client) listContactsWhere(whereFilter string) ([]*model.Contact, error) {
+	response := SFContactsListSuccessResponse{}
+	if err := client.listWithWhere(CONTACT_OBJECT, client.sfContactQueryFields(), whereFilter, &response); err != nil {
+		log.Errorf("Error listing contacts: %s", err)
+		return nil, err
+	}
+
+	contacts := make([]*model.Contact, len(response.Records))
+	for i := range response.Records {
+		contacts[i] = client.mapContact(&response.Records[i])
+	}
+
+	return contacts, nil
+}
+
+// Clears the AccountId lookup of the object if it points to the given account
+func (client *Client) unlinkAccount(objectName string, objectId string, accountId string) (bool, error) {
+	response := struct {
+		AccountId string `json:"AccountId"`
+	}{}
+	if err := client.get(objectName, objectId, []string{"AccountId"}, &response); err != nil {
+		return false, err
+	}
+
+	if !sameSFID(response.AccountId, accountId) {
+		return false, fmt.Errorf("%s #%s is not linked to account #%s", objectName, objectId, accountId)
+	}
+
+	return client.update(objectName, objectId, map[string]interface{}{"AccountId": nil})
+}
@@ -0,0 +1,183 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_getdeleted.htm
+type SFDeletedRecordsResponse struct {
+	DeletedRecords []struct {
+		ID          string `json:"id"`
+		DeletedDate string `json:"deletedDate"`
+	} `json:"deletedRecords"`
+}
+
+// getDeleted rejects the date ranges starting earlier than that
+const SF_DELETED_RECORDS_WINDOW = 30 * 24 * time.Hour
+
+var sfLastModifiedDateField = sfFilterField{Name: "LastModifiedDate", Type: SF_FIELD_TYPE_DATETIME}
+
+// Updated records are read by LastModifiedDate, deleted records with the getDeleted resource.
+// getDeleted only covers the last 30 days, the deletions of a feed starting earlier than that are listed from then on.
+func (client *Client) ListChanges(ctx context.Context, params *connectors.ChangesParams) (*model.ChangeConnection, error) {
+	batches := []*connectors.ChangeBatch{}
+	sources := []struct {
+		objectType model.ChangeObjectType
+		objectName string
+		list       func(*connectors.ChangesParams) (*connectors.ChangeBatch, error)
+	}{
+		{model.ChangeObjectTypeContact, CONTACT_OBJECT, client.listContactChanges},
+		{model.ChangeObjectTypeNote, NOTE_OBJECT, client.listNoteChanges},
+		{model.ChangeObjectTypeOpportunity, OPPORTUNITY_OBJECT, client.listOpportunityChanges},
+	}
+
+	for _, source := range sources {
+		if !params.Includes(source.objectType) {
+			continue
+		}
+
+		batch, err := source.list(params)
+		if err != nil {
+			log.Errorf("Error listing changes of %s: %s", source.objectName, err)
+			return nil, err
+		}
+
+		deletedBatch, err := client.listDeletedChanges(source.objectName, source.objectType, params)
+		if err != nil {
+			log.Errorf("Error listing deleted %s: %s", source.objectName, err)
+			return nil, err
+		}
+
+		batches = append(batches, batch, deletedBatch)
+	}
+
+	return connectors.NewChangeConnection(params, batches), nil
+}
+
+func (client *Client) listContactChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	response := SFContactsListSuccessResponse{}
+	if err := client.listChanged(CONTACT_OBJECT, client.sfContactQueryFields(), model.ChangeObjectTypeContact, params, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Records))
+	for i := range response.Records {
+		contact := client.mapContact(&response.Records[i])
+		changes[i] = params.NewChange(model.ChangeObjectTypeContact, contact.ID, contact.CreatedAt, contact.UpdatedAt)
+		changes[i].Contact = contact
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+func (client *Client) listNoteChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	response := SFNotesListSuccessResponse{}
+	if err := client.listChanged(NOTE_OBJECT, connectors.StructFieldNames(SFNote{}), model.ChangeObjectTypeNote, params, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Records))
+	for i := range response.Records {
+		note := response.Records[i].mapNoteProperties()
+		changes[i] = params.NewChange(model.ChangeObjectTypeNote, note.ID, note.CreatedAt, note.UpdatedAt)
+		changes[i].Note = note
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+func (client *Client) listOpportunityChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	response := SFOpportunityListSuccessResponse{}
+	if err := client.listChanged(OPPORTUNITY_OBJECT, client.sfOpportunityQueryFields(), model.ChangeObjectTypeOpportunity, params, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.Records))
+	for i := range response.Records {
+		opportunity := client.mapOpportunity(&response.Records[i])
+		changes[i] = params.NewChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.CreatedAt, opportunity.UpdatedAt)
+		changes[i].Opportunity = opportunity
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: len(changes) > params.First}, nil
+}
+
+// Lists the records modified after the bound of the object type in the order of the feed
+func (client *Client) listChanged(objectName string, fields []string, objectType model.ChangeObjectType, params *connectors.ChangesParams, response interface{}) error {
+	sorts := []sfSort{{Field: sfLastModifiedDateField}}
+
+	return client.list(
+		objectName,
+		fields,
+		&connectors.ListParams{First: params.First},
+		sfChangesCondition(params.Bound(objectType)),
+		sorts,
+		response,
+	)
+}
+
+func sfChangesCondition(bound connectors.ChangeBound) string {
+	switch {
+	case bound.After != nil:
+		value := formatSFDateTime(bound.Time.UTC())
+		return sfKeysetCondition([]sfSort{{Field: sfLastModifiedDateField}}, []*string{&value}, *bound.After, false)
+	case bound.Exclusive:
+		return fmt.Sprintf("LastModifiedDate > %s", formatSOQLValue(sfLastModifiedDateField, bound.Time))
+	}
+
+	return fmt.Sprintf("LastModifiedDate >= %s", formatSOQLValue(sfLastModifiedDateField, bound.Time))
+}
+
+// Salesforce returns all the records deleted in the date range, the range has a minute precision
+func (client *Client) listDeletedChanges(objectName string, objectType model.ChangeObjectType, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	start, end := sfDeletedRange(params.Bound(objectType).Time, time.Now())
+
+	query := url.Values{}
+	query.Set("start", start.Format(time.RFC3339))
+	query.Set("end", end.Format(time.RFC3339))
+
+	url := fmt.Sprintf("%s/sobjects/%s/deleted/?%s", client.baseUrl(), objectName, query.Encode())
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SFDeletedRecordsResponse{}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(response.DeletedRecords))
+	for i, deletedRecord := range response.DeletedRecords {
+		changes[i] = &model.Change{
+			ObjectType: objectType,
+			ObjectID:   deletedRecord.ID,
+			ChangeType: model.ChangeTypeDeleted,
+			ChangedAt:  *parseSFDateTime(&deletedRecord.DeletedDate),
+		}
+	}
+
+	return &connectors.ChangeBatch{Changes: changes}, nil
+}
+
+// Date range of getDeleted from the bound time until now, with a minute precision. The range starts within
+// the window of getDeleted so that an older bound doesn't fail the whole feed.
+func sfDeletedRange(since time.Time, now time.Time) (time.Time, time.Time) {
+	start := since.UTC().Truncate(time.Minute)
+	end := now.UTC().Truncate(time.Minute).Add(time.Minute)
+
+	earliest := now.UTC().Add(-SF_DELETED_RECORDS_WINDOW).Truncate(time.Minute).Add(time.Minute)
+	if start.Before(earliest) {
+		start = earliest
+	}
+
+	return start, end
+}
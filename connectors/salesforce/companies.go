@@ -0,0 +1,187 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ACCOUNT_OBJECT = "Account"
+)
+
+type SFAccountsListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFAccount `json:"records"`
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_account.htm
+type SFAccount struct {
+	ID                string   `json:"Id"`
+	Name              string   `json:"Name"`
+	Website           string   `json:"Website"`
+	Phone             string   `json:"Phone"`
+	Industry          string   `json:"Industry"`
+	Description       string   `json:"Description"`
+	BillingCity       string   `json:"BillingCity"`
+	BillingCountry    string   `json:"BillingCountry"`
+	NumberOfEmployees *int     `json:"NumberOfEmployees"`
+	AnnualRevenue     *float64 `json:"AnnualRevenue"`
+	OwnerId           string   `json:"OwnerId"`
+
+	IsDeleted        bool   `json:"IsDeleted"`
+	CreatedDate      string `json:"CreatedDate"`
+	LastModifiedDate string `json:"LastModifiedDate"`
+}
+
+type SFAccountCreateUpdatePayload struct {
+	Name              string  `json:"Name"`
+	Website           *string `json:"Website,omitempty"`
+	Phone             *string `json:"Phone,omitempty"`
+	Industry          *string `json:"Industry,omitempty"`
+	Description       *string `json:"Description,omitempty"`
+	BillingCity       *string `json:"BillingCity,omitempty"`
+	BillingCountry    *string `json:"BillingCountry,omitempty"`
+	NumberOfEmployees *int    `json:"NumberOfEmployees,omitempty"`
+	AnnualRevenue     *string `json:"AnnualRevenue,omitempty"`
+	OwnerId           *string `json:"OwnerId,omitempty"`
+}
+
+func (client *Client) ListCompanies(ctx context.Context, params *connectors.ListParams) (*model.CompanyConnection, error) {
+	sorts, err := sfSorts(params.OrderBy, sfCompanyFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SFAccountsListSuccessResponse{}
+	err = client.list(
+		ACCOUNT_OBJECT,
+		connectors.StructFieldNames(SFAccount{}),
+		params,
+		"",
+		sorts,
+		&response,
+	)
+
+	if err != nil {
+		log.Errorf("Error listing accounts: %s", err)
+		return nil, err
+	}
+
+	var company *model.Company
+	edges := make([]*model.CompanyEdge, len(response.Records))
+	for i := range response.Records {
+		sfAccount := &response.Records[i]
+		company = sfAccount.mapCompanyProperties()
+		edges[i] = &model.CompanyEdge{
+			Cursor: sfRecordCursor(sfAccount, company.ID, sorts),
+			Node:   company,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &edges)
+
+	return &model.CompanyConnection{
+		Edges:    recordsValue.Interface().([]*model.CompanyEdge),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (client *Client) GetCompany(ctx context.Context, companyId string) (*model.Company, error) {
+	response := SFAccount{}
+	err := client.get(
+		ACCOUNT_OBJECT,
+		companyId,
+		connectors.StructFieldNames(SFAccount{}),
+		&response,
+	)
+
+	if err != nil {
+		log.Errorf("Error getting account: %s", err)
+		return nil, err
+	}
+
+	return response.mapCompanyProperties(), nil
+}
+
+func (client *Client) CreateCompany(ctx context.Context, input *model.CompanyInput) (*model.Company, error) {
+	payload := createSFAccountPayload(input)
+
+	objectId, err := client.create(ACCOUNT_OBJECT, payload)
+	if err != nil {
+		log.Errorf("Error creating account: %s", err)
+		return nil, err
+	}
+
+	// Fetch all the fields after the creation
+	company, err := client.GetCompany(ctx, objectId)
+	if err != nil {
+		log.Errorf("Error fetching account #%s after creation with :%s", objectId, err)
+		return nil, err
+	}
+
+	return company, nil
+}
+
+func (client *Client) UpdateCompany(ctx context.Context, companyId string, input *model.CompanyInput) (bool, error) {
+	payload := createSFAccountPayload(input)
+
+	success, err := client.update(ACCOUNT_OBJECT, companyId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating account #%s: %s", companyId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteCompany(ctx context.Context, companyId string) (bool, error) {
+	return client.delete(ACCOUNT_OBJECT, companyId)
+}
+
+// Creates Salesforce Account Update/Create payload from GraphQL input
+func createSFAccountPayload(input *model.CompanyInput) *SFAccountCreateUpdatePayload {
+	return &SFAccountCreateUpdatePayload{
+		Name:              input.Name,
+		Website:           input.Website,
+		Phone:             input.Phone,
+		Industry:          input.Industry,
+		Description:       input.Description,
+		BillingCity:       input.City,
+		BillingCountry:    input.Country,
+		NumberOfEmployees: input.NumberOfEmployees,
+		AnnualRevenue:     input.AnnualRevenue,
+		OwnerId:           input.OwnerID,
+	}
+}
+
+func (sfAccount *SFAccount) mapCompanyProperties() *model.Company {
+	company := model.Company{
+		ID:                sfAccount.ID,
+		Name:              sfAccount.Name,
+		Website:           &sfAccount.Website,
+		Phone:             &sfAccount.Phone,
+		Industry:          &sfAccount.Industry,
+		Description:       &sfAccount.Description,
+		City:              &sfAccount.BillingCity,
+		Country:           &sfAccount.BillingCountry,
+		NumberOfEmployees: sfAccount.NumberOfEmployees,
+		Archived:          &sfAccount.IsDeleted,
+		CreatedAt:         parseSFDateTime(&sfAccount.CreatedDate),
+		UpdatedAt:         parseSFDateTime(&sfAccount.LastModifiedDate),
+	}
+
+	if sfAccount.AnnualRevenue != nil {
+		annualRevenue := strconv.FormatFloat(*sfAccount.AnnualRevenue, 'f', -1, 64)
+		company.AnnualRevenue = &annualRevenue
+	}
+
+	if sfAccount.OwnerId != "" {
+		company.Owner = &model.User{ID: sfAccount.OwnerId}
+	}
+
+	return &company
+}
@@ -31,16 +31,30 @@ type SFContactBase struct {
 
 	IsDeleted        bool   `json:"IsDeleted"`
 	AccountID        string `json:"AccountId"`
+	OwnerID          string `json:"OwnerId"`
 	CreatedDate      string `json:"CreatedDate"`
 	LastModifiedDate string `json:"LastModifiedDate"`
 }
 
 type SFContact struct {
 	SFContactBase
+	Account    *SFAccountReference `json:"Account"`
 	Attributes struct {
 		Type string `json:"type"`
 		Url  string `json:"url"`
 	} `json:"attributes"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (sfContact *SFContact) UnmarshalJSON(data []byte) error {
+	type plainSFContact SFContact
+	return connectors.UnmarshalWithRawFields(data, (*plainSFContact)(sfContact), &sfContact.RawFields)
+}
+
+// Parent account fields fetched through the Account relationship
+type SFAccountReference struct {
+	Name string `json:"Name"`
 }
 
 type SFContactCreateUpdatePayload struct {
@@ -48,15 +62,33 @@ type SFContactCreateUpdatePayload struct {
 	LastName  *string `json:"LastName,omitempty"`
 	Email     *string `json:"Email,omitempty"`
 	Phone     *string `json:"Phone,omitempty"`
+	AccountId *string `json:"AccountId,omitempty"`
+	OwnerId   *string `json:"OwnerId,omitempty"`
 }
 
-func (client *Client) ListContacts(ctx context.Context, first int, after *string) (*model.ContactConnection, error) {
+// Fields used in SOQL queries, including the name of the parent account and the custom fields
+func (client *Client) sfContactQueryFields() []string {
+	return client.FieldMapping.QueryFields(model.CrmObjectContact, append(connectors.StructFieldNames(SFContactBase{}), "Account.Name"))
+}
+
+func (client *Client) ListContacts(ctx context.Context, params *connectors.ListParams) (*model.ContactConnection, error) {
+	whereFilter, err := sfListWhereClause(params, sfContactFilterFields, sfContactSearchFields)
+	if err != nil {
+		return nil, err
+	}
+
+	sorts, err := sfSorts(params.OrderBy, sfContactFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
 	response := SFContactsListSuccessResponse{}
-	err := client.list(
+	err = client.list(
 		CONTACT_OBJECT,
-		connectors.StructFieldNames(SFContactBase{}),
-		first,
-		after,
+		client.sfContactQueryFields(),
+		params,
+		whereFilter,
+		sorts,
 		&response,
 	)
 
@@ -67,20 +99,32 @@ func (client *Client) ListContacts(ctx context.Context, first int, after *string
 
 	var contact *model.Contact
 	contactEdges := make([]*model.ContactEdge, len(response.Records))
-	for i, sfContact := range response.Records {
-		contact = sfContact.mapContactProperties()
+	for i := range response.Records {
+		sfContact := &response.Records[i]
+		contact = client.mapContact(sfContact)
 		contactEdges[i] = &model.ContactEdge{
-			Cursor: connectors.EncodeCursor(contact.ID),
+			Cursor: sfRecordCursor(sfContact, contact.ID, sorts),
 			Node:   contact,
 		}
 	}
 
-	recordsValue, pageInfo := client.prepareListResults(first, after, &contactEdges)
+	recordsValue, pageInfo := client.prepareListResults(params, &contactEdges)
 
-	return &model.ContactConnection{
+	connection := model.ContactConnection{
 		Edges:    recordsValue.Interface().([]*model.ContactEdge),
 		PageInfo: pageInfo,
-	}, nil
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(CONTACT_OBJECT, whereFilter)
+		if err != nil {
+			log.Errorf("Error counting contacts: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
 }
 
 func (client *Client) GetContact(ctx context.Context, contactId string) (*model.Contact, error) {
@@ -88,7 +132,7 @@ func (client *Client) GetContact(ctx context.Context, contactId string) (*model.
 	err := client.get(
 		CONTACT_OBJECT,
 		contactId,
-		connectors.StructFieldNames(SFContactBase{}),
+		client.FieldMapping.QueryFields(model.CrmObjectContact, connectors.StructFieldNames(SFContactBase{})),
 		&response,
 	)
 
@@ -97,14 +141,27 @@ func (client *Client) GetContact(ctx context.Context, contactId string) (*model.
 		return nil, err
 	}
 
-	contact := response.mapContactProperties()
+	// relationship fields are not returned for a single record, fetching the account name separately
+	if response.AccountID != "" {
+		account := SFAccount{}
+		if err := client.get(ACCOUNT_OBJECT, response.AccountID, []string{"Name"}, &account); err != nil {
+			log.Warnf("Error getting account #%s of contact #%s: %s", response.AccountID, contactId, err)
+		} else {
+			response.Account = &SFAccountReference{Name: account.Name}
+		}
+	}
+
+	contact := client.mapContact(&response)
 
 	return contact, nil
 }
 
 // Create contact using GraphQL input
 func (client *Client) CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error) {
-	payload := createSFContactPayload(input)
+	payload, err := client.createSFContactPayload(input)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create the contact
 	objectId, err := client.create(CONTACT_OBJECT, payload)
@@ -124,7 +181,10 @@ func (client *Client) CreateContact(ctx context.Context, input *model.ContactInp
 }
 
 func (client *Client) UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error) {
-	payload := createSFContactPayload(input)
+	payload, err := client.createSFContactPayload(input)
+	if err != nil {
+		return false, err
+	}
 
 	// Update the contact
 	success, err := client.update(CONTACT_OBJECT, contactId, payload)
@@ -141,7 +201,7 @@ func (client *Client) DeleteContact(ctx context.Context, contactId string) (bool
 }
 
 // Creates Salesforce Contact Update/Create payload from GraphQL input
-func createSFContactPayload(input *model.ContactInput) *SFContactCreateUpdatePayload {
+func (client *Client) createSFContactPayload(input *model.ContactInput) (map[string]interface{}, error) {
 	payload := SFContactCreateUpdatePayload{}
 
 	if input.FirstName != nil {
@@ -160,12 +220,28 @@ func createSFContactPayload(input *model.ContactInput) *SFContactCreateUpdatePay
 		payload.Phone = input.Phone
 	}
 
-	return &payload
+	if input.CompanyID != nil {
+		payload.AccountId = input.CompanyID
+	}
+
+	if input.OwnerID != nil {
+		payload.OwnerId = input.OwnerID
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectContact, input.CustomFields))
+}
+
+// Maps the contact with its custom fields
+func (client *Client) mapContact(sfContact *SFContact) *model.Contact {
+	contact := sfContact.mapContactProperties()
+	contact.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectContact, sfContact.RawFields)
+
+	return contact
 }
 
 func (sfContact *SFContact) mapContactProperties() *model.Contact {
 	name := sfContact.FirstName + " " + sfContact.LastName
-	return &model.Contact{
+	contact := model.Contact{
 		ID:        sfContact.ID,
 		Name:      &name,
 		FirstName: &sfContact.FirstName,
@@ -175,4 +251,19 @@ func (sfContact *SFContact) mapContactProperties() *model.Contact {
 		CreatedAt: parseSFDateTime(&sfContact.CreatedDate),
 		UpdatedAt: parseSFDateTime(&sfContact.LastModifiedDate),
 	}
+
+	if sfContact.AccountID != "" {
+		contact.Company = &model.Company{ID: sfContact.AccountID}
+
+		if sfContact.Account != nil {
+			contact.CompanyName = &sfContact.Account.Name
+			contact.Company.Name = sfContact.Account.Name
+		}
+	}
+
+	if sfContact.OwnerID != "" {
+		contact.Owner = &model.User{ID: sfContact.OwnerID}
+	}
+
+	return &contact
 }
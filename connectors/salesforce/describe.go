@@ -0,0 +1,104 @@
+package salesforce
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Objects of Salesforce the Omni API objects are stored in
+var sfDescribedObjects = map[model.CrmObject]string{
+	model.CrmObjectContact:     CONTACT_OBJECT,
+	model.CrmObjectOpportunity: OPPORTUNITY_OBJECT,
+	model.CrmObjectCompany:     ACCOUNT_OBJECT,
+	model.CrmObjectLead:        LEAD_OBJECT,
+	model.CrmObjectUser:        USER_OBJECT,
+	model.CrmObjectNote:        NOTE_OBJECT,
+	model.CrmObjectTask:        TASK_OBJECT,
+	model.CrmObjectActivity:    EVENT_OBJECT,
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_sobject_describe.htm
+type SFObjectDescription struct {
+	Name   string                     `json:"name"`
+	Fields []SFObjectFieldDescription `json:"fields"`
+}
+
+type SFObjectFieldDescription struct {
+	Name              string            `json:"name"`
+	Label             string            `json:"label"`
+	Type              string            `json:"type"`
+	Nillable          bool              `json:"nillable"`
+	Createable        bool              `json:"createable"`
+	Updateable        bool              `json:"updateable"`
+	DefaultedOnCreate bool              `json:"defaultedOnCreate"`
+	Calculated        bool              `json:"calculated"`
+	Custom            bool              `json:"custom"`
+	PicklistValues    []SFPicklistValue `json:"picklistValues"`
+}
+
+type SFPicklistValue struct {
+	Value  string `json:"value"`
+	Label  string `json:"label"`
+	Active bool   `json:"active"`
+}
+
+func (client *Client) DescribeObject(ctx context.Context, object model.CrmObject) (*model.ObjectDescription, error) {
+	objectName, found := sfDescribedObjects[object]
+	if !found {
+		return nil, fmt.Errorf("describing %s is not supported", object)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/sobjects/%s/describe", client.baseUrl(), objectName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SFObjectDescription{}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		log.Errorf("Error describing %s: %s", objectName, err)
+		return nil, err
+	}
+
+	return response.mapProperties(object), nil
+}
+
+func (sfDescription *SFObjectDescription) mapProperties(object model.CrmObject) *model.ObjectDescription {
+	description := model.ObjectDescription{
+		Object: object,
+		Name:   sfDescription.Name,
+		Fields: make([]*model.FieldDescription, len(sfDescription.Fields)),
+	}
+
+	for i, sfField := range sfDescription.Fields {
+		description.Fields[i] = sfField.mapProperties()
+	}
+
+	return &description
+}
+
+func (sfField *SFObjectFieldDescription) mapProperties() *model.FieldDescription {
+	field := model.FieldDescription{
+		Name:  sfField.Name,
+		Label: sfField.Label,
+		Type:  sfField.Type,
+		// the fields Salesforce fills in when they're left empty aren't required, e.g. the owner
+		Required: sfField.Createable && !sfField.Nillable && !sfField.DefaultedOnCreate,
+		Readonly: sfField.Calculated || (!sfField.Createable && !sfField.Updateable),
+		Custom:   sfField.Custom,
+	}
+
+	if sfField.Type == "picklist" || sfField.Type == "multipicklist" {
+		field.PicklistValues = []*model.PicklistValue{}
+		for _, sfValue := range sfField.PicklistValues {
+			if sfValue.Active {
+				field.PicklistValues = append(field.PicklistValues, &model.PicklistValue{Value: sfValue.Value, Label: sfValue.Label})
+			}
+		}
+	}
+
+	return &field
+}
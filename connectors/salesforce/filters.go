@@ -0,0 +1,280 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	SF_FIELD_TYPE_STRING = iota
+	SF_FIELD_TYPE_NUMBER
+	SF_FIELD_TYPE_DATETIME
+	SF_FIELD_TYPE_DATE
+)
+
+type sfFilterField struct {
+	Name     string
+	Type     int
+	Constant *string // value of a field that isn't stored in Salesforce
+}
+
+// SOQL conditions that always or never match
+const (
+	SOQL_TRUE  = "Id != null"
+	SOQL_FALSE = "Id = null"
+)
+
+var sfDefaultPipelineID = SF_DEFAULT_PIPELINE_ID
+
+// Numbers written in the SOQL queries, e.g. "1000" or "-12.5"
+var soqlDecimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// Salesforce fields of the unified filter fields
+var sfContactFilterFields = map[string]sfFilterField{
+	"firstName": {Name: "FirstName"},
+	"lastName":  {Name: "LastName"},
+	"email":     {Name: "Email"},
+	"phone":     {Name: "Phone"},
+	"companyId": {Name: "AccountId"},
+	"ownerId":   {Name: "OwnerId"},
+	"createdAt": {Name: "CreatedDate", Type: SF_FIELD_TYPE_DATETIME},
+	"updatedAt": {Name: "LastModifiedDate", Type: SF_FIELD_TYPE_DATETIME},
+}
+
+var sfOpportunityFilterFields = map[string]sfFilterField{
+	"name":       {Name: "Name"},
+	"stageName":  {Name: "StageName"},
+	"companyId":  {Name: "AccountId"},
+	"ownerId":    {Name: "OwnerId"},
+	"amount":     {Name: "Amount", Type: SF_FIELD_TYPE_NUMBER},
+	"closeDate":  {Name: "CloseDate", Type: SF_FIELD_TYPE_DATE},
+	"createdAt":  {Name: "CreatedDate", Type: SF_FIELD_TYPE_DATETIME},
+	"updatedAt":  {Name: "LastModifiedDate", Type: SF_FIELD_TYPE_DATETIME},
+	"pipelineId": {Constant: &sfDefaultPipelineID}, // there is a single pipeline
+}
+
+// Companies can't be filtered yet, the fields are used for sorting
+var sfCompanyFilterFields = map[string]sfFilterField{
+	"name":              {Name: "Name"},
+	"ownerId":           {Name: "OwnerId"},
+	"numberOfEmployees": {Name: "NumberOfEmployees", Type: SF_FIELD_TYPE_NUMBER},
+	"annualRevenue":     {Name: "AnnualRevenue", Type: SF_FIELD_TYPE_NUMBER},
+	"createdAt":         {Name: "CreatedDate", Type: SF_FIELD_TYPE_DATETIME},
+	"updatedAt":         {Name: "LastModifiedDate", Type: SF_FIELD_TYPE_DATETIME},
+}
+
+// Leads can't be filtered yet, the fields are used for sorting
+var sfLeadFilterFields = map[string]sfFilterField{
+	"firstName":   {Name: "FirstName"},
+	"lastName":    {Name: "LastName"},
+	"email":       {Name: "Email"},
+	"companyName": {Name: "Company"},
+	"status":      {Name: "Status"},
+	"createdAt":   {Name: "CreatedDate", Type: SF_FIELD_TYPE_DATETIME},
+	"updatedAt":   {Name: "LastModifiedDate", Type: SF_FIELD_TYPE_DATETIME},
+}
+
+// Users can't be filtered yet, the fields are used for sorting
+var sfUserFilterFields = map[string]sfFilterField{
+	"name":      {Name: "Name"},
+	"firstName": {Name: "FirstName"},
+	"lastName":  {Name: "LastName"},
+	"email":     {Name: "Email"},
+	"createdAt": {Name: "CreatedDate", Type: SF_FIELD_TYPE_DATETIME},
+	"updatedAt": {Name: "LastModifiedDate", Type: SF_FIELD_TYPE_DATETIME},
+}
+
+// Text fields matched by the free-text search
+var sfContactSearchFields = []string{"Name", "Email", "Phone"}
+var sfOpportunitySearchFields = []string{"Name"}
+
+// Builds the SOQL WHERE clause of the list params, returns an empty string when there is nothing to filter by
+func sfListWhereClause(params *connectors.ListParams, filterFields map[string]sfFilterField, searchFields []string) (string, error) {
+	clauses := []string{}
+
+	if params.Filter != nil {
+		clause, err := compileSOQLFilter(params.Filter, filterFields)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	if params.Query != nil && *params.Query != "" {
+		pattern := formatSOQLLikePattern(*params.Query)
+		searchClauses := make([]string, len(searchFields))
+		for i, field := range searchFields {
+			searchClauses[i] = fmt.Sprintf("%s LIKE %s", field, pattern)
+		}
+		clauses = append(clauses, "("+strings.Join(searchClauses, " OR ")+")")
+	}
+
+	return strings.Join(clauses, " AND "), nil
+}
+
+func compileSOQLFilter(filter *connectors.Filter, filterFields map[string]sfFilterField) (string, error) {
+	clauses := []string{}
+
+	for _, condition := range filter.Conditions {
+		clause, err := compileSOQLCondition(condition, filterFields)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, clause)
+	}
+
+	for _, and := range filter.And {
+		clause, err := compileSOQLFilter(and, filterFields)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		orClauses := []string{}
+		for _, or := range filter.Or {
+			clause, err := compileSOQLFilter(or, filterFields)
+			if err != nil {
+				return "", err
+			}
+			if clause == "" {
+				// an empty filter matches everything
+				orClauses = nil
+				break
+			}
+			orClauses = append(orClauses, clause)
+		}
+
+		if len(orClauses) > 0 {
+			clauses = append(clauses, "("+strings.Join(orClauses, " OR ")+")")
+		}
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+
+	return "(" + strings.Join(clauses, " AND ") + ")", nil
+}
+
+func compileSOQLCondition(condition connectors.FilterCondition, filterFields map[string]sfFilterField) (string, error) {
+	field, ok := filterFields[condition.Field]
+	if !ok {
+		return "", fmt.Errorf("filtering by %s is not supported", condition.Field)
+	}
+
+	if field.Constant != nil {
+		return compileSOQLConstantCondition(condition, *field.Constant)
+	}
+
+	if field.Type == SF_FIELD_TYPE_NUMBER {
+		if err := validateSOQLDecimals(condition); err != nil {
+			return "", err
+		}
+	}
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ:
+		return fmt.Sprintf("%s = %s", field.Name, formatSOQLValue(field, condition.Value)), nil
+	case connectors.FILTER_OPERATOR_GTE:
+		return fmt.Sprintf("%s >= %s", field.Name, formatSOQLValue(field, condition.Value)), nil
+	case connectors.FILTER_OPERATOR_LTE:
+		return fmt.Sprintf("%s <= %s", field.Name, formatSOQLValue(field, condition.Value)), nil
+	case connectors.FILTER_OPERATOR_CONTAINS:
+		value, _ := condition.Value.(string)
+		return fmt.Sprintf("%s LIKE %s", field.Name, formatSOQLLikePattern(value)), nil
+	case connectors.FILTER_OPERATOR_IN:
+		values, _ := condition.Value.([]string)
+		if len(values) == 0 {
+			// nothing can match an empty list
+			return SOQL_FALSE, nil
+		}
+
+		formattedValues := make([]string, len(values))
+		for i, value := range values {
+			formattedValues[i] = formatSOQLValue(field, value)
+		}
+		return fmt.Sprintf("%s IN (%s)", field.Name, strings.Join(formattedValues, ", ")), nil
+	}
+
+	return "", fmt.Errorf("unsupported filter operator %s", condition.Operator)
+}
+
+// Evaluates the condition on a constant field, the result doesn't depend on the record
+func compileSOQLConstantCondition(condition connectors.FilterCondition, constant string) (string, error) {
+	matches := false
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ:
+		value, _ := condition.Value.(string)
+		matches = value == constant
+	case connectors.FILTER_OPERATOR_IN:
+		values, _ := condition.Value.([]string)
+		for _, value := range values {
+			matches = matches || value == constant
+		}
+	default:
+		return "", fmt.Errorf("unsupported filter operator %s for %s", condition.Operator, condition.Field)
+	}
+
+	if matches {
+		return SOQL_TRUE, nil
+	}
+
+	return SOQL_FALSE, nil
+}
+
+// Decimal scalar isn't validated, e.g. "NaN" or "1e400" can't be written in SOQL
+func validateSOQLDecimals(condition connectors.FilterCondition) error {
+	values, ok := condition.Value.([]string)
+	if value, isString := condition.Value.(string); isString {
+		values, ok = []string{value}, true
+	}
+	if !ok {
+		return nil
+	}
+
+	for _, value := range values {
+		if !soqlDecimalPattern.MatchString(value) {
+			return fmt.Errorf("invalid decimal %q for %s", value, condition.Field)
+		}
+	}
+
+	return nil
+}
+
+func formatSOQLValue(field sfFilterField, value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		if field.Type == SF_FIELD_TYPE_DATE {
+			return v.UTC().Format(SF_DATE_FORMAT)
+		}
+		return v.UTC().Format(time.RFC3339)
+	case string:
+		// only the plain decimals of the number fields are written as they are, anything else is quoted
+		if field.Type == SF_FIELD_TYPE_NUMBER && soqlDecimalPattern.MatchString(v) {
+			return v
+		}
+		return formatSOQLString(v)
+	}
+
+	return formatSOQLString(fmt.Sprint(value))
+}
+
+func formatSOQLString(value string) string {
+	return "'" + escapeSOQL(value) + "'"
+}
+
+// Pattern matching the value anywhere in the field with the LIKE operator, wildcards of the value are escaped
+func formatSOQLLikePattern(value string) string {
+	escaped := strings.NewReplacer(`%`, `\%`, `_`, `\_`).Replace(escapeSOQL(value))
+	return "'%" + escaped + "%'"
+}
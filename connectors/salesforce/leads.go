@@ -0,0 +1,287 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"encoding/xml"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	LEAD_OBJECT        = "Lead"
+	LEAD_STATUS_OBJECT = "LeadStatus"
+)
+
+type SFLeadsListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFLead `json:"records"`
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_lead.htm
+type SFLead struct {
+	ID          string `json:"Id"`
+	FirstName   string `json:"FirstName"`
+	LastName    string `json:"LastName"`
+	Email       string `json:"Email"`
+	Phone       string `json:"Phone"`
+	Website     string `json:"Website"`
+	Company     string `json:"Company"`
+	Title       string `json:"Title"`
+	Status      string `json:"Status"`
+	IsConverted bool   `json:"IsConverted"`
+
+	IsDeleted        bool   `json:"IsDeleted"`
+	CreatedDate      string `json:"CreatedDate"`
+	LastModifiedDate string `json:"LastModifiedDate"`
+}
+
+type SFLeadCreateUpdatePayload struct {
+	FirstName *string `json:"FirstName,omitempty"`
+	LastName  *string `json:"LastName,omitempty"`
+	Email     *string `json:"Email,omitempty"`
+	Phone     *string `json:"Phone,omitempty"`
+	Website   *string `json:"Website,omitempty"`
+	Company   *string `json:"Company,omitempty"`
+	Title     *string `json:"Title,omitempty"`
+	Status    *string `json:"Status,omitempty"`
+}
+
+type SFLeadStatus struct {
+	MasterLabel string `json:"MasterLabel"`
+}
+
+type SFLeadStatusListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFLeadStatus `json:"records"`
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_calls_convertlead.htm
+type SFConvertLeadRequest struct {
+	XMLName      xml.Name      `xml:"urn:convertLead"`
+	LeadConverts SFLeadConvert `xml:"urn:leadConverts"`
+}
+
+// the order of the fields follows the LeadConvert type definition of the WSDL
+type SFLeadConvert struct {
+	AccountID              *string `xml:"urn:accountId,omitempty"`
+	ConvertedStatus        string  `xml:"urn:convertedStatus"`
+	DoNotCreateOpportunity bool    `xml:"urn:doNotCreateOpportunity"`
+	LeadID                 string  `xml:"urn:leadId"`
+	OpportunityName        *string `xml:"urn:opportunityName,omitempty"`
+}
+
+type SFConvertLeadResponse struct {
+	Results []SFLeadConvertResult `xml:"result"`
+}
+
+type SFLeadConvertResult struct {
+	AccountID     string        `xml:"accountId"`
+	ContactID     string        `xml:"contactId"`
+	OpportunityID string        `xml:"opportunityId"`
+	LeadID        string        `xml:"leadId"`
+	Success       bool          `xml:"success"`
+	Errors        []SFSOAPError `xml:"errors"`
+}
+
+func (client *Client) ListLeads(ctx context.Context, params *connectors.ListParams) (*model.LeadConnection, error) {
+	sorts, err := sfSorts(params.OrderBy, sfLeadFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SFLeadsListSuccessResponse{}
+	err = client.list(
+		LEAD_OBJECT,
+		connectors.StructFieldNames(SFLead{}),
+		params,
+		"",
+		sorts,
+		&response,
+	)
+
+	if err != nil {
+		log.Errorf("Error listing leads: %s", err)
+		return nil, err
+	}
+
+	var lead *model.Lead
+	edges := make([]*model.LeadEdge, len(response.Records))
+	for i := range response.Records {
+		sfLead := &response.Records[i]
+		lead = sfLead.mapLeadProperties()
+		edges[i] = &model.LeadEdge{
+			Cursor: sfRecordCursor(sfLead, lead.ID, sorts),
+			Node:   lead,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &edges)
+
+	connection := model.LeadConnection{
+		Edges:    recordsValue.Interface().([]*model.LeadEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(LEAD_OBJECT, "")
+		if err != nil {
+			log.Errorf("Error counting leads: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetLead(ctx context.Context, leadId string) (*model.Lead, error) {
+	response := SFLead{}
+	err := client.get(
+		LEAD_OBJECT,
+		leadId,
+		connectors.StructFieldNames(SFLead{}),
+		&response,
+	)
+
+	if err != nil {
+		log.Errorf("Error getting lead: %s", err)
+		return nil, err
+	}
+
+	return response.mapLeadProperties(), nil
+}
+
+func (client *Client) CreateLead(ctx context.Context, input *model.LeadInput) (*model.Lead, error) {
+	payload := createSFLeadPayload(input)
+
+	objectId, err := client.create(LEAD_OBJECT, payload)
+	if err != nil {
+		log.Errorf("Error creating lead: %s", err)
+		return nil, err
+	}
+
+	// Fetch all the fields after the creation
+	lead, err := client.GetLead(ctx, objectId)
+	if err != nil {
+		log.Errorf("Error fetching lead #%s after creation with :%s", objectId, err)
+		return nil, err
+	}
+
+	return lead, nil
+}
+
+func (client *Client) UpdateLead(ctx context.Context, leadId string, input *model.LeadInput) (bool, error) {
+	payload := createSFLeadPayload(input)
+
+	success, err := client.update(LEAD_OBJECT, leadId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating lead #%s: %s", leadId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteLead(ctx context.Context, leadId string) (bool, error) {
+	return client.delete(LEAD_OBJECT, leadId)
+}
+
+// Converts the lead into a contact, an account and (optionally) an opportunity using the SOAP API
+func (client *Client) ConvertLead(ctx context.Context, leadId string, input *model.LeadConversionInput) (*model.LeadConversionResult, error) {
+	leadConvert := SFLeadConvert{
+		AccountID:              input.CompanyID,
+		DoNotCreateOpportunity: input.CreateOpportunity != nil && !*input.CreateOpportunity,
+		LeadID:                 leadId,
+		OpportunityName:        input.OpportunityName,
+	}
+
+	if input.ConvertedStatus != nil {
+		leadConvert.ConvertedStatus = *input.ConvertedStatus
+	} else {
+		convertedStatus, err := client.defaultConvertedLeadStatus()
+		if err != nil {
+			return nil, err
+		}
+		leadConvert.ConvertedStatus = convertedStatus
+	}
+
+	response := SFConvertLeadResponse{}
+	if err := client.sendSOAPRequest(&SFConvertLeadRequest{LeadConverts: leadConvert}, &response); err != nil {
+		log.Errorf("Error converting lead #%s: %s", leadId, err)
+		return nil, err
+	}
+
+	if len(response.Results) == 0 {
+		return nil, errors.New("empty lead conversion response")
+	}
+
+	result := response.Results[0]
+	if !result.Success {
+		return nil, errors.New(formatSFSOAPErrors(result.Errors))
+	}
+
+	conversionResult := model.LeadConversionResult{
+		ContactID: result.ContactID,
+	}
+	if result.AccountID != "" {
+		conversionResult.CompanyID = &result.AccountID
+	}
+	if result.OpportunityID != "" {
+		conversionResult.OpportunityID = &result.OpportunityID
+	}
+
+	return &conversionResult, nil
+}
+
+// Salesforce requires a status with IsConverted flag to be provided for the conversion
+func (client *Client) defaultConvertedLeadStatus() (string, error) {
+	response := SFLeadStatusListSuccessResponse{}
+	err := client.listWithWhere(LEAD_STATUS_OBJECT, connectors.StructFieldNames(SFLeadStatus{}),
+		"IsConverted = true ORDER BY SortOrder LIMIT 1", &response)
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Records) == 0 {
+		return "", errors.New("no converted lead status is configured in Salesforce")
+	}
+
+	return response.Records[0].MasterLabel, nil
+}
+
+// Creates Salesforce Lead Update/Create payload from GraphQL input
+func createSFLeadPayload(input *model.LeadInput) *SFLeadCreateUpdatePayload {
+	return &SFLeadCreateUpdatePayload{
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+		Email:     input.Email,
+		Phone:     input.Phone,
+		Website:   input.Website,
+		Company:   input.CompanyName,
+		Title:     input.Title,
+		Status:    input.Status,
+	}
+}
+
+func (sfLead *SFLead) mapLeadProperties() *model.Lead {
+	name := sfLead.FirstName + " " + sfLead.LastName
+	return &model.Lead{
+		ID:          sfLead.ID,
+		Name:        &name,
+		FirstName:   &sfLead.FirstName,
+		LastName:    &sfLead.LastName,
+		Email:       &sfLead.Email,
+		Phone:       &sfLead.Phone,
+		Website:     &sfLead.Website,
+		CompanyName: &sfLead.Company,
+		Title:       &sfLead.Title,
+		Status:      &sfLead.Status,
+		Converted:   &sfLead.IsConverted,
+		Archived:    &sfLead.IsDeleted,
+		CreatedAt:   parseSFDateTime(&sfLead.CreatedDate),
+		UpdatedAt:   parseSFDateTime(&sfLead.LastModifiedDate),
+	}
+}
@@ -20,6 +20,23 @@ type SFOpportunity struct {
 	StageName string   `json:"StageName"`
 	CloseDate *string  `json:"CloseDate"`
 	Amount    *float32 `json:"Amount"`
+	AccountId *string  `json:"AccountId"`
+	OwnerId   *string  `json:"OwnerId"`
+
+	CreatedDate      string `json:"CreatedDate"`
+	LastModifiedDate string `json:"LastModifiedDate"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (sfOpportunity *SFOpportunity) UnmarshalJSON(data []byte) error {
+	type plainSFOpportunity SFOpportunity
+	return connectors.UnmarshalWithRawFields(data, (*plainSFOpportunity)(sfOpportunity), &sfOpportunity.RawFields)
+}
+
+// Fields used in SOQL queries, including the custom fields
+func (client *Client) sfOpportunityQueryFields() []string {
+	return client.FieldMapping.QueryFields(model.CrmObjectOpportunity, connectors.StructFieldNames(SFOpportunity{}))
 }
 
 type SFOpportunityListSuccessResponse struct {
@@ -32,15 +49,28 @@ type SFOpportunityCreateUpdatePayload struct {
 	StageName string  `json:"StageName"`
 	CloseDate string  `json:"CloseDate"`
 	Amount    *string `json:"Amount"`
+	AccountId *string `json:"AccountId,omitempty"`
+	OwnerId   *string `json:"OwnerId,omitempty"`
 }
 
-func (client *Client) ListOpportunities(ctx context.Context, first int, after *string) (*model.OpportunityConnection, error) {
+func (client *Client) ListOpportunities(ctx context.Context, params *connectors.ListParams) (*model.OpportunityConnection, error) {
+	whereFilter, err := sfListWhereClause(params, sfOpportunityFilterFields, sfOpportunitySearchFields)
+	if err != nil {
+		return nil, err
+	}
+
+	sorts, err := sfSorts(params.OrderBy, sfOpportunityFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
 	response := SFOpportunityListSuccessResponse{}
-	err := client.list(
+	err = client.list(
 		OPPORTUNITY_OBJECT,
-		connectors.StructFieldNames(SFOpportunity{}),
-		first,
-		after,
+		client.sfOpportunityQueryFields(),
+		params,
+		whereFilter,
+		sorts,
 		&response,
 	)
 
@@ -51,20 +81,32 @@ func (client *Client) ListOpportunities(ctx context.Context, first int, after *s
 
 	var opportunity *model.Opportunity
 	edges := make([]*model.OpportunityEdge, len(response.Records))
-	for i, sfOpportunity := range response.Records {
-		opportunity = sfOpportunity.mapProperties()
+	for i := range response.Records {
+		sfOpportunity := &response.Records[i]
+		opportunity = client.mapOpportunity(sfOpportunity)
 		edges[i] = &model.OpportunityEdge{
-			Cursor: connectors.EncodeCursor(opportunity.ID),
+			Cursor: sfRecordCursor(sfOpportunity, opportunity.ID, sorts),
 			Node:   opportunity,
 		}
 	}
 
-	recordsValue, pageInfo := client.prepareListResults(first, after, &edges)
+	recordsValue, pageInfo := client.prepareListResults(params, &edges)
 
-	return &model.OpportunityConnection{
+	connection := model.OpportunityConnection{
 		Edges:    recordsValue.Interface().([]*model.OpportunityEdge),
 		PageInfo: pageInfo,
-	}, nil
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(OPPORTUNITY_OBJECT, whereFilter)
+		if err != nil {
+			log.Errorf("Error counting opportunities: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
 }
 
 func (client *Client) GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error) {
@@ -72,7 +114,7 @@ func (client *Client) GetOpportunity(ctx context.Context, opportunityId string)
 	err := client.get(
 		OPPORTUNITY_OBJECT,
 		opportunityId,
-		connectors.StructFieldNames(SFOpportunity{}),
+		client.sfOpportunityQueryFields(),
 		&response,
 	)
 
@@ -81,12 +123,15 @@ func (client *Client) GetOpportunity(ctx context.Context, opportunityId string)
 		return nil, err
 	}
 
-	obj := response.mapProperties()
+	obj := client.mapOpportunity(&response)
 	return obj, nil
 }
 
 func (client *Client) CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error) {
-	payload := createSFOpportunityPayload(input)
+	payload, err := client.createSFOpportunityPayload(input)
+	if err != nil {
+		return nil, err
+	}
 
 	objectId, err := client.create(OPPORTUNITY_OBJECT, payload)
 	if err != nil {
@@ -105,7 +150,10 @@ func (client *Client) CreateOpportunity(ctx context.Context, input *model.Opport
 }
 
 func (client *Client) UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error) {
-	payload := createSFOpportunityPayload(input)
+	payload, err := client.createSFOpportunityPayload(input)
+	if err != nil {
+		return false, err
+	}
 
 	success, err := client.update(OPPORTUNITY_OBJECT, opportunityId, payload)
 	if !success || err != nil {
@@ -120,13 +168,26 @@ func (client *Client) DeleteOpportunity(ctx context.Context, opportunityId strin
 	return client.delete(OPPORTUNITY_OBJECT, opportunityId)
 }
 
+// Maps the opportunity with its custom fields
+func (client *Client) mapOpportunity(sfOpportunity *SFOpportunity) *model.Opportunity {
+	opportunity := sfOpportunity.mapProperties()
+	opportunity.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectOpportunity, sfOpportunity.RawFields)
+
+	return opportunity
+}
+
 func (sfOpportunity *SFOpportunity) mapProperties() *model.Opportunity {
 	opportunity := model.Opportunity{
 		ID:        sfOpportunity.Id,
 		Name:      sfOpportunity.Name,
 		StageName: &sfOpportunity.StageName,
+		CreatedAt: parseSFDateTime(&sfOpportunity.CreatedDate),
+		UpdatedAt: parseSFDateTime(&sfOpportunity.LastModifiedDate),
 	}
 
+	pipelineId := SF_DEFAULT_PIPELINE_ID
+	opportunity.PipelineID = &pipelineId
+
 	if sfOpportunity.CloseDate != nil {
 		closeDate, _ := time.Parse(time.RFC3339, *sfOpportunity.CloseDate)
 		opportunity.CloseDate = &closeDate
@@ -137,10 +198,18 @@ func (sfOpportunity *SFOpportunity) mapProperties() *model.Opportunity {
 		opportunity.Amount = &amount
 	}
 
+	if sfOpportunity.AccountId != nil && *sfOpportunity.AccountId != "" {
+		opportunity.Company = &model.Company{ID: *sfOpportunity.AccountId}
+	}
+
+	if sfOpportunity.OwnerId != nil && *sfOpportunity.OwnerId != "" {
+		opportunity.Owner = &model.User{ID: *sfOpportunity.OwnerId}
+	}
+
 	return &opportunity
 }
 
-func createSFOpportunityPayload(input *model.OpportunityInput) *SFOpportunityCreateUpdatePayload {
+func (client *Client) createSFOpportunityPayload(input *model.OpportunityInput) (map[string]interface{}, error) {
 	payload := SFOpportunityCreateUpdatePayload{}
 
 	payload.Name = input.Name
@@ -150,5 +219,13 @@ func createSFOpportunityPayload(input *model.OpportunityInput) *SFOpportunityCre
 		payload.Amount = input.Amount
 	}
 
-	return &payload
+	if input.CompanyID != nil {
+		payload.AccountId = input.CompanyID
+	}
+
+	if input.OwnerID != nil {
+		payload.OwnerId = input.OwnerID
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectOpportunity, input.CustomFields))
 }
@@ -0,0 +1,74 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	OPPORTUNITY_STAGE_OBJECT = "OpportunityStage"
+
+	// Salesforce has a single set of opportunity stages, sales processes only limit the available ones
+	SF_DEFAULT_PIPELINE_ID    = "default"
+	SF_DEFAULT_PIPELINE_LABEL = "Opportunities"
+)
+
+type SFOpportunityStagesListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFOpportunityStage `json:"records"`
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_opportunitystage.htm
+type SFOpportunityStage struct {
+	ID                 string   `json:"Id"`
+	ApiName            string   `json:"ApiName"` // value stored in the StageName field of opportunities
+	MasterLabel        string   `json:"MasterLabel"`
+	SortOrder          *int     `json:"SortOrder"`
+	DefaultProbability *float64 `json:"DefaultProbability"` // percentage
+	IsClosed           bool     `json:"IsClosed"`
+	IsWon              bool     `json:"IsWon"`
+}
+
+func (client *Client) ListPipelines(ctx context.Context) ([]*model.Pipeline, error) {
+	response := SFOpportunityStagesListSuccessResponse{}
+	err := client.listWithWhere(OPPORTUNITY_STAGE_OBJECT, connectors.StructFieldNames(SFOpportunityStage{}),
+		"IsActive = true ORDER BY SortOrder", &response)
+
+	if err != nil {
+		log.Errorf("Error listing opportunity stages: %s", err)
+		return nil, err
+	}
+
+	stages := make([]*model.PipelineStage, len(response.Records))
+	for i, sfStage := range response.Records {
+		stages[i] = sfStage.mapPipelineStageProperties()
+	}
+
+	pipeline := model.Pipeline{
+		ID:     SF_DEFAULT_PIPELINE_ID,
+		Label:  SF_DEFAULT_PIPELINE_LABEL,
+		Stages: stages,
+	}
+
+	return []*model.Pipeline{&pipeline}, nil
+}
+
+func (sfStage *SFOpportunityStage) mapPipelineStageProperties() *model.PipelineStage {
+	stage := model.PipelineStage{
+		ID:           sfStage.ApiName,
+		Label:        sfStage.MasterLabel,
+		DisplayOrder: sfStage.SortOrder,
+		IsClosed:     sfStage.IsClosed,
+		IsWon:        sfStage.IsWon,
+	}
+
+	if sfStage.DefaultProbability != nil {
+		probability := *sfStage.DefaultProbability / 100
+		stage.Probability = &probability
+	}
+
+	return &stage
+}
@@ -0,0 +1,59 @@
+package salesforce
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+	"fmt"
+)
+
+func init() {
+	connectors.Register(connectors.Registration{
+		Connector: connectors.Connector{
+			ServiceCode: connectors.CONNECTOR_CRM_SALESFORCE,
+			Type:        connectors.CONNECTOR_TYPE_CRM,
+			Name:        "Salesforce",
+			Description: "Salesforce is the world’s #1 customer relationship management (CRM) platform.",
+			AuthType:    connectors.AUTH_TYPE_OAUTH2,
+		},
+		Position: 1,
+		NewCrmConnector: func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping connectors.FieldMapping) connectors.CrmConnector {
+			client := SaleforceClient(app, oauthConfig)
+			client.FieldMapping = fieldMapping
+			return client
+		},
+		OAuth2: &oauth2Flow,
+		Capabilities: []*model.ObjectCapabilities{
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectContact, "id", "createdAt", "updatedAt", "name", "firstName", "lastName", "email", "phone", "companyName", "company", "owner", "customFields"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectOpportunity, "id", "createdAt", "updatedAt", "name", "amount", "stageName", "pipelineId", "closeDate", "stage", "company", "owner", "customFields"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectCompany, "id", "createdAt", "updatedAt", "archived", "name", "website", "phone", "industry", "description", "city", "country", "numberOfEmployees", "annualRevenue", "owner"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectLead, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "title", "status", "converted"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectUser, "id", "createdAt", "updatedAt", "archived", "name", "firstName", "lastName", "email", "active"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectNote, "id", "createdAt", "updatedAt", "content"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectTask, "id", "createdAt", "updatedAt", "subject", "description", "status", "priority", "dueDate"), model.CrmOperationDescribe),
+			connectors.WithOperations(connectors.ObjectCapabilities(model.CrmObjectActivity, "id", "createdAt", "updatedAt", "type", "subject", "description", "startTime", "endTime"), model.CrmOperationDescribe),
+			connectors.ObjectCapabilities(model.CrmObjectPipeline, connectors.PipelineFields...),
+			connectors.ObjectCapabilities(model.CrmObjectChange, connectors.ChangeFields...),
+		},
+	})
+}
+
+// The instance URL is returned with the token, the subdomain is only needed before the integration is authorized
+func oauth2CustomSettings(input *model.OAuth2ConfigurationInput) (*integrations.ConsumerOauth2ConfigurationCustomSettings, error) {
+	customSettings := integrations.ConsumerOauth2ConfigurationCustomSettings{}
+
+	if input.SalesforceInstanceSubdomain != nil {
+		customSettings.SalesforceInstanceSubdomain = *input.SalesforceInstanceSubdomain
+	}
+
+	if input.SalesforceLoginURL != nil && *input.SalesforceLoginURL != "" {
+		loginUrl, err := ValidateSalesforceURL(*input.SalesforceLoginURL)
+		if err != nil {
+			return nil, fmt.Errorf("salesforceLoginUrl is invalid: %s", err)
+		}
+		customSettings.SalesforceLoginURL = loginUrl
+	}
+
+	return &customSettings, nil
+}
@@ -8,11 +8,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
 	"blendbase/config"
@@ -22,14 +22,16 @@ import (
 )
 
 const (
-	BaseUrlTemplate        = "https://%s.my.salesforce.com/services/data/v53.0"
+	InstanceUrlTemplate    = "https://%s.my.salesforce.com"
+	BaseUrlTemplate        = "%s/services/data/v53.0"
+	SoapUrlTemplate        = "%s/services/Soap/u/53.0"
 	SALESFORCE_TIME_FORMAT = "2006-01-02T15:04:05.000+0000"
 )
 
 type Client struct {
-	SalesforceInstanceSubdomain string
-	OAuthStateString            string
-	HTTPClient                  *http.Client
+	InstanceURL  string // e.g. https://acme.my.salesforce.com
+	HTTPClient   *http.Client
+	FieldMapping connectors.FieldMapping
 
 	app                 *config.App
 	consumerOAuthConfig *integrations.ConsumerOauth2Configuration
@@ -55,33 +57,33 @@ type SFCreateObjectResponse struct {
 func SaleforceClient(app *config.App, consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *Client {
 	context := context.Background()
 
-	customSettings, _ := consumerOAuthConfig.GetCustomSettings()
+	// the instance URL may be returned with the refreshed token
+	httpClient := oauth2Flow.Client(context, app, consumerOAuthConfig)
 
 	return &Client{
-		SalesforceInstanceSubdomain: customSettings.SalesforceInstanceSubdomain,
-		OAuthStateString:            os.Getenv("OAUTH_STATE_STRING"),
-		HTTPClient:                  getOAuthConfig(consumerOAuthConfig).Client(context, getOAuthToken(consumerOAuthConfig)),
-		app:                         app,
-		consumerOAuthConfig:         consumerOAuthConfig,
+		InstanceURL:         getInstanceURL(consumerOAuthConfig),
+		HTTPClient:          httpClient,
+		app:                 app,
+		consumerOAuthConfig: consumerOAuthConfig,
 	}
 }
 
-func LoadClientFromDB(app *config.App, consumer *integrations.Consumer) (*Client, error) {
-	var consumerIntegration integrations.ConsumerIntegration
-	if err := app.DB.Where("consumer_id = ?", consumer.ID).Where("service_code = ?", "crm_salesforce").Order("created_at DESC").First(&consumerIntegration).Error; err != nil {
-		return nil, err
-	}
-
-	var consumerOAuthConfig integrations.ConsumerOauth2Configuration
-	if err := app.DB.Where("consumer_integration_id = ?", consumerIntegration.ID).First(&consumerOAuthConfig).Error; err != nil {
+// Loads the client of the integration of the consumer, the latest integration of the connector unless an integration ID is given
+func LoadClientFromDB(app *config.App, consumer *integrations.Consumer, integrationID *uuid.UUID) (*Client, error) {
+	consumerOAuthConfig, err := connectors.LoadOAuth2Configuration(app, consumer, connectors.CONNECTOR_CRM_SALESFORCE, integrationID)
+	if err != nil {
 		return nil, err
 	}
 
-	return SaleforceClient(app, &consumerOAuthConfig), nil
+	return SaleforceClient(app, consumerOAuthConfig), nil
 }
 
 func (client *Client) baseUrl() string {
-	return fmt.Sprintf(BaseUrlTemplate, client.SalesforceInstanceSubdomain)
+	return fmt.Sprintf(BaseUrlTemplate, client.InstanceURL)
+}
+
+func (client *Client) soapUrl() string {
+	return fmt.Sprintf(SoapUrlTemplate, client.InstanceURL)
 }
 
 func (client *Client) sendAPIRequest(req *http.Request, response interface{}) error {
@@ -95,13 +97,16 @@ func (client *Client) sendAPIRequest(req *http.Request, response interface{}) er
 	defer res.Body.Close()
 
 	if res.StatusCode == 401 {
-		// SF doesn't provide an expiration date for tokens, so we need to refresh the token
-		// if the call to the API fails with a 401 error
+		// SF doesn't provide an expiration date for tokens, they're refreshed after the lifetime of the
+		// default session timeout and when the call to the API fails with a 401 error
 		if err := client.refreshToken(); err != nil {
 			return err
 		}
 
-		// retry
+		// retry with the body read again
+		if err := connectors.RewindRequestBody(req); err != nil {
+			return err
+		}
 		res, err = client.HTTPClient.Do(req)
 		if err != nil {
 			return err
@@ -134,19 +139,39 @@ func (client *Client) sendAPIRequest(req *http.Request, response interface{}) er
 }
 
 // === API Specific Functions ===
-// Generalized list objects request
-func (client *Client) list(objectName string, fields []string, first int, after *string, response interface{}) error {
+// Generalized list objects request, whereFilter is an optional SOQL condition.
+// Records are ordered by the sorts and then by ID, cursors of sorted lists hold the sorted values.
+// Backward pagination reads the records in the reverse order, prepareListResults restores the order.
+func (client *Client) list(objectName string, fields []string, params *connectors.ListParams, whereFilter string, sorts []sfSort, response interface{}) error {
 	query := url.Values{}
 
 	// +1 to see if there are more pages
-	first += 1
+	limit := params.Limit() + 1
+
+	cursor := params.After
+	if params.Backward() {
+		cursor = params.Before
+	}
+
+	conditions := []string{}
+	if cursor != nil {
+		cursorCondition, err := sfCursorCondition(*cursor, sorts, params.Backward())
+		if err != nil {
+			return err
+		}
+		conditions = append(conditions, cursorCondition)
+	}
+	if whereFilter != "" {
+		conditions = append(conditions, whereFilter)
+	}
+
+	orderBy := sfOrderByClause(sorts, params.Backward())
 
 	var selectQuery string
-	if after != nil {
-		decodedAfter := connectors.DecodeCursor(*after)
-		selectQuery = fmt.Sprintf(`SELECT %s from %s where Id > '%s' order by Id limit %d`, strings.Join(fields, ","), objectName, decodedAfter, first)
+	if len(conditions) > 0 {
+		selectQuery = fmt.Sprintf(`SELECT %s from %s where %s order by %s limit %d`, strings.Join(fields, ","), objectName, strings.Join(conditions, " AND "), orderBy, limit)
 	} else {
-		selectQuery = fmt.Sprintf("SELECT %s from %s order by Id limit %d", strings.Join(fields, ","), objectName, first)
+		selectQuery = fmt.Sprintf("SELECT %s from %s order by %s limit %d", strings.Join(fields, ","), objectName, orderBy, limit)
 	}
 
 	log.Debugf("Listing objects of %s type: %s", objectName, selectQuery)
@@ -168,26 +193,61 @@ func (client *Client) list(objectName string, fields []string, first int, after
 	return nil
 }
 
-func (client *Client) prepareListResults(first int, after *string, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
+// Counts the objects matching the optional SOQL condition
+func (client *Client) count(objectName string, whereFilter string) (int, error) {
+	query := url.Values{}
+
+	selectQuery := fmt.Sprintf("SELECT COUNT() from %s", objectName)
+	if whereFilter != "" {
+		selectQuery = fmt.Sprintf("%s where %s", selectQuery, whereFilter)
+	}
+
+	log.Debugf("Counting objects of %s type: %s", objectName, selectQuery)
+
+	query.Set("q", selectQuery)
+	url := fmt.Sprintf("%s/query?%s", client.baseUrl(), query.Encode())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	response := SFListQuerySuccessResponseBase{}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		log.Error(err)
+		return 0, err
+	}
+
+	return response.TotalSize, nil
+}
+
+func (client *Client) prepareListResults(params *connectors.ListParams, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
 	recordsValue := reflect.ValueOf(edgesPtr).Elem()
 	recordsLenght := recordsValue.Len()
 
 	start := 0
 	end := recordsLenght
-	hasNextPage := false
+	hasMore := false
 
-	if (recordsLenght - start) > first {
+	if (recordsLenght - start) > params.Limit() {
 		// remove the item that was added to see if there are more pages
-		end = first + start
-		hasNextPage = true
+		end = params.Limit() + start
+		hasMore = true
 	}
 
 	ret := recordsValue.Slice(start, end)
 	pageInfo := &model.PageInfo{
-		HasNextPage: hasNextPage,
+		HasNextPage:     hasMore,
+		HasPreviousPage: params.After != nil,
 	}
 
-	if recordsLenght > 0 {
+	if params.Backward() {
+		ret = reverseSlice(ret)
+		pageInfo.HasNextPage = params.Before != nil
+		pageInfo.HasPreviousPage = hasMore
+	}
+
+	if ret.Len() > 0 {
 		startCursor := ret.Index(0).Elem().FieldByName("Cursor").String()
 		endCursor := ret.Index(ret.Len() - 1).Elem().FieldByName("Cursor").String()
 
@@ -198,6 +258,16 @@ func (client *Client) prepareListResults(first int, after *string, edgesPtr inte
 	return ret, pageInfo
 }
 
+// Returns a copy of the slice with the elements in the reverse order
+func reverseSlice(slice reflect.Value) reflect.Value {
+	reversed := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		reversed.Index(slice.Len() - 1 - i).Set(slice.Index(i))
+	}
+
+	return reversed
+}
+
 func (client *Client) listWithWhere(objectName string, fields []string, whereFilter string, response interface{}) error {
 	query := url.Values{}
 
@@ -310,6 +380,21 @@ func (client *Client) delete(objectName string, objectId string) (bool, error) {
 	return true, nil
 }
 
+// Escapes a value so it can be safely used inside a quoted SOQL string literal
+func escapeSOQL(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
+
+// Salesforce IDs come in a case-sensitive 15 character and a case-insensitive 18 character form,
+// both forms share the same first 15 characters
+func sameSFID(a string, b string) bool {
+	if len(a) < 15 || len(b) < 15 {
+		return a == b
+	}
+
+	return a[:15] == b[:15]
+}
+
 func formatSFErrors(errors []SFError) string {
 	messages := []string{}
 	for _, error := range errors {
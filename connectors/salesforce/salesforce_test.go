@@ -3,14 +3,23 @@ package salesforce
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
 	"blendbase/integrations"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
 
 	"blendbase/misc/db_utils"
 	"blendbase/misc/test_utils"
@@ -44,7 +53,7 @@ func init() {
 	log.Debugf("Consumer %+#v", consumer)
 
 	var err error
-	client, err = LoadClientFromDB(app, &consumer)
+	client, err = LoadClientFromDB(app, &consumer, nil)
 	if err != nil {
 		log.Fatalf("Could not load client from DB: %s", err)
 	}
@@ -54,7 +63,7 @@ func init() {
 
 func TestListContacts(t *testing.T) {
 	ctx := context.Background()
-	contactConnection, err := client.ListContacts(ctx, 10, nil)
+	contactConnection, err := client.ListContacts(ctx, &connectors.ListParams{First: 10})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, contactConnection, "expecting non-nil result")
@@ -67,7 +76,7 @@ func TestListContacts(t *testing.T) {
 
 func TestListContactsPagination(t *testing.T) {
 	ctx := context.Background()
-	contactConnection, err := client.ListContacts(ctx, 1, nil)
+	contactConnection, err := client.ListContacts(ctx, &connectors.ListParams{First: 1})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, contactConnection.Edges, "expecting non-nil result")
@@ -85,7 +94,7 @@ func TestListContactsPagination(t *testing.T) {
 		contactConnection.Edges[0].Node.ID,
 		"expecting EndCursor to be equal to the last contact ID")
 
-	contactConnection, err = client.ListContacts(ctx, 1, afterParam)
+	contactConnection, err = client.ListContacts(ctx, &connectors.ListParams{First: 1, After: afterParam})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, contactConnection.Edges, "expecting non-nil result")
@@ -148,7 +157,7 @@ func TestDeleteContact(t *testing.T) {
 
 func TestListOpportunitiesPagination(t *testing.T) {
 	ctx := context.Background()
-	connection, err := client.ListOpportunities(ctx, 1, nil)
+	connection, err := client.ListOpportunities(ctx, &connectors.ListParams{First: 1})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, connection.Edges, "expecting non-nil result")
@@ -166,7 +175,7 @@ func TestListOpportunitiesPagination(t *testing.T) {
 		connection.Edges[0].Node.ID,
 		"expecting EndCursor to be equal to the last ID")
 
-	connection, err = client.ListOpportunities(ctx, 1, afterParam)
+	connection, err = client.ListOpportunities(ctx, &connectors.ListParams{First: 1, After: afterParam})
 
 	assert.Nil(t, err, "expecting nil error")
 	assert.NotNil(t, connection.Edges, "expecting non-nil result")
@@ -213,3 +222,472 @@ func TestCreateOpportunityAndAddNote(t *testing.T) {
 	assert.NotEmpty(t, note.ID, "expecting a non-empty ID for the note")
 	assert.Equal(t, note.Content, noteInput.Content, "expecting a content for the note equal to the content requested")
 }
+
+func TestCompanyCRUD(t *testing.T) {
+	ctx := context.Background()
+	input := test_utils.GenerateCompanyInput()
+
+	company, err := client.CreateCompany(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, company.ID, "expecting a non-empty ID for the company")
+	assert.Equal(t, input.Name, company.Name, "expecting a name for the company equal to the name requested")
+
+	connection, err := client.ListCompanies(ctx, &connectors.ListParams{First: 10})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(connection.Edges), 0, "expecting more than zero companies")
+
+	input = test_utils.GenerateCompanyInput()
+
+	success, err := client.UpdateCompany(ctx, company.ID, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	foundCompany, err := client.GetCompany(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, input.Name, foundCompany.Name, "expecting a name for the company equal to the updated one")
+
+	success, err = client.DeleteCompany(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true archive result")
+}
+
+func TestMapCompanyAnnualRevenue(t *testing.T) {
+	annualRevenue := 1000.0
+	company := (&SFAccount{AnnualRevenue: &annualRevenue}).mapCompanyProperties()
+	assert.Equal(t, "1000", *company.AnnualRevenue, "expecting the annual revenue without trailing zeros")
+
+	annualRevenue = 1250000.5
+	company = (&SFAccount{AnnualRevenue: &annualRevenue}).mapCompanyProperties()
+	assert.Equal(t, "1250000.5", *company.AnnualRevenue, "expecting the annual revenue to keep its decimals")
+}
+
+func TestLinkContactToCompany(t *testing.T) {
+	ctx := context.Background()
+
+	company, err := client.CreateCompany(ctx, test_utils.GenerateCompanyInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	contact, err := client.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	success, err := client.LinkContactToCompany(ctx, contact.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true link result")
+
+	linkedContact, err := client.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, company.ID, linkedContact.Company.ID, "expecting the contact to reference the linked company")
+	assert.Equal(t, company.Name, *linkedContact.CompanyName, "expecting the company name to be filled")
+
+	companyContacts, err := client.ListCompanyContacts(ctx, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(companyContacts), "expecting a single contact of the company")
+
+	success, err = client.UnlinkContactFromCompany(ctx, contact.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true unlink result")
+
+	unlinkedContact, err := client.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Nil(t, unlinkedContact.Company, "expecting no company after unlinking")
+
+	client.DeleteContact(ctx, contact.ID)
+	client.DeleteCompany(ctx, company.ID)
+}
+
+func TestLeadCRUD(t *testing.T) {
+	ctx := context.Background()
+	input := test_utils.GenerateLeadInput()
+
+	lead, err := client.CreateLead(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, lead.ID, "expecting a non-empty ID for the lead")
+
+	connection, err := client.ListLeads(ctx, &connectors.ListParams{First: 10})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(connection.Edges), 0, "expecting more than zero leads")
+
+	input = test_utils.GenerateLeadInput()
+
+	success, err := client.UpdateLead(ctx, lead.ID, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	foundLead, err := client.GetLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, *input.Email, *foundLead.Email, "expecting an email for the lead equal to the updated one")
+
+	success, err = client.DeleteLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true archive result")
+}
+
+func TestConvertLead(t *testing.T) {
+	ctx := context.Background()
+
+	lead, err := client.CreateLead(ctx, test_utils.GenerateLeadInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	result, err := client.ConvertLead(ctx, lead.ID, &model.LeadConversionInput{})
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, result.ContactID, "expecting a non-empty ID for the converted contact")
+	assert.NotNil(t, result.CompanyID, "expecting a company to be created for the converted lead")
+	assert.NotNil(t, result.OpportunityID, "expecting an opportunity to be created for the converted lead")
+
+	foundLead, err := client.GetLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, *foundLead.Converted, "expecting the lead to be marked as converted")
+}
+
+func TestCreateContactTaskAndComplete(t *testing.T) {
+	ctx := context.Background()
+
+	contact, err := client.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	taskInput := test_utils.GenerateTaskInput()
+	task, err := client.CreateContactTask(ctx, contact.ID, taskInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotEmpty(t, task.ID, "expecting a non-empty ID for the task")
+	assert.Equal(t, taskInput.Subject, task.Subject, "expecting a subject for the task equal to the subject requested")
+
+	completed := model.TaskStatusCompleted
+	taskInput.Status = &completed
+
+	success, err := client.UpdateTask(ctx, task.ID, taskInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting true update result")
+
+	tasks, err := client.ListContactTasks(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Len(t, tasks, 1, "expecting the created task to be listed for the contact")
+	assert.Equal(t, completed, *tasks[0].Status, "expecting the task to be completed")
+}
+
+func TestLogContactActivities(t *testing.T) {
+	ctx := context.Background()
+
+	contact, err := client.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	for _, activityType := range model.AllActivityType {
+		activityInput := test_utils.GenerateActivityInput(activityType)
+		activity, err := client.CreateContactActivity(ctx, contact.ID, activityInput)
+		assert.Nil(t, err, "expecting nil error")
+		assert.NotEmpty(t, activity.ID, "expecting a non-empty ID for the activity")
+		assert.Equal(t, activityType, activity.Type, "expecting a type for the activity equal to the type requested")
+	}
+
+	activities, err := client.ListContactActivities(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Len(t, activities, len(model.AllActivityType), "expecting all the logged activities to be listed for the contact")
+}
+
+func TestListUsersAndAssignOwner(t *testing.T) {
+	ctx := context.Background()
+
+	connection, err := client.ListUsers(ctx, &connectors.ListParams{First: 10})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(connection.Edges), 0, "expecting more than zero users")
+
+	owner := connection.Edges[0].Node
+	foundUser, err := client.GetUser(ctx, owner.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, owner.ID, foundUser.ID, "expecting a ID for the user equal to the ID requested")
+
+	input := test_utils.GenerateContactInput()
+	input.OwnerID = &owner.ID
+
+	contact, err := client.CreateContact(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+
+	foundContact, err := client.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotNil(t, foundContact.Owner, "expecting the contact to have an owner")
+	assert.Equal(t, owner.ID, foundContact.Owner.ID, "expecting the owner of the contact to be the assigned user")
+}
+
+func TestListPipelines(t *testing.T) {
+	ctx := context.Background()
+
+	pipelines, err := client.ListPipelines(ctx)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(pipelines), 0, "expecting more than zero pipelines")
+	assert.Greater(t, len(pipelines[0].Stages), 0, "expecting more than zero stages in the pipeline")
+
+	wonStages := 0
+	for _, stage := range pipelines[0].Stages {
+		if stage.IsWon {
+			assert.True(t, stage.IsClosed, "expecting a won stage to be closed")
+			wonStages += 1
+		}
+	}
+	assert.Greater(t, wonStages, 0, "expecting the pipeline to have a won stage")
+}
+
+func TestListContactsWithFilter(t *testing.T) {
+	ctx := context.Background()
+	input := test_utils.GenerateContactInput()
+
+	contact, err := client.CreateContact(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+
+	filter := connectors.NewContactFilter(&model.ContactFilter{
+		Email: &model.StringFilter{Eq: input.Email},
+	})
+	contactConnection, err := client.ListContacts(ctx, &connectors.ListParams{First: 10, Filter: filter})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Len(t, contactConnection.Edges, 1, "expecting a single contact with the email")
+	assert.Equal(t, contact.ID, contactConnection.Edges[0].Node.ID, "expecting the contact with the email to be listed")
+
+	contactConnection, err = client.ListContacts(ctx, &connectors.ListParams{First: 10, Query: input.LastName})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(contactConnection.Edges), 0, "expecting the search to find the contact")
+}
+
+func TestCompileSOQLFilter(t *testing.T) {
+	closeDate := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	stageName := "Closed Won"
+	amount := "1000"
+	name := "O'Brien 100%"
+
+	filter := connectors.NewOpportunityFilter(&model.OpportunityFilter{
+		Amount:    &model.DecimalFilter{Gte: &amount},
+		CloseDate: &model.DateTimeFilter{Lte: &closeDate},
+		Or: []*model.OpportunityFilter{
+			{StageName: &model.StringFilter{Eq: &stageName}},
+			{Name: &model.StringFilter{Contains: &name}},
+		},
+	})
+
+	whereClause, err := sfListWhereClause(&connectors.ListParams{Filter: filter}, sfOpportunityFilterFields, sfOpportunitySearchFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t,
+		`(Amount >= 1000 AND CloseDate <= 2022-03-01 AND ((StageName = 'Closed Won') OR (Name LIKE '%O\'Brien 100\%%')))`,
+		whereClause,
+		"expecting the filter to be compiled to the SOQL condition",
+	)
+
+	for _, invalidAmount := range []string{"NaN", "Inf", "1e400", "0x10", "1000 OR Amount > 0"} {
+		amount := invalidAmount
+		filter = connectors.NewOpportunityFilter(&model.OpportunityFilter{Amount: &model.DecimalFilter{Eq: &amount}})
+
+		_, err = sfListWhereClause(&connectors.ListParams{Filter: filter}, sfOpportunityFilterFields, sfOpportunitySearchFields)
+		assert.EqualError(t, err, fmt.Sprintf("invalid decimal %q for amount", amount), "expecting only plain decimals for a number field")
+	}
+
+	name = "1000"
+	filter = connectors.NewOpportunityFilter(&model.OpportunityFilter{Name: &model.StringFilter{Eq: &name}})
+	whereClause, err = sfListWhereClause(&connectors.ListParams{Filter: filter}, sfOpportunityFilterFields, sfOpportunitySearchFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, `(Name = '1000')`, whereClause, "expecting a string field to be quoted whatever the value looks like")
+}
+
+func TestListContactsSortedByUpdatedAt(t *testing.T) {
+	ctx := context.Background()
+	direction := model.SortDirectionDesc
+	orderBy := []*model.SortInput{{Field: "updatedAt", Direction: &direction}}
+
+	firstPage, err := client.ListContacts(ctx, &connectors.ListParams{First: 2, OrderBy: orderBy})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Greater(t, len(firstPage.Edges), 0, "expecting more than zero contacts")
+
+	secondPage, err := client.ListContacts(ctx, &connectors.ListParams{First: 2, After: firstPage.PageInfo.EndCursor, OrderBy: orderBy})
+	assert.Nil(t, err, "expecting nil error")
+
+	lastSeen := firstPage.Edges[len(firstPage.Edges)-1].Node
+	for _, edge := range secondPage.Edges {
+		assert.NotEqual(t, lastSeen.ID, edge.Node.ID, "expecting the next page to start after the cursor")
+		assert.False(t, edge.Node.UpdatedAt.After(*lastSeen.UpdatedAt), "expecting the most recently updated contacts first")
+	}
+}
+
+func TestSOQLKeysetCondition(t *testing.T) {
+	direction := model.SortDirectionDesc
+	sorts, err := sfSorts([]*model.SortInput{{Field: "amount", Direction: &direction}, {Field: "name"}}, sfOpportunityFilterFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "Amount DESC NULLS LAST, Name ASC NULLS FIRST, Id ASC", sfOrderByClause(sorts, false), "expecting the ID to break the ties")
+
+	amount := "1000"
+	cursor := connectors.EncodeSortCursor([]*string{&amount, nil}, "006000000000001")
+	condition, err := sfCursorCondition(cursor, sorts, false)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t,
+		"(Amount < 1000 OR Amount = null OR (Amount = 1000 AND (Name != null OR (Name = null AND Id > '006000000000001'))))",
+		condition,
+		"expecting the condition to match the records after the cursor",
+	)
+
+	assert.Equal(t, "Amount ASC NULLS FIRST, Name DESC NULLS LAST, Id DESC", sfOrderByClause(sorts, true), "expecting the reverse order for backward pagination")
+	condition, err = sfCursorCondition(cursor, sorts, true)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t,
+		"(Amount > 1000 OR (Amount = 1000 AND (Name = null AND Id < '006000000000001')))",
+		condition,
+		"expecting the condition to match the records before the cursor",
+	)
+
+	_, err = sfCursorCondition(connectors.EncodeCursor("006000000000001"), sorts, false)
+	assert.NotNil(t, err, "expecting an error for a cursor of another order")
+
+	largeAmount := float32(2500000)
+	cursor = sfRecordCursor(&SFOpportunity{Id: "006000000000002", Amount: &largeAmount}, "006000000000002", sorts)
+	condition, err = sfCursorCondition(cursor, sorts, false)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Contains(t, condition, "Amount < 2500000 OR", "expecting the cursor to hold the amount as a plain decimal")
+
+	_, err = sfSorts([]*model.SortInput{{Field: "pipelineId"}}, sfOpportunityFilterFields)
+	assert.NotNil(t, err, "expecting an error for a field that can't be sorted by")
+}
+
+func TestListContactsBackward(t *testing.T) {
+	ctx := context.Background()
+
+	firstPage, err := client.ListContacts(ctx, &connectors.ListParams{First: 2, IncludeTotalCount: true})
+	assert.Nil(t, err, "expecting nil error")
+	assert.False(t, firstPage.PageInfo.HasPreviousPage, "expecting no previous page for the first page")
+	assert.NotNil(t, firstPage.TotalCount, "expecting the total count")
+	assert.GreaterOrEqual(t, *firstPage.TotalCount, len(firstPage.Edges), "expecting the total count to include the page")
+
+	secondPage, err := client.ListContacts(ctx, &connectors.ListParams{First: 2, After: firstPage.PageInfo.EndCursor})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, secondPage.PageInfo.HasPreviousPage, "expecting a previous page for the second page")
+
+	last := 2
+	previousPage, err := client.ListContacts(ctx, &connectors.ListParams{Last: &last, Before: secondPage.PageInfo.StartCursor})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, previousPage.PageInfo.HasNextPage, "expecting a next page before the cursor")
+	assert.False(t, previousPage.PageInfo.HasPreviousPage, "expecting the first page before the second one")
+	assert.Equal(t, firstPage.Edges, previousPage.Edges, "expecting the back button to return the first page")
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{InstanceURL: server.URL, HTTPClient: server.Client()}
+}
+
+func TestListContactChangesMapsEachRecord(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/services/data/v53.0/query", r.URL.Path, "expecting a SOQL query")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"totalSize": 2,
+			"done":      true,
+			"records": []map[string]string{
+				{"Id": "003A", "FirstName": "Ada", "Email": "ada@example.com", "LastModifiedDate": "2022-06-01T10:00:00.000+0000"},
+				{"Id": "003B", "FirstName": "Grace", "Email": "grace@example.com", "LastModifiedDate": "2022-06-01T11:00:00.000+0000"},
+			},
+		})
+	})
+
+	since := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	params, err := connectors.NewChangesParams(&since, 10, nil, []model.ChangeObjectType{model.ChangeObjectTypeContact})
+	assert.Nil(t, err, "expecting nil error")
+
+	batch, err := c.listContactChanges(params)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 2, len(batch.Changes), "expecting a change for each record")
+	assert.Equal(t, "Ada", *batch.Changes[0].Contact.FirstName, "expecting the first change to keep the fields of its record")
+	assert.Equal(t, "ada@example.com", *batch.Changes[0].Contact.Email, "expecting the first change to keep the fields of its record")
+	assert.Equal(t, "Grace", *batch.Changes[1].Contact.FirstName, "expecting the second change to keep the fields of its record")
+}
+
+func TestSFDeletedRange(t *testing.T) {
+	now := time.Date(2022, 6, 30, 12, 30, 45, 0, time.UTC)
+
+	start, end := sfDeletedRange(time.Date(2022, 6, 29, 8, 15, 30, 0, time.UTC), now)
+	assert.Equal(t, time.Date(2022, 6, 29, 8, 15, 0, 0, time.UTC), start, "expecting the range to start at the minute of the bound")
+	assert.Equal(t, time.Date(2022, 6, 30, 12, 31, 0, 0, time.UTC), end, "expecting the range to end after now")
+
+	start, _ = sfDeletedRange(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), now)
+	assert.Equal(t, time.Date(2022, 5, 31, 12, 31, 0, 0, time.UTC), start, "expecting an older bound to start within the 30 days of getDeleted")
+	assert.False(t, start.Before(now.Add(-SF_DELETED_RECORDS_WINDOW)), "expecting getDeleted to accept the start")
+}
+
+func TestListChangesSince(t *testing.T) {
+	ctx := context.Background()
+	since := time.Now().UTC().Add(-time.Minute)
+
+	contact, err := client.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	opportunity, err := client.CreateOpportunity(ctx, test_utils.GenerateOpportunityInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	_, err = client.DeleteOpportunity(ctx, opportunity.ID)
+	assert.Nil(t, err, "expecting nil error")
+
+	params, err := connectors.NewChangesParams(&since, 100, nil, nil)
+	assert.Nil(t, err, "expecting nil error")
+
+	changeConnection, err := client.ListChanges(ctx, params)
+	assert.Nil(t, err, "expecting nil error")
+
+	changeTypes := map[string]model.ChangeType{}
+	for _, edge := range changeConnection.Edges {
+		changeTypes[edge.Node.ObjectID] = edge.Node.ChangeType
+	}
+	assert.Equal(t, model.ChangeTypeCreated, changeTypes[contact.ID], "expecting the new contact in the changes")
+	assert.Equal(t, model.ChangeTypeDeleted, changeTypes[opportunity.ID], "expecting the deleted opportunity in the changes")
+}
+
+func TestMapObjectDescription(t *testing.T) {
+	data, err := os.ReadFile("schema/contact.describe.json")
+	assert.Nil(t, err, "expecting nil error")
+
+	sfDescription := SFObjectDescription{}
+	assert.Nil(t, json.Unmarshal(data, &sfDescription), "expecting nil error")
+
+	description := sfDescription.mapProperties(model.CrmObjectContact)
+	assert.Equal(t, CONTACT_OBJECT, description.Name)
+	assert.Equal(t, len(sfDescription.Fields), len(description.Fields), "expecting every field to be described")
+
+	fields := map[string]*model.FieldDescription{}
+	for _, field := range description.Fields {
+		fields[field.Name] = field
+	}
+
+	assert.True(t, fields["LastName"].Required, "expecting the last name to be required")
+	assert.False(t, fields["OwnerId"].Required, "expecting the owner to be filled in by Salesforce")
+	assert.True(t, fields["Id"].Readonly, "expecting the ID to be readonly")
+	assert.False(t, fields["Email"].Readonly, "expecting the email to be writable")
+	assert.Nil(t, fields["Email"].PicklistValues, "expecting no values for a text field")
+	assert.Equal(t, "picklist", fields["Salutation"].Type)
+	assert.Equal(t, &model.PicklistValue{Value: "Mr.", Label: "Mr."}, fields["Salutation"].PicklistValues[0])
+}
+
+func TestDescribeObject(t *testing.T) {
+	ctx := context.Background()
+
+	description, err := client.DescribeObject(ctx, model.CrmObjectOpportunity)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, OPPORTUNITY_OBJECT, description.Name)
+	assert.Greater(t, len(description.Fields), 0, "expecting more than zero fields")
+
+	_, err = client.DescribeObject(ctx, model.CrmObjectPipeline)
+	assert.NotNil(t, err, "expecting an error for an object that can't be described")
+}
+
+func TestLoginAndInstanceURLs(t *testing.T) {
+	loginUrl, err := ValidateSalesforceURL("https://Acme--UAT.sandbox.my.salesforce.com/")
+	assert.Nil(t, err, "expecting a My Domain to be accepted")
+	assert.Equal(t, "https://acme--uat.sandbox.my.salesforce.com", loginUrl, "expecting the origin of the URL")
+
+	_, err = ValidateSalesforceURL("https://login.salesforce.com.example.com")
+	assert.NotNil(t, err, "expecting another host to be rejected")
+	_, err = ValidateSalesforceURL("http://test.salesforce.com")
+	assert.NotNil(t, err, "expecting an http URL to be rejected")
+
+	customSettings, err := oauth2CustomSettings(&model.OAuth2ConfigurationInput{SalesforceLoginURL: &loginUrl})
+	assert.Nil(t, err, "expecting the subdomain to be optional")
+
+	consumerOAuthConfig := integrations.ConsumerOauth2Configuration{}
+	consumerOAuthConfig.SetCustomSettings(customSettings)
+	assert.Equal(t, "https://acme--uat.sandbox.my.salesforce.com/services/oauth2/token", getOAuthConfig(&consumerOAuthConfig).Endpoint.TokenURL, "expecting the token URL of the login URL")
+
+	token := (&oauth2.Token{AccessToken: "00D"}).WithExtra(map[string]interface{}{"instance_url": "https://acme--uat.sandbox.my.salesforce.com"})
+	assert.Nil(t, storeInstanceURL(token, &consumerOAuthConfig), "expecting the instance URL to be stored")
+	assert.Equal(t, "https://acme--uat.sandbox.my.salesforce.com", getInstanceURL(&consumerOAuthConfig), "expecting the instance URL of the token")
+}
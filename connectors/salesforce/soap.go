@@ -0,0 +1,144 @@
+package salesforce
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// Some operations (e.g. lead conversion) are only available through the SOAP API.
+// SOAP API accepts the OAuth2 access token as the session ID.
+// https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_quickstart_intro.htm
+
+const (
+	SOAP_ENVELOPE_NAMESPACE    = "http://schemas.xmlsoap.org/soap/envelope/"
+	SOAP_PARTNER_NAMESPACE     = "urn:partner.soap.sforce.com"
+	SOAP_INVALID_SESSION_FAULT = "INVALID_SESSION_ID"
+)
+
+type SFSOAPRequestEnvelope struct {
+	XMLName    xml.Name `xml:"soapenv:Envelope"`
+	EnvelopeNS string   `xml:"xmlns:soapenv,attr"`
+	PartnerNS  string   `xml:"xmlns:urn,attr"`
+	SessionID  string   `xml:"soapenv:Header>urn:SessionHeader>urn:sessionId"`
+	Body       struct {
+		Content interface{}
+	} `xml:"soapenv:Body"`
+}
+
+type SFSOAPResponseEnvelope struct {
+	Body struct {
+		Fault   *SFSOAPFault `xml:"Fault"`
+		Content []byte       `xml:",innerxml"`
+	} `xml:"Body"`
+}
+
+type SFSOAPFault struct {
+	FaultCode   string `xml:"faultcode"`
+	FaultString string `xml:"faultstring"`
+}
+
+type SFSOAPError struct {
+	StatusCode string `xml:"statusCode"`
+	Message    string `xml:"message"`
+}
+
+// Sends a SOAP API call wrapped into the request envelope (the request struct should define
+// its own XMLName inside soapenv:Body) and decodes the body of the response into the response struct
+func (client *Client) sendSOAPRequest(request interface{}, response interface{}) error {
+	res, err := client.doSOAPRequest(request)
+	if err != nil {
+		return err
+	}
+
+	if res.Body.Fault != nil && strings.Contains(res.Body.Fault.FaultCode, SOAP_INVALID_SESSION_FAULT) {
+		if err := client.refreshToken(); err != nil {
+			return err
+		}
+
+		// retry
+		res, err = client.doSOAPRequest(request)
+		if err != nil {
+			return err
+		}
+	}
+
+	if res.Body.Fault != nil {
+		return fmt.Errorf("%s: %s", res.Body.Fault.FaultCode, res.Body.Fault.FaultString)
+	}
+
+	return xml.Unmarshal(res.Body.Content, response)
+}
+
+func (client *Client) doSOAPRequest(request interface{}) (*SFSOAPResponseEnvelope, error) {
+	sessionID, err := client.accessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := SFSOAPRequestEnvelope{
+		EnvelopeNS: SOAP_ENVELOPE_NAMESPACE,
+		PartnerNS:  SOAP_PARTNER_NAMESPACE,
+		SessionID:  sessionID,
+	}
+	envelope.Body.Content = request
+
+	encodedEnvelope, err := xml.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", client.soapUrl(), bytes.NewBuffer(append([]byte(xml.Header), encodedEnvelope...)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("SOAPAction", `""`)
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	log.WithFields(log.Fields{
+		"status_code": res.StatusCode,
+	}).Info("Salesforce SOAP request")
+
+	responseEnvelope := SFSOAPResponseEnvelope{}
+	if err := xml.NewDecoder(res.Body).Decode(&responseEnvelope); err != nil {
+		return nil, fmt.Errorf("unexpected SOAP response (status code %d): %s", res.StatusCode, err)
+	}
+
+	return &responseEnvelope, nil
+}
+
+// Returns the current access token of the HTTP client
+func (client *Client) accessToken() (string, error) {
+	transport, ok := client.HTTPClient.Transport.(*oauth2.Transport)
+	if !ok {
+		return client.consumerOAuthConfig.AccessToken.Raw, nil
+	}
+
+	token, err := transport.Source.Token()
+	if err != nil {
+		return "", errors.New("failed to get access token")
+	}
+
+	return token.AccessToken, nil
+}
+
+func formatSFSOAPErrors(errors []SFSOAPError) string {
+	messages := []string{}
+	for _, error := range errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", error.StatusCode, error.Message))
+	}
+
+	return strings.Join(messages, ", ")
+}
@@ -0,0 +1,161 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type sfSort struct {
+	Field      sfFilterField
+	Descending bool
+}
+
+// Maps the unified sort fields to Salesforce fields
+func sfSorts(orderBy []*model.SortInput, fields map[string]sfFilterField) ([]sfSort, error) {
+	sorts := make([]sfSort, len(orderBy))
+	for i, sort := range orderBy {
+		field, ok := fields[sort.Field]
+		if !ok || field.Constant != nil {
+			return nil, fmt.Errorf("sorting by %s is not supported", sort.Field)
+		}
+
+		sorts[i] = sfSort{Field: field, Descending: connectors.IsDescending(sort)}
+	}
+
+	return sorts, nil
+}
+
+// ORDER BY clause of the sorts, the ID is always the last one to make the order stable.
+// Backward pagination reads the records in the reverse order.
+func sfOrderByClause(sorts []sfSort, backward bool) string {
+	clauses := make([]string, 0, len(sorts)+1)
+	for _, sort := range sorts {
+		if sort.Descending != backward {
+			clauses = append(clauses, sort.Field.Name+" DESC NULLS LAST")
+		} else {
+			clauses = append(clauses, sort.Field.Name+" ASC NULLS FIRST")
+		}
+	}
+
+	if backward {
+		return strings.Join(append(clauses, "Id DESC"), ", ")
+	}
+
+	return strings.Join(append(clauses, "Id ASC"), ", ")
+}
+
+// Condition matching the records that come after the cursor in the sorted list,
+// or before the cursor when paginating backwards
+func sfCursorCondition(cursor string, sorts []sfSort, backward bool) (string, error) {
+	if len(sorts) == 0 {
+		return sfKeysetCondition(sorts, nil, connectors.DecodeCursor(cursor), backward), nil
+	}
+
+	sortCursor, err := connectors.DecodeSortCursor(cursor, len(sorts))
+	if err != nil {
+		return "", err
+	}
+
+	return sfKeysetCondition(sorts, sortCursor.Values, sortCursor.ID, backward), nil
+}
+
+// Records after the cursor either come after it by the first sort field,
+// or have the same value and come after it by the remaining fields
+func sfKeysetCondition(sorts []sfSort, values []*string, id string, backward bool) string {
+	if len(sorts) == 0 {
+		if backward {
+			return fmt.Sprintf("Id < %s", formatSOQLString(id))
+		}
+		return fmt.Sprintf("Id > %s", formatSOQLString(id))
+	}
+
+	field := sorts[0].Field
+	value := values[0]
+	descending := sorts[0].Descending != backward
+	next := sfKeysetCondition(sorts[1:], values[1:], id, backward)
+
+	var equal string
+	if value == nil {
+		equal = fmt.Sprintf("%s = null", field.Name)
+	} else {
+		equal = fmt.Sprintf("%s = %s", field.Name, formatSOQLSortValue(field, *value))
+	}
+	sameValue := fmt.Sprintf("(%s AND %s)", equal, next)
+
+	// nulls go first in the ascending order and last in the descending order
+	switch {
+	case !descending && value == nil:
+		return fmt.Sprintf("(%s != null OR %s)", field.Name, sameValue)
+	case !descending:
+		return fmt.Sprintf("(%s > %s OR %s)", field.Name, formatSOQLSortValue(field, *value), sameValue)
+	case value == nil:
+		return sameValue
+	default:
+		return fmt.Sprintf("(%s < %s OR %s = null OR %s)", field.Name, formatSOQLSortValue(field, *value), field.Name, sameValue)
+	}
+}
+
+// Formats a value of the cursor, the values are stored the way Salesforce returns them
+func formatSOQLSortValue(field sfFilterField, value string) string {
+	switch field.Type {
+	case SF_FIELD_TYPE_DATETIME:
+		if t, err := time.Parse(SALESFORCE_TIME_FORMAT, value); err == nil {
+			return formatSOQLValue(field, t)
+		}
+	case SF_FIELD_TYPE_DATE:
+		if t, err := time.Parse(SF_DATE_FORMAT, value); err == nil {
+			return formatSOQLValue(field, t)
+		}
+	}
+
+	return formatSOQLValue(field, value)
+}
+
+// Cursor of the record in the sorted list, the record is a Salesforce object struct
+func sfRecordCursor(record interface{}, id string, sorts []sfSort) string {
+	if len(sorts) == 0 {
+		return connectors.EncodeCursor(id)
+	}
+
+	recordValue := reflect.Indirect(reflect.ValueOf(record))
+	values := make([]*string, len(sorts))
+	for i, sort := range sorts {
+		// struct field names don't always have the same case as Salesforce fields, e.g. AccountID
+		fieldValue := recordValue.FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(name, sort.Field.Name)
+		})
+		values[i] = sfFieldValueString(fieldValue)
+	}
+
+	return connectors.EncodeSortCursor(values, id)
+}
+
+// Returns nil for null and empty values
+func sfFieldValueString(value reflect.Value) *string {
+	if !value.IsValid() {
+		return nil
+	}
+
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	str := fmt.Sprint(value.Interface())
+	if value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64 {
+		// plain decimals, e.g. "1000000" rather than "1e+06", so that SOQL accepts them
+		str = strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits())
+	}
+	if str == "" {
+		return nil
+	}
+
+	return &str
+}
@@ -0,0 +1,213 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TASK_OBJECT = "Task"
+
+	SF_TASK_SUBTYPE_TASK  = "Task"
+	SF_TASK_SUBTYPE_CALL  = "Call"
+	SF_TASK_SUBTYPE_EMAIL = "Email"
+
+	SF_DATE_FORMAT = "2006-01-02"
+)
+
+// Default values of the Status and Priority picklists
+var sfTaskStatuses = map[model.TaskStatus]string{
+	model.TaskStatusNotStarted: "Not Started",
+	model.TaskStatusInProgress: "In Progress",
+	model.TaskStatusWaiting:    "Waiting on someone else",
+	model.TaskStatusCompleted:  "Completed",
+	model.TaskStatusDeferred:   "Deferred",
+}
+
+var sfTaskPriorities = map[model.TaskPriority]string{
+	model.TaskPriorityLow:    "Low",
+	model.TaskPriorityNormal: "Normal",
+	model.TaskPriorityHigh:   "High",
+}
+
+type SFTasksListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFTask `json:"records"`
+}
+
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_task.htm
+type SFTask struct {
+	ID                    string  `json:"Id"`
+	Subject               string  `json:"Subject"`
+	Description           string  `json:"Description"`
+	Status                string  `json:"Status"`
+	Priority              string  `json:"Priority"`
+	ActivityDate          *string `json:"ActivityDate"`
+	TaskSubtype           string  `json:"TaskSubtype"`
+	CallDurationInSeconds *int    `json:"CallDurationInSeconds"`
+	WhoId                 *string `json:"WhoId"`
+	WhatId                *string `json:"WhatId"`
+
+	IsDeleted        bool   `json:"IsDeleted"`
+	CreatedDate      string `json:"CreatedDate"`
+	LastModifiedDate string `json:"LastModifiedDate"`
+}
+
+type SFTaskCreateUpdatePayload struct {
+	Subject               string  `json:"Subject"`
+	Description           *string `json:"Description,omitempty"`
+	Status                *string `json:"Status,omitempty"`
+	Priority              *string `json:"Priority,omitempty"`
+	ActivityDate          *string `json:"ActivityDate,omitempty"`
+	TaskSubtype           *string `json:"TaskSubtype,omitempty"` // can be set only on creation
+	CallDurationInSeconds *int    `json:"CallDurationInSeconds,omitempty"`
+	WhoId                 *string `json:"WhoId,omitempty"`
+	WhatId                *string `json:"WhatId,omitempty"`
+}
+
+// Tasks are linked to contacts with the WhoId field and to opportunities with the WhatId field
+func (client *Client) ListContactTasks(ctx context.Context, contactId string) ([]*model.Task, error) {
+	return client.listTasks("WhoId", contactId)
+}
+
+func (client *Client) CreateContactTask(ctx context.Context, contactId string, input *model.TaskInput) (*model.Task, error) {
+	payload := createSFTaskPayload(input)
+	payload.WhoId = &contactId
+
+	return client.createTask(ctx, payload)
+}
+
+func (client *Client) ListOpportunityTasks(ctx context.Context, opportunityId string) ([]*model.Task, error) {
+	return client.listTasks("WhatId", opportunityId)
+}
+
+func (client *Client) CreateOpportunityTask(ctx context.Context, opportunityId string, input *model.TaskInput) (*model.Task, error) {
+	payload := createSFTaskPayload(input)
+	payload.WhatId = &opportunityId
+
+	return client.createTask(ctx, payload)
+}
+
+func (client *Client) UpdateTask(ctx context.Context, taskId string, input *model.TaskInput) (bool, error) {
+	payload := createSFTaskPayload(input)
+
+	success, err := client.update(TASK_OBJECT, taskId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating task #%s: %s", taskId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) listTasks(parentField string, parentId string) ([]*model.Task, error) {
+	response := SFTasksListSuccessResponse{}
+	err := client.listWithWhere(TASK_OBJECT, connectors.StructFieldNames(SFTask{}),
+		fmt.Sprintf("%s = '%s' AND TaskSubtype = '%s'", parentField, escapeSOQL(parentId), SF_TASK_SUBTYPE_TASK), &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, len(response.Records))
+	for i := range response.Records {
+		tasks[i] = response.Records[i].mapTaskProperties()
+	}
+
+	return tasks, nil
+}
+
+func (client *Client) createTask(ctx context.Context, payload *SFTaskCreateUpdatePayload) (*model.Task, error) {
+	subtype := SF_TASK_SUBTYPE_TASK
+	payload.TaskSubtype = &subtype
+
+	objectId, err := client.create(TASK_OBJECT, payload)
+	if err != nil {
+		log.Errorf("Error creating task: %s", err)
+		return nil, err
+	}
+
+	// Fetch all the fields after the creation
+	sfTask := SFTask{}
+	err = client.get(TASK_OBJECT, objectId, connectors.StructFieldNames(sfTask), &sfTask)
+	if err != nil {
+		log.Errorf("Error fetching task #%s after creation with :%s", objectId, err)
+		return nil, err
+	}
+
+	return sfTask.mapTaskProperties(), nil
+}
+
+// Creates Salesforce Task Update/Create payload from GraphQL input
+func createSFTaskPayload(input *model.TaskInput) *SFTaskCreateUpdatePayload {
+	payload := SFTaskCreateUpdatePayload{
+		Subject:     input.Subject,
+		Description: input.Description,
+	}
+
+	if input.Status != nil {
+		status := sfTaskStatuses[*input.Status]
+		payload.Status = &status
+	}
+
+	if input.Priority != nil {
+		priority := sfTaskPriorities[*input.Priority]
+		payload.Priority = &priority
+	}
+
+	if input.DueDate != nil {
+		activityDate := input.DueDate.Format(SF_DATE_FORMAT)
+		payload.ActivityDate = &activityDate
+	}
+
+	return &payload
+}
+
+func (sfTask *SFTask) mapTaskProperties() *model.Task {
+	task := model.Task{
+		ID:          sfTask.ID,
+		Subject:     sfTask.Subject,
+		Description: &sfTask.Description,
+		DueDate:     parseSFDate(sfTask.ActivityDate),
+		CreatedAt:   parseSFDateTime(&sfTask.CreatedDate),
+		UpdatedAt:   parseSFDateTime(&sfTask.LastModifiedDate),
+	}
+
+	// custom picklist values have no unified counterpart
+	for status, sfStatus := range sfTaskStatuses {
+		if sfStatus == sfTask.Status {
+			status := status
+			task.Status = &status
+		}
+	}
+
+	for priority, sfPriority := range sfTaskPriorities {
+		if sfPriority == sfTask.Priority {
+			priority := priority
+			task.Priority = &priority
+		}
+	}
+
+	return &task
+}
+
+func parseSFDate(date *string) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	t, err := time.Parse(SF_DATE_FORMAT, *date)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"date": *date,
+		}).Error("Failed to parse date")
+		return nil
+	}
+
+	return &t
+}
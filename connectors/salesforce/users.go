@@ -0,0 +1,116 @@
+package salesforce
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	USER_OBJECT = "User"
+)
+
+type SFUsersListSuccessResponse struct {
+	SFListQuerySuccessResponseBase
+	Records []SFUser `json:"records"`
+}
+
+// Users can't be deleted in Salesforce, they are deactivated instead
+// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_user.htm
+type SFUser struct {
+	ID        string `json:"Id"`
+	Name      string `json:"Name"`
+	FirstName string `json:"FirstName"`
+	LastName  string `json:"LastName"`
+	Email     string `json:"Email"`
+	IsActive  bool   `json:"IsActive"`
+
+	CreatedDate      string `json:"CreatedDate"`
+	LastModifiedDate string `json:"LastModifiedDate"`
+}
+
+func (client *Client) ListUsers(ctx context.Context, params *connectors.ListParams) (*model.UserConnection, error) {
+	sorts, err := sfSorts(params.OrderBy, sfUserFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SFUsersListSuccessResponse{}
+	err = client.list(
+		USER_OBJECT,
+		connectors.StructFieldNames(SFUser{}),
+		params,
+		"",
+		sorts,
+		&response,
+	)
+
+	if err != nil {
+		log.Errorf("Error listing users: %s", err)
+		return nil, err
+	}
+
+	var user *model.User
+	edges := make([]*model.UserEdge, len(response.Records))
+	for i := range response.Records {
+		sfUser := &response.Records[i]
+		user = sfUser.mapUserProperties()
+		edges[i] = &model.UserEdge{
+			Cursor: sfRecordCursor(sfUser, user.ID, sorts),
+			Node:   user,
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &edges)
+
+	connection := model.UserConnection{
+		Edges:    recordsValue.Interface().([]*model.UserEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(USER_OBJECT, "")
+		if err != nil {
+			log.Errorf("Error counting users: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetUser(ctx context.Context, userId string) (*model.User, error) {
+	response := SFUser{}
+	err := client.get(
+		USER_OBJECT,
+		userId,
+		connectors.StructFieldNames(SFUser{}),
+		&response,
+	)
+
+	if err != nil {
+		log.Errorf("Error getting user: %s", err)
+		return nil, err
+	}
+
+	return response.mapUserProperties(), nil
+}
+
+func (sfUser *SFUser) mapUserProperties() *model.User {
+	archived := !sfUser.IsActive
+
+	return &model.User{
+		ID:        sfUser.ID,
+		Name:      &sfUser.Name,
+		FirstName: &sfUser.FirstName,
+		LastName:  &sfUser.LastName,
+		Email:     &sfUser.Email,
+		Active:    &sfUser.IsActive,
+		Archived:  &archived,
+		CreatedAt: parseSFDateTime(&sfUser.CreatedDate),
+		UpdatedAt: parseSFDateTime(&sfUser.LastModifiedDate),
+	}
+}
@@ -0,0 +1,91 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (client *Client) ListContactActivities(ctx context.Context, contactId string) ([]*model.Activity, error) {
+	return client.listActivities(ctx, sandboxContactParent, contactId)
+}
+
+func (client *Client) CreateContactActivity(ctx context.Context, contactId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivity(ctx, sandboxContactParent, contactId, input)
+}
+
+func (client *Client) ListOpportunityActivities(ctx context.Context, opportunityId string) ([]*model.Activity, error) {
+	return client.listActivities(ctx, sandboxOpportunityParent, opportunityId)
+}
+
+func (client *Client) CreateOpportunityActivity(ctx context.Context, opportunityId string, input *model.ActivityInput) (*model.Activity, error) {
+	return client.createActivity(ctx, sandboxOpportunityParent, opportunityId, input)
+}
+
+func (client *Client) listActivities(ctx context.Context, parent sandboxParent, recordId string) ([]*model.Activity, error) {
+	id, err := client.parentID(ctx, parent, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxActivities := []SandboxActivity{}
+	if err := client.scoped(ctx).Where(parent.Column+" = ?", id).Order("id asc").Find(&sandboxActivities).Error; err != nil {
+		log.Errorf("Error listing activities of %s #%s: %s", parent.Name, recordId, err)
+		return nil, err
+	}
+
+	activities := make([]*model.Activity, len(sandboxActivities))
+	for i := range sandboxActivities {
+		activities[i] = sandboxActivities[i].mapActivityProperties()
+	}
+	connectors.SortActivities(activities)
+
+	return activities, nil
+}
+
+func (client *Client) createActivity(ctx context.Context, parent sandboxParent, recordId string, input *model.ActivityInput) (*model.Activity, error) {
+	id, err := client.parentID(ctx, parent, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxActivity := SandboxActivity{
+		Record:      Record{IntegrationID: client.IntegrationID},
+		Type:        input.Type.String(),
+		Subject:     input.Subject,
+		Description: input.Description,
+		StartTime:   input.StartTime,
+		EndTime:     input.EndTime,
+	}
+	sandboxActivity.ContactID, sandboxActivity.OpportunityID = parent.childIDs(id)
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxActivity).Error; err != nil {
+		log.Errorf("Error creating activity: %s", err)
+		return nil, err
+	}
+
+	return sandboxActivity.mapActivityProperties(), nil
+}
+
+func (sandboxActivity *SandboxActivity) mapActivityProperties() *model.Activity {
+	activity := model.Activity{
+		ID:          formatSandboxID(sandboxActivity.ID),
+		Type:        model.ActivityType(sandboxActivity.Type),
+		Subject:     sandboxActivity.Subject,
+		Description: sandboxActivity.Description,
+	}
+	activity.CreatedAt, activity.UpdatedAt = sandboxActivity.timestamps()
+
+	if sandboxActivity.StartTime != nil {
+		startTime := sandboxActivity.StartTime.UTC()
+		activity.StartTime = &startTime
+	}
+	if sandboxActivity.EndTime != nil {
+		endTime := sandboxActivity.EndTime.UTC()
+		activity.EndTime = &endTime
+	}
+
+	return &activity
+}
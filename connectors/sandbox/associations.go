@@ -0,0 +1,58 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+)
+
+// Contacts and opportunities belong to a single company, linking sets the company of the record
+// and unlinking clears it when the record is linked to the given company.
+
+func (client *Client) LinkContactToCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	return client.setCompany(ctx, "contact", contactId, &SandboxContact{}, companyId)
+}
+
+func (client *Client) UnlinkContactFromCompany(ctx context.Context, contactId string, companyId string) (bool, error) {
+	return client.clearCompany(ctx, "contact", contactId, &SandboxContact{}, companyId)
+}
+
+func (client *Client) LinkOpportunityToCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	return client.setCompany(ctx, "opportunity", opportunityId, &SandboxOpportunity{}, companyId)
+}
+
+func (client *Client) UnlinkOpportunityFromCompany(ctx context.Context, opportunityId string, companyId string) (bool, error) {
+	return client.clearCompany(ctx, "opportunity", opportunityId, &SandboxOpportunity{}, companyId)
+}
+
+func (client *Client) setCompany(ctx context.Context, name string, recordId string, record interface{}, companyId string) (bool, error) {
+	id, err := client.reference(ctx, "company", &SandboxCompany{}, &companyId)
+	if err != nil {
+		return false, err
+	}
+	if id == nil {
+		return false, fmt.Errorf("invalid ID %s", companyId)
+	}
+
+	return client.update(ctx, name, recordId, record, map[string]interface{}{"company_id": id})
+}
+
+func (client *Client) clearCompany(ctx context.Context, name string, recordId string, record interface{}, companyId string) (bool, error) {
+	id, err := parseSandboxID(recordId)
+	if err != nil {
+		return false, err
+	}
+	linkedCompanyId, err := parseSandboxID(companyId)
+	if err != nil {
+		return false, err
+	}
+
+	result := client.scoped(ctx).Model(record).Where("id = ?", id).Where("company_id = ?", linkedCompanyId).Update("company_id", nil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, fmt.Errorf("%s #%s is not linked to company #%s", name, recordId, companyId)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,155 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// deleted records are changed when they're deleted
+	SANDBOX_CHANGED_AT = "COALESCE(deleted_at, updated_at)"
+)
+
+// Created, updated and deleted records are read by their change times in the order of the feed,
+// so every source reads a single page of its changes
+func (client *Client) ListChanges(ctx context.Context, params *connectors.ChangesParams) (*model.ChangeConnection, error) {
+	batches := []*connectors.ChangeBatch{}
+	sources := []struct {
+		objectType model.ChangeObjectType
+		list       func(context.Context, *connectors.ChangesParams) (*connectors.ChangeBatch, error)
+	}{
+		{model.ChangeObjectTypeContact, client.listContactChanges},
+		{model.ChangeObjectTypeNote, client.listNoteChanges},
+		{model.ChangeObjectTypeOpportunity, client.listOpportunityChanges},
+	}
+
+	for _, source := range sources {
+		if !params.Includes(source.objectType) {
+			continue
+		}
+
+		batch, err := source.list(ctx, params)
+		if err != nil {
+			log.Errorf("Error listing changes of %s: %s", source.objectType, err)
+			return nil, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return connectors.NewChangeConnection(params, batches), nil
+}
+
+func (client *Client) listContactChanges(ctx context.Context, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	sandboxContacts := []SandboxContact{}
+	hasMore, err := client.listChanged(ctx, model.ChangeObjectTypeContact, params, &sandboxContacts, "Company")
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(sandboxContacts))
+	for i := range sandboxContacts {
+		if changes[i] = sandboxContacts[i].deletedChange(model.ChangeObjectTypeContact); changes[i] == nil {
+			contact := client.mapContact(&sandboxContacts[i])
+			changes[i] = params.NewChange(model.ChangeObjectTypeContact, contact.ID, contact.CreatedAt, contact.UpdatedAt)
+			changes[i].Contact = contact
+		}
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: hasMore}, nil
+}
+
+func (client *Client) listNoteChanges(ctx context.Context, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	sandboxNotes := []SandboxNote{}
+	hasMore, err := client.listChanged(ctx, model.ChangeObjectTypeNote, params, &sandboxNotes)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(sandboxNotes))
+	for i := range sandboxNotes {
+		if changes[i] = sandboxNotes[i].deletedChange(model.ChangeObjectTypeNote); changes[i] == nil {
+			note := sandboxNotes[i].mapNoteProperties()
+			changes[i] = params.NewChange(model.ChangeObjectTypeNote, note.ID, note.CreatedAt, note.UpdatedAt)
+			changes[i].Note = note
+		}
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: hasMore}, nil
+}
+
+func (client *Client) listOpportunityChanges(ctx context.Context, params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	sandboxOpportunities := []SandboxOpportunity{}
+	hasMore, err := client.listChanged(ctx, model.ChangeObjectTypeOpportunity, params, &sandboxOpportunities, "Company")
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(sandboxOpportunities))
+	for i := range sandboxOpportunities {
+		if changes[i] = sandboxOpportunities[i].deletedChange(model.ChangeObjectTypeOpportunity); changes[i] == nil {
+			opportunity := client.mapOpportunity(&sandboxOpportunities[i])
+			changes[i] = params.NewChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.CreatedAt, opportunity.UpdatedAt)
+			changes[i].Opportunity = opportunity
+		}
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: hasMore}, nil
+}
+
+// Reads the records changed after the bound of the object type, deleted records included.
+// Returns true when there are more changes than the page of the feed.
+func (client *Client) listChanged(ctx context.Context, objectType model.ChangeObjectType, params *connectors.ChangesParams, records interface{}, preloads ...string) (bool, error) {
+	condition := sandboxChangesCondition(params.Bound(objectType))
+	query := client.scoped(ctx).Unscoped().Where(condition.SQL, condition.Args...)
+	for _, preload := range preloads {
+		query = query.Preload(preload)
+	}
+
+	if err := query.Order(SANDBOX_CHANGED_AT + " asc, id asc").Limit(params.First + 1).Find(records).Error; err != nil {
+		return false, err
+	}
+
+	recordsValue := reflect.ValueOf(records).Elem()
+	if recordsValue.Len() > params.First {
+		recordsValue.Set(recordsValue.Slice(0, params.First))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func sandboxChangesCondition(bound connectors.ChangeBound) *sandboxCondition {
+	if bound.Exclusive {
+		return &sandboxCondition{SQL: SANDBOX_CHANGED_AT + " > ?", Args: []interface{}{bound.Time}}
+	}
+
+	if bound.After != nil {
+		if id, err := parseSandboxID(*bound.After); err == nil {
+			return &sandboxCondition{
+				SQL:  "(" + SANDBOX_CHANGED_AT + " > ? OR (" + SANDBOX_CHANGED_AT + " = ? AND id > ?))",
+				Args: []interface{}{bound.Time, bound.Time, id},
+			}
+		}
+	}
+
+	return &sandboxCondition{SQL: SANDBOX_CHANGED_AT + " >= ?", Args: []interface{}{bound.Time}}
+}
+
+// Change of the deleted record, nil when the record isn't deleted
+func (record *Record) deletedChange(objectType model.ChangeObjectType) *model.Change {
+	if !record.DeletedAt.Valid {
+		return nil
+	}
+
+	return &model.Change{
+		ObjectType: objectType,
+		ObjectID:   formatSandboxID(record.ID),
+		ChangeType: model.ChangeTypeDeleted,
+		ChangedAt:  record.DeletedAt.Time.UTC(),
+	}
+}
@@ -0,0 +1,164 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (client *Client) ListCompanies(ctx context.Context, params *connectors.ListParams) (*model.CompanyConnection, error) {
+	sandboxCompanies := []SandboxCompany{}
+	sorts, hasMore, err := client.listPage(ctx, params, sandboxCompanyList, &sandboxCompanies)
+	if err != nil {
+		log.Errorf("Error listing companies: %s", err)
+		return nil, err
+	}
+
+	companyEdges := make([]*model.CompanyEdge, len(sandboxCompanies))
+	for i := range sandboxCompanies {
+		companyEdges[i] = &model.CompanyEdge{
+			Cursor: sandboxRecordCursor(&sandboxCompanies[i], sandboxCompanies[i].ID, sorts),
+			Node:   sandboxCompanies[i].mapCompanyProperties(),
+		}
+	}
+
+	return &model.CompanyConnection{
+		Edges:    companyEdges,
+		PageInfo: sandboxPageInfo(params, hasMore, &companyEdges),
+	}, nil
+}
+
+func (client *Client) GetCompany(ctx context.Context, companyId string) (*model.Company, error) {
+	sandboxCompany := SandboxCompany{}
+	if err := client.get(ctx, "company", companyId, &sandboxCompany); err != nil {
+		return nil, err
+	}
+
+	return sandboxCompany.mapCompanyProperties(), nil
+}
+
+func (client *Client) CreateCompany(ctx context.Context, input *model.CompanyInput) (*model.Company, error) {
+	sandboxCompany := SandboxCompany{
+		Record:            Record{IntegrationID: client.IntegrationID},
+		Name:              input.Name,
+		Website:           input.Website,
+		Phone:             input.Phone,
+		Industry:          input.Industry,
+		Description:       input.Description,
+		City:              input.City,
+		Country:           input.Country,
+		NumberOfEmployees: input.NumberOfEmployees,
+	}
+
+	var err error
+	if sandboxCompany.AnnualRevenue, err = parseSandboxAmount(input.AnnualRevenue); err != nil {
+		return nil, err
+	}
+	if sandboxCompany.OwnerID, err = client.reference(ctx, "user", &SandboxUser{}, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxCompany).Error; err != nil {
+		log.Errorf("Error creating company: %s", err)
+		return nil, err
+	}
+
+	return client.GetCompany(ctx, formatSandboxID(sandboxCompany.ID))
+}
+
+func (client *Client) UpdateCompany(ctx context.Context, companyId string, input *model.CompanyInput) (bool, error) {
+	values := map[string]interface{}{}
+	if input.Name != "" {
+		values["name"] = input.Name
+	}
+	setValue(values, "website", input.Website)
+	setValue(values, "phone", input.Phone)
+	setValue(values, "industry", input.Industry)
+	setValue(values, "description", input.Description)
+	setValue(values, "city", input.City)
+	setValue(values, "country", input.Country)
+	setValue(values, "number_of_employees", input.NumberOfEmployees)
+
+	if input.AnnualRevenue != nil {
+		annualRevenue, err := parseSandboxAmount(input.AnnualRevenue)
+		if err != nil {
+			return false, err
+		}
+		values["annual_revenue"] = annualRevenue
+	}
+
+	if input.OwnerID != nil {
+		ownerId, err := client.reference(ctx, "user", &SandboxUser{}, input.OwnerID)
+		if err != nil {
+			return false, err
+		}
+		values["owner_id"] = ownerId
+	}
+
+	return client.update(ctx, "company", companyId, &SandboxCompany{}, values)
+}
+
+func (client *Client) DeleteCompany(ctx context.Context, companyId string) (bool, error) {
+	return client.delete(ctx, "company", companyId, &SandboxCompany{})
+}
+
+func (client *Client) ListCompanyContacts(ctx context.Context, companyId string) ([]*model.Contact, error) {
+	id, err := parseSandboxID(companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxContacts := []SandboxContact{}
+	if err := client.scoped(ctx).Preload("Company").Where("company_id = ?", id).Order("id asc").Find(&sandboxContacts).Error; err != nil {
+		log.Errorf("Error listing contacts of company #%s: %s", companyId, err)
+		return nil, err
+	}
+
+	contacts := make([]*model.Contact, len(sandboxContacts))
+	for i := range sandboxContacts {
+		contacts[i] = client.mapContact(&sandboxContacts[i])
+	}
+
+	return contacts, nil
+}
+
+func (client *Client) ListCompanyOpportunities(ctx context.Context, companyId string) ([]*model.Opportunity, error) {
+	id, err := parseSandboxID(companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxOpportunities := []SandboxOpportunity{}
+	if err := client.scoped(ctx).Preload("Company").Where("company_id = ?", id).Order("id asc").Find(&sandboxOpportunities).Error; err != nil {
+		log.Errorf("Error listing opportunities of company #%s: %s", companyId, err)
+		return nil, err
+	}
+
+	opportunities := make([]*model.Opportunity, len(sandboxOpportunities))
+	for i := range sandboxOpportunities {
+		opportunities[i] = client.mapOpportunity(&sandboxOpportunities[i])
+	}
+
+	return opportunities, nil
+}
+
+func (sandboxCompany *SandboxCompany) mapCompanyProperties() *model.Company {
+	company := model.Company{
+		ID:                formatSandboxID(sandboxCompany.ID),
+		Name:              sandboxCompany.Name,
+		Website:           sandboxCompany.Website,
+		Phone:             sandboxCompany.Phone,
+		Industry:          sandboxCompany.Industry,
+		Description:       sandboxCompany.Description,
+		City:              sandboxCompany.City,
+		Country:           sandboxCompany.Country,
+		NumberOfEmployees: sandboxCompany.NumberOfEmployees,
+		AnnualRevenue:     sandboxCompany.AnnualRevenue,
+		Owner:             sandboxUserReference(sandboxCompany.OwnerID),
+	}
+	company.CreatedAt, company.UpdatedAt = sandboxCompany.timestamps()
+
+	return &company
+}
@@ -0,0 +1,170 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (client *Client) ListContacts(ctx context.Context, params *connectors.ListParams) (*model.ContactConnection, error) {
+	sandboxContacts := []SandboxContact{}
+	sorts, hasMore, err := client.listPage(ctx, params, sandboxContactList, &sandboxContacts)
+	if err != nil {
+		log.Errorf("Error listing contacts: %s", err)
+		return nil, err
+	}
+
+	contactEdges := make([]*model.ContactEdge, len(sandboxContacts))
+	for i := range sandboxContacts {
+		contactEdges[i] = &model.ContactEdge{
+			Cursor: sandboxRecordCursor(&sandboxContacts[i], sandboxContacts[i].ID, sorts),
+			Node:   client.mapContact(&sandboxContacts[i]),
+		}
+	}
+
+	connection := model.ContactConnection{
+		Edges:    contactEdges,
+		PageInfo: sandboxPageInfo(params, hasMore, &contactEdges),
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(ctx, params, sandboxContactList, &SandboxContact{})
+		if err != nil {
+			log.Errorf("Error counting contacts: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetContact(ctx context.Context, contactId string) (*model.Contact, error) {
+	sandboxContact := SandboxContact{}
+	if err := client.get(ctx, "contact", contactId, &sandboxContact, "Company"); err != nil {
+		return nil, err
+	}
+
+	return client.mapContact(&sandboxContact), nil
+}
+
+func (client *Client) CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error) {
+	sandboxContact := SandboxContact{
+		Record:      Record{IntegrationID: client.IntegrationID},
+		FirstName:   input.FirstName,
+		LastName:    input.LastName,
+		Email:       input.Email,
+		Phone:       input.Phone,
+		Website:     input.Website,
+		CompanyName: input.CompanyName,
+	}
+
+	var err error
+	if sandboxContact.CustomFields, err = client.customFieldsJSON(model.CrmObjectContact, input.CustomFields); err != nil {
+		return nil, err
+	}
+	if sandboxContact.CompanyID, err = client.reference(ctx, "company", &SandboxCompany{}, input.CompanyID); err != nil {
+		return nil, err
+	}
+	if sandboxContact.OwnerID, err = client.reference(ctx, "user", &SandboxUser{}, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxContact).Error; err != nil {
+		log.Errorf("Error creating contact: %s", err)
+		return nil, err
+	}
+
+	return client.GetContact(ctx, formatSandboxID(sandboxContact.ID))
+}
+
+func (client *Client) UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error) {
+	values, err := client.contactValues(ctx, input)
+	if err != nil {
+		return false, err
+	}
+
+	return client.update(ctx, "contact", contactId, &SandboxContact{}, values)
+}
+
+func (client *Client) DeleteContact(ctx context.Context, contactId string) (bool, error) {
+	return client.delete(ctx, "contact", contactId, &SandboxContact{})
+}
+
+// Columns of the contact the input updates, the company and the owner have to be in the sandbox
+func (client *Client) contactValues(ctx context.Context, input *model.ContactInput) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	setValue(values, "first_name", input.FirstName)
+	setValue(values, "last_name", input.LastName)
+	setValue(values, "email", input.Email)
+	setValue(values, "phone", input.Phone)
+	setValue(values, "website", input.Website)
+	setValue(values, "company_name", input.CompanyName)
+
+	if input.CompanyID != nil {
+		companyId, err := client.reference(ctx, "company", &SandboxCompany{}, input.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		values["company_id"] = companyId
+	}
+
+	if input.OwnerID != nil {
+		ownerId, err := client.reference(ctx, "user", &SandboxUser{}, input.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		values["owner_id"] = ownerId
+	}
+
+	if err := client.setCustomFields(values, model.CrmObjectContact, input.CustomFields); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// Maps the contact with its custom fields
+func (client *Client) mapContact(sandboxContact *SandboxContact) *model.Contact {
+	contact := sandboxContact.mapContactProperties()
+	contact.CustomFields = client.customFields(model.CrmObjectContact, sandboxContact.CustomFields)
+
+	return contact
+}
+
+func (sandboxContact *SandboxContact) mapContactProperties() *model.Contact {
+	contact := model.Contact{
+		ID:          formatSandboxID(sandboxContact.ID),
+		FirstName:   sandboxContact.FirstName,
+		LastName:    sandboxContact.LastName,
+		Email:       sandboxContact.Email,
+		Phone:       sandboxContact.Phone,
+		Website:     sandboxContact.Website,
+		CompanyName: sandboxContact.CompanyName,
+		Company:     sandboxCompanyReference(sandboxContact.CompanyID, sandboxContact.Company),
+		Owner:       sandboxUserReference(sandboxContact.OwnerID),
+	}
+	contact.CreatedAt, contact.UpdatedAt = sandboxContact.timestamps()
+
+	if contact.Company != nil && contact.Company.Name != "" {
+		contact.CompanyName = &contact.Company.Name
+	}
+
+	name := strings.TrimSpace(strings.Join([]string{stringValue(sandboxContact.FirstName), stringValue(sandboxContact.LastName)}, " "))
+	if name != "" {
+		contact.Name = &name
+	}
+
+	return &contact
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
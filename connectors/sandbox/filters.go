@@ -0,0 +1,399 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SANDBOX_FIELD_TEXT    = "text"
+	SANDBOX_FIELD_ID      = "id"
+	SANDBOX_FIELD_NUMERIC = "numeric"
+	SANDBOX_FIELD_TIME    = "time"
+)
+
+// Column of a unified field, the name of the field of the record struct is used to build the cursors
+type sandboxField struct {
+	Column string
+	Field  string
+	Type   string
+}
+
+// Fields a list can be filtered and sorted by, the columns of its free-text search
+// and the associations read with the records
+type sandboxList struct {
+	Fields        map[string]sandboxField
+	SearchColumns []string
+	Preloads      []string
+}
+
+// Condition of a query with the arguments of its placeholders
+type sandboxCondition struct {
+	SQL  string
+	Args []interface{}
+}
+
+type sandboxSort struct {
+	Field      sandboxField
+	Descending bool
+}
+
+var sandboxContactList = sandboxList{
+	Fields: map[string]sandboxField{
+		"firstName": {"first_name", "FirstName", SANDBOX_FIELD_TEXT},
+		"lastName":  {"last_name", "LastName", SANDBOX_FIELD_TEXT},
+		"email":     {"email", "Email", SANDBOX_FIELD_TEXT},
+		"phone":     {"phone", "Phone", SANDBOX_FIELD_TEXT},
+		"companyId": {"company_id", "CompanyID", SANDBOX_FIELD_ID},
+		"ownerId":   {"owner_id", "OwnerID", SANDBOX_FIELD_ID},
+		"createdAt": {"created_at", "CreatedAt", SANDBOX_FIELD_TIME},
+		"updatedAt": {"updated_at", "UpdatedAt", SANDBOX_FIELD_TIME},
+	},
+	SearchColumns: []string{"first_name", "last_name", "email", "phone"},
+	Preloads:      []string{"Company"},
+}
+
+var sandboxOpportunityList = sandboxList{
+	Fields: map[string]sandboxField{
+		"name":       {"name", "Name", SANDBOX_FIELD_TEXT},
+		"stageName":  {"stage_id", "StageID", SANDBOX_FIELD_TEXT},
+		"pipelineId": {"pipeline_id", "PipelineID", SANDBOX_FIELD_TEXT},
+		"companyId":  {"company_id", "CompanyID", SANDBOX_FIELD_ID},
+		"ownerId":    {"owner_id", "OwnerID", SANDBOX_FIELD_ID},
+		"amount":     {"amount", "Amount", SANDBOX_FIELD_NUMERIC},
+		"closeDate":  {"close_date", "CloseDate", SANDBOX_FIELD_TIME},
+		"createdAt":  {"created_at", "CreatedAt", SANDBOX_FIELD_TIME},
+		"updatedAt":  {"updated_at", "UpdatedAt", SANDBOX_FIELD_TIME},
+	},
+	SearchColumns: []string{"name"},
+	Preloads:      []string{"Company"},
+}
+
+var sandboxCompanyList = sandboxList{
+	Fields: map[string]sandboxField{
+		"name":      {"name", "Name", SANDBOX_FIELD_TEXT},
+		"createdAt": {"created_at", "CreatedAt", SANDBOX_FIELD_TIME},
+		"updatedAt": {"updated_at", "UpdatedAt", SANDBOX_FIELD_TIME},
+	},
+	SearchColumns: []string{"name"},
+}
+
+var sandboxUserList = sandboxList{
+	Fields: map[string]sandboxField{
+		"firstName": {"first_name", "FirstName", SANDBOX_FIELD_TEXT},
+		"lastName":  {"last_name", "LastName", SANDBOX_FIELD_TEXT},
+		"email":     {"email", "Email", SANDBOX_FIELD_TEXT},
+		"createdAt": {"created_at", "CreatedAt", SANDBOX_FIELD_TIME},
+		"updatedAt": {"updated_at", "UpdatedAt", SANDBOX_FIELD_TIME},
+	},
+}
+
+var sandboxLeadList = sandboxList{
+	Fields: map[string]sandboxField{
+		"firstName":   {"first_name", "FirstName", SANDBOX_FIELD_TEXT},
+		"lastName":    {"last_name", "LastName", SANDBOX_FIELD_TEXT},
+		"email":       {"email", "Email", SANDBOX_FIELD_TEXT},
+		"companyName": {"company_name", "CompanyName", SANDBOX_FIELD_TEXT},
+		"status":      {"status", "Status", SANDBOX_FIELD_TEXT},
+		"createdAt":   {"created_at", "CreatedAt", SANDBOX_FIELD_TIME},
+		"updatedAt":   {"updated_at", "UpdatedAt", SANDBOX_FIELD_TIME},
+	},
+}
+
+// Compiles the filter tree to a condition, returns nil when the filter has no conditions
+func compileSandboxFilter(filter *connectors.Filter, fields map[string]sandboxField) (*sandboxCondition, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	conditions := []*sandboxCondition{}
+	for _, condition := range filter.Conditions {
+		compiled, err := compileSandboxCondition(condition, fields)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, compiled)
+	}
+
+	for _, and := range filter.And {
+		compiled, err := compileSandboxFilter(and, fields)
+		if err != nil {
+			return nil, err
+		}
+		if compiled != nil {
+			conditions = append(conditions, compiled)
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		alternatives := []*sandboxCondition{}
+		for _, or := range filter.Or {
+			compiled, err := compileSandboxFilter(or, fields)
+			if err != nil {
+				return nil, err
+			}
+			if compiled == nil {
+				// an empty alternative matches all the records
+				alternatives = nil
+				break
+			}
+			alternatives = append(alternatives, compiled)
+		}
+
+		if alternatives != nil {
+			conditions = append(conditions, joinSandboxConditions(alternatives, " OR "))
+		}
+	}
+
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	return joinSandboxConditions(conditions, " AND "), nil
+}
+
+func compileSandboxCondition(condition connectors.FilterCondition, fields map[string]sandboxField) (*sandboxCondition, error) {
+	field, ok := fields[condition.Field]
+	if !ok {
+		return nil, fmt.Errorf("filtering by %s is not supported", condition.Field)
+	}
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ:
+		value, err := sandboxFieldValue(field, condition.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &sandboxCondition{SQL: field.Column + " = ?", Args: []interface{}{value}}, nil
+	case connectors.FILTER_OPERATOR_IN:
+		values, ok := condition.Value.([]string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value of %s", condition.Field)
+		}
+		if len(values) == 0 {
+			return &sandboxCondition{SQL: "FALSE"}, nil
+		}
+
+		args := make([]interface{}, len(values))
+		for i, value := range values {
+			arg, err := sandboxFieldValue(field, value)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = arg
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ")
+		return &sandboxCondition{SQL: field.Column + " IN (" + placeholders + ")", Args: args}, nil
+	case connectors.FILTER_OPERATOR_CONTAINS:
+		value, ok := condition.Value.(string)
+		if !ok || field.Type != SANDBOX_FIELD_TEXT {
+			return nil, fmt.Errorf("contains is not supported for %s", condition.Field)
+		}
+		return &sandboxCondition{SQL: field.Column + " ILIKE ?", Args: []interface{}{sandboxLikePattern(value)}}, nil
+	case connectors.FILTER_OPERATOR_GTE, connectors.FILTER_OPERATOR_LTE:
+		value, err := sandboxFieldValue(field, condition.Value)
+		if err != nil {
+			return nil, err
+		}
+		operator := " >= ?"
+		if condition.Operator == connectors.FILTER_OPERATOR_LTE {
+			operator = " <= ?"
+		}
+		return &sandboxCondition{SQL: field.Column + operator, Args: []interface{}{value}}, nil
+	}
+
+	return nil, fmt.Errorf("unknown filter operator %s", condition.Operator)
+}
+
+// Records containing the query in any of the columns
+func sandboxSearchCondition(query string, columns []string) *sandboxCondition {
+	conditions := make([]*sandboxCondition, len(columns))
+	for i, column := range columns {
+		conditions[i] = &sandboxCondition{SQL: column + " ILIKE ?", Args: []interface{}{sandboxLikePattern(query)}}
+	}
+
+	return joinSandboxConditions(conditions, " OR ")
+}
+
+func joinSandboxConditions(conditions []*sandboxCondition, separator string) *sandboxCondition {
+	clauses := make([]string, len(conditions))
+	args := []interface{}{}
+	for i, condition := range conditions {
+		clauses[i] = "(" + condition.SQL + ")"
+		args = append(args, condition.Args...)
+	}
+
+	return &sandboxCondition{SQL: strings.Join(clauses, separator), Args: args}
+}
+
+// The wildcards of the value are matched literally
+func sandboxLikePattern(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	return "%" + escaped + "%"
+}
+
+// Validates the value of the filter or the cursor, values are passed to the database as they are
+// except IDs that are parsed
+func sandboxFieldValue(field sandboxField, value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case time.Time:
+		if field.Type != SANDBOX_FIELD_TIME {
+			return nil, fmt.Errorf("invalid value of %s", field.Column)
+		}
+		return value, nil
+	case string:
+		switch field.Type {
+		case SANDBOX_FIELD_ID:
+			return parseSandboxID(value)
+		case SANDBOX_FIELD_NUMERIC:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("invalid amount %s", value)
+			}
+			return value, nil
+		case SANDBOX_FIELD_TIME:
+			parsed, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid time %s", value)
+			}
+			return parsed, nil
+		}
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("invalid value of %s", field.Column)
+}
+
+// Maps the unified sort fields to the columns
+func sandboxSorts(orderBy []*model.SortInput, fields map[string]sandboxField) ([]sandboxSort, error) {
+	sorts := make([]sandboxSort, len(orderBy))
+	for i, sort := range orderBy {
+		field, ok := fields[sort.Field]
+		if !ok {
+			return nil, fmt.Errorf("sorting by %s is not supported", sort.Field)
+		}
+
+		sorts[i] = sandboxSort{Field: field, Descending: connectors.IsDescending(sort)}
+	}
+
+	return sorts, nil
+}
+
+// Order of the sorts, the ID is always the last one to make the order stable.
+// Nulls go first in the ascending order and last in the descending order.
+// Backward pagination reads the records in the reverse order.
+func sandboxOrderBy(sorts []sandboxSort, backward bool) string {
+	clauses := make([]string, 0, len(sorts)+1)
+	for _, sort := range sorts {
+		if sort.Descending != backward {
+			clauses = append(clauses, sort.Field.Column+" DESC NULLS LAST")
+		} else {
+			clauses = append(clauses, sort.Field.Column+" ASC NULLS FIRST")
+		}
+	}
+
+	if backward {
+		return strings.Join(append(clauses, "id DESC"), ", ")
+	}
+
+	return strings.Join(append(clauses, "id ASC"), ", ")
+}
+
+// Condition matching the records that come after the cursor in the sorted list,
+// or before the cursor when paginating backwards
+func sandboxCursorCondition(cursor string, sorts []sandboxSort, backward bool) (*sandboxCondition, error) {
+	if len(sorts) == 0 {
+		return sandboxKeysetCondition(sorts, nil, connectors.DecodeCursor(cursor), backward)
+	}
+
+	sortCursor, err := connectors.DecodeSortCursor(cursor, len(sorts))
+	if err != nil {
+		return nil, err
+	}
+
+	return sandboxKeysetCondition(sorts, sortCursor.Values, sortCursor.ID, backward)
+}
+
+// Records after the cursor either come after it by the first sort field,
+// or have the same value and come after it by the remaining fields
+func sandboxKeysetCondition(sorts []sandboxSort, values []*string, cursorId string, backward bool) (*sandboxCondition, error) {
+	if len(sorts) == 0 {
+		id, err := parseSandboxID(cursorId)
+		if err != nil {
+			return nil, errors.New("invalid cursor")
+		}
+
+		if backward {
+			return &sandboxCondition{SQL: "id < ?", Args: []interface{}{id}}, nil
+		}
+		return &sandboxCondition{SQL: "id > ?", Args: []interface{}{id}}, nil
+	}
+
+	column := sorts[0].Field.Column
+	descending := sorts[0].Descending != backward
+	next, err := sandboxKeysetCondition(sorts[1:], values[1:], cursorId, backward)
+	if err != nil {
+		return nil, err
+	}
+
+	if values[0] == nil {
+		sameValue := sandboxCondition{SQL: fmt.Sprintf("(%s IS NULL AND %s)", column, next.SQL), Args: next.Args}
+		if descending {
+			// nulls go last in the descending order
+			return &sameValue, nil
+		}
+		return &sandboxCondition{SQL: fmt.Sprintf("(%s IS NOT NULL OR %s)", column, sameValue.SQL), Args: sameValue.Args}, nil
+	}
+
+	value, err := sandboxFieldValue(sorts[0].Field, *values[0])
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	args := append([]interface{}{value, value}, next.Args...)
+
+	if descending {
+		return &sandboxCondition{SQL: fmt.Sprintf("(%s < ? OR %s IS NULL OR (%s = ? AND %s))", column, column, column, next.SQL), Args: args}, nil
+	}
+
+	return &sandboxCondition{SQL: fmt.Sprintf("(%s > ? OR (%s = ? AND %s))", column, column, next.SQL), Args: args}, nil
+}
+
+// Cursor of the record in the sorted list, the record is a pointer to a sandbox record
+func sandboxRecordCursor(record interface{}, id uint64, sorts []sandboxSort) string {
+	if len(sorts) == 0 {
+		return connectors.EncodeCursor(formatSandboxID(id))
+	}
+
+	recordValue := reflect.Indirect(reflect.ValueOf(record))
+	values := make([]*string, len(sorts))
+	for i, sort := range sorts {
+		values[i] = sandboxValueString(recordValue.FieldByName(sort.Field.Field))
+	}
+
+	return connectors.EncodeSortCursor(values, formatSandboxID(id))
+}
+
+// Returns nil for null values, times are formatted with their fractions of a second
+func sandboxValueString(value reflect.Value) *string {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	var str string
+	switch value := value.Interface().(type) {
+	case time.Time:
+		str = value.UTC().Format(time.RFC3339Nano)
+	default:
+		str = fmt.Sprint(value)
+	}
+
+	return &str
+}
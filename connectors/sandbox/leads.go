@@ -0,0 +1,237 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"errors"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	SANDBOX_LEAD_STATUS_NEW       = "New"
+	SANDBOX_LEAD_STATUS_CONVERTED = "Converted"
+)
+
+// Converted leads stay in the list with the converted flag
+func (client *Client) ListLeads(ctx context.Context, params *connectors.ListParams) (*model.LeadConnection, error) {
+	sandboxLeads := []SandboxLead{}
+	sorts, hasMore, err := client.listPage(ctx, params, sandboxLeadList, &sandboxLeads)
+	if err != nil {
+		log.Errorf("Error listing leads: %s", err)
+		return nil, err
+	}
+
+	leadEdges := make([]*model.LeadEdge, len(sandboxLeads))
+	for i := range sandboxLeads {
+		leadEdges[i] = &model.LeadEdge{
+			Cursor: sandboxRecordCursor(&sandboxLeads[i], sandboxLeads[i].ID, sorts),
+			Node:   sandboxLeads[i].mapLeadProperties(),
+		}
+	}
+
+	connection := model.LeadConnection{
+		Edges:    leadEdges,
+		PageInfo: sandboxPageInfo(params, hasMore, &leadEdges),
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(ctx, params, sandboxLeadList, &SandboxLead{})
+		if err != nil {
+			log.Errorf("Error counting leads: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetLead(ctx context.Context, leadId string) (*model.Lead, error) {
+	sandboxLead := SandboxLead{}
+	if err := client.get(ctx, "lead", leadId, &sandboxLead); err != nil {
+		return nil, err
+	}
+
+	return sandboxLead.mapLeadProperties(), nil
+}
+
+func (client *Client) CreateLead(ctx context.Context, input *model.LeadInput) (*model.Lead, error) {
+	sandboxLead := SandboxLead{
+		Record:      Record{IntegrationID: client.IntegrationID},
+		FirstName:   input.FirstName,
+		LastName:    input.LastName,
+		Email:       input.Email,
+		Phone:       input.Phone,
+		Website:     input.Website,
+		CompanyName: input.CompanyName,
+		Title:       input.Title,
+		Status:      input.Status,
+	}
+
+	if sandboxLead.Status == nil {
+		status := SANDBOX_LEAD_STATUS_NEW
+		sandboxLead.Status = &status
+	}
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxLead).Error; err != nil {
+		log.Errorf("Error creating lead: %s", err)
+		return nil, err
+	}
+
+	return sandboxLead.mapLeadProperties(), nil
+}
+
+func (client *Client) UpdateLead(ctx context.Context, leadId string, input *model.LeadInput) (bool, error) {
+	values := map[string]interface{}{}
+	setValue(values, "first_name", input.FirstName)
+	setValue(values, "last_name", input.LastName)
+	setValue(values, "email", input.Email)
+	setValue(values, "phone", input.Phone)
+	setValue(values, "website", input.Website)
+	setValue(values, "company_name", input.CompanyName)
+	setValue(values, "title", input.Title)
+	setValue(values, "status", input.Status)
+
+	return client.update(ctx, "lead", leadId, &SandboxLead{}, values)
+}
+
+func (client *Client) DeleteLead(ctx context.Context, leadId string) (bool, error) {
+	return client.delete(ctx, "lead", leadId, &SandboxLead{})
+}
+
+// Converts the lead to a contact in a single transaction. The company is created from the company name
+// of the lead unless an existing company is given, the opportunity is created unless it's disabled.
+func (client *Client) ConvertLead(ctx context.Context, leadId string, input *model.LeadConversionInput) (*model.LeadConversionResult, error) {
+	result := model.LeadConversionResult{}
+
+	err := client.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txClient := Client{DB: tx, IntegrationID: client.IntegrationID}
+
+		sandboxLead := SandboxLead{}
+		if err := txClient.get(ctx, "lead", leadId, &sandboxLead); err != nil {
+			return err
+		}
+		if sandboxLead.Converted {
+			return errors.New("lead is already converted")
+		}
+
+		if input.CompanyID != nil {
+			result.CompanyID = input.CompanyID
+		} else if sandboxLead.CompanyName != nil && *sandboxLead.CompanyName != "" {
+			company, err := txClient.CreateCompany(ctx, &model.CompanyInput{
+				Name:    *sandboxLead.CompanyName,
+				Website: sandboxLead.Website,
+				Phone:   sandboxLead.Phone,
+			})
+			if err != nil {
+				return err
+			}
+			result.CompanyID = &company.ID
+		}
+
+		contact, err := txClient.CreateContact(ctx, &model.ContactInput{
+			FirstName:   sandboxLead.FirstName,
+			LastName:    sandboxLead.LastName,
+			Email:       sandboxLead.Email,
+			Phone:       sandboxLead.Phone,
+			Website:     sandboxLead.Website,
+			CompanyName: sandboxLead.CompanyName,
+			CompanyID:   result.CompanyID,
+		})
+		if err != nil {
+			return err
+		}
+		result.ContactID = contact.ID
+		contactId, _ := parseSandboxID(contact.ID)
+
+		if input.CreateOpportunity == nil || *input.CreateOpportunity {
+			opportunityId, err := txClient.createLeadConversionOpportunity(ctx, &sandboxLead, contact, result.CompanyID, input.OpportunityName)
+			if err != nil {
+				return err
+			}
+			result.OpportunityID = &opportunityId
+		}
+
+		status := SANDBOX_LEAD_STATUS_CONVERTED
+		if input.ConvertedStatus != nil {
+			status = *input.ConvertedStatus
+		}
+
+		_, err = txClient.update(ctx, "lead", leadId, &SandboxLead{}, map[string]interface{}{
+			"converted":            true,
+			"converted_contact_id": contactId,
+			"status":               status,
+		})
+		return err
+	})
+	if err != nil {
+		log.Errorf("Error converting lead #%s: %s", leadId, err)
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// The opportunity is created in the first stage of the default pipeline with the contact of the lead
+func (client *Client) createLeadConversionOpportunity(ctx context.Context, sandboxLead *SandboxLead, contact *model.Contact, companyId *string, opportunityName *string) (string, error) {
+	input := model.OpportunityInput{
+		Name:      sandboxLead.name(),
+		StageName: sandboxPipelines[0].Stages[0].ID,
+		CompanyID: companyId,
+	}
+	if sandboxLead.CompanyName != nil && *sandboxLead.CompanyName != "" {
+		input.Name = *sandboxLead.CompanyName
+	}
+	if opportunityName != nil {
+		input.Name = *opportunityName
+	}
+
+	opportunity, err := client.CreateOpportunity(ctx, &input)
+	if err != nil {
+		return "", err
+	}
+
+	opportunityId, _ := parseSandboxID(opportunity.ID)
+	contactId, _ := parseSandboxID(contact.ID)
+	opportunityContact := SandboxOpportunityContact{
+		IntegrationID: client.IntegrationID,
+		OpportunityID: opportunityId,
+		ContactID:     contactId,
+	}
+	if err := client.DB.WithContext(ctx).Create(&opportunityContact).Error; err != nil {
+		return "", err
+	}
+
+	return opportunity.ID, nil
+}
+
+func (sandboxLead *SandboxLead) name() string {
+	return strings.TrimSpace(strings.Join([]string{stringValue(sandboxLead.FirstName), stringValue(sandboxLead.LastName)}, " "))
+}
+
+func (sandboxLead *SandboxLead) mapLeadProperties() *model.Lead {
+	converted := sandboxLead.Converted
+	lead := model.Lead{
+		ID:          formatSandboxID(sandboxLead.ID),
+		FirstName:   sandboxLead.FirstName,
+		LastName:    sandboxLead.LastName,
+		Email:       sandboxLead.Email,
+		Phone:       sandboxLead.Phone,
+		Website:     sandboxLead.Website,
+		CompanyName: sandboxLead.CompanyName,
+		Title:       sandboxLead.Title,
+		Status:      sandboxLead.Status,
+		Converted:   &converted,
+	}
+	lead.CreatedAt, lead.UpdatedAt = sandboxLead.timestamps()
+
+	if name := sandboxLead.name(); name != "" {
+		lead.Name = &name
+	}
+
+	return &lead
+}
@@ -0,0 +1,133 @@
+package sandbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Columns every record of the sandbox has. Records belong to the integration they were created in,
+// deleted records are kept until the sandbox is reset so the change feed can list them.
+type Record struct {
+	ID            uint64    `gorm:"primaryKey"`
+	IntegrationID uuid.UUID `gorm:"type:UUID;index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+type SandboxUser struct {
+	Record
+	FirstName *string `gorm:"type:VARCHAR(255);"`
+	LastName  *string `gorm:"type:VARCHAR(255);"`
+	Email     *string `gorm:"type:VARCHAR(255);"`
+	Active    bool    `gorm:"default:true;"`
+}
+
+type SandboxCompany struct {
+	Record
+	Name              string  `gorm:"type:VARCHAR(255);"`
+	Website           *string `gorm:"type:VARCHAR(255);"`
+	Phone             *string `gorm:"type:VARCHAR(255);"`
+	Industry          *string `gorm:"type:VARCHAR(255);"`
+	Description       *string `gorm:"type:TEXT;"`
+	City              *string `gorm:"type:VARCHAR(255);"`
+	Country           *string `gorm:"type:VARCHAR(255);"`
+	NumberOfEmployees *int
+	AnnualRevenue     *string `gorm:"type:NUMERIC;"`
+	OwnerID           *uint64
+}
+
+type SandboxContact struct {
+	Record
+	FirstName    *string `gorm:"type:VARCHAR(255);"`
+	LastName     *string `gorm:"type:VARCHAR(255);"`
+	Email        *string `gorm:"type:VARCHAR(255);"`
+	Phone        *string `gorm:"type:VARCHAR(255);"`
+	Website      *string `gorm:"type:VARCHAR(255);"`
+	CompanyName  *string `gorm:"type:VARCHAR(255);"` // name of a company that isn't in the sandbox
+	CompanyID    *uint64
+	Company      *SandboxCompany
+	OwnerID      *uint64
+	CustomFields datatypes.JSON `gorm:"type:JSONB;"` // the custom fields by their names
+}
+
+type SandboxOpportunity struct {
+	Record
+	Name         string  `gorm:"type:VARCHAR(255);"`
+	Amount       *string `gorm:"type:NUMERIC;"`
+	PipelineID   string  `gorm:"type:VARCHAR(255);"`
+	StageID      string  `gorm:"type:VARCHAR(255);"`
+	CloseDate    *time.Time
+	CompanyID    *uint64
+	Company      *SandboxCompany
+	OwnerID      *uint64
+	CustomFields datatypes.JSON `gorm:"type:JSONB;"`
+}
+
+// Contacts of an opportunity
+type SandboxOpportunityContact struct {
+	IntegrationID uuid.UUID `gorm:"type:UUID;index"`
+	OpportunityID uint64    `gorm:"primaryKey"`
+	ContactID     uint64    `gorm:"primaryKey"`
+	CreatedAt     time.Time
+}
+
+// Notes, tasks and activities belong to either a contact or an opportunity
+type SandboxNote struct {
+	Record
+	ContactID     *uint64 `gorm:"index"`
+	OpportunityID *uint64 `gorm:"index"`
+	Content       string  `gorm:"type:TEXT;"`
+}
+
+type SandboxTask struct {
+	Record
+	ContactID     *uint64 `gorm:"index"`
+	OpportunityID *uint64 `gorm:"index"`
+	Subject       string  `gorm:"type:VARCHAR(255);"`
+	Description   *string `gorm:"type:TEXT;"`
+	Status        *string `gorm:"type:VARCHAR(255);"`
+	Priority      *string `gorm:"type:VARCHAR(255);"`
+	DueDate       *time.Time
+}
+
+type SandboxActivity struct {
+	Record
+	ContactID     *uint64 `gorm:"index"`
+	OpportunityID *uint64 `gorm:"index"`
+	Type          string  `gorm:"type:VARCHAR(255);"`
+	Subject       *string `gorm:"type:VARCHAR(255);"`
+	Description   *string `gorm:"type:TEXT;"`
+	StartTime     *time.Time
+	EndTime       *time.Time
+}
+
+type SandboxLead struct {
+	Record
+	FirstName          *string `gorm:"type:VARCHAR(255);"`
+	LastName           *string `gorm:"type:VARCHAR(255);"`
+	Email              *string `gorm:"type:VARCHAR(255);"`
+	Phone              *string `gorm:"type:VARCHAR(255);"`
+	Website            *string `gorm:"type:VARCHAR(255);"`
+	CompanyName        *string `gorm:"type:VARCHAR(255);"`
+	Title              *string `gorm:"type:VARCHAR(255);"`
+	Status             *string `gorm:"type:VARCHAR(255);"`
+	Converted          bool    `gorm:"default:false;"`
+	ConvertedContactID *uint64
+}
+
+// Models of the sandbox tables in the order they can be deleted in
+var Models = []interface{}{
+	&SandboxNote{},
+	&SandboxTask{},
+	&SandboxActivity{},
+	&SandboxLead{},
+	&SandboxOpportunityContact{},
+	&SandboxOpportunity{},
+	&SandboxContact{},
+	&SandboxCompany{},
+	&SandboxUser{},
+}
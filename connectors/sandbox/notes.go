@@ -0,0 +1,75 @@
+package sandbox
+
+import (
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (client *Client) ListContactNotes(ctx context.Context, contactId string) ([]*model.Note, error) {
+	return client.listNotes(ctx, sandboxContactParent, contactId)
+}
+
+func (client *Client) CreateContactNote(ctx context.Context, contactId string, input *model.NoteInput) (*model.Note, error) {
+	return client.createNote(ctx, sandboxContactParent, contactId, input)
+}
+
+func (client *Client) ListOpportunityNotes(ctx context.Context, opportunityId string) ([]*model.Note, error) {
+	return client.listNotes(ctx, sandboxOpportunityParent, opportunityId)
+}
+
+func (client *Client) CreateOpportunityNote(ctx context.Context, opportunityId string, input *model.NoteInput) (*model.Note, error) {
+	return client.createNote(ctx, sandboxOpportunityParent, opportunityId, input)
+}
+
+// Lists the notes of the record from the most recent one
+func (client *Client) listNotes(ctx context.Context, parent sandboxParent, recordId string) ([]*model.Note, error) {
+	id, err := client.parentID(ctx, parent, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxNotes := []SandboxNote{}
+	if err := client.scoped(ctx).Where(parent.Column+" = ?", id).Order("created_at desc, id desc").Find(&sandboxNotes).Error; err != nil {
+		log.Errorf("Error listing notes of %s #%s: %s", parent.Name, recordId, err)
+		return nil, err
+	}
+
+	notes := make([]*model.Note, len(sandboxNotes))
+	for i := range sandboxNotes {
+		notes[i] = sandboxNotes[i].mapNoteProperties()
+	}
+
+	return notes, nil
+}
+
+func (client *Client) createNote(ctx context.Context, parent sandboxParent, recordId string, input *model.NoteInput) (*model.Note, error) {
+	id, err := client.parentID(ctx, parent, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxNote := SandboxNote{
+		Record:  Record{IntegrationID: client.IntegrationID},
+		Content: input.Content,
+	}
+	sandboxNote.ContactID, sandboxNote.OpportunityID = parent.childIDs(id)
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxNote).Error; err != nil {
+		log.Errorf("Error creating note: %s", err)
+		return nil, err
+	}
+
+	return sandboxNote.mapNoteProperties(), nil
+}
+
+func (sandboxNote *SandboxNote) mapNoteProperties() *model.Note {
+	note := model.Note{
+		ID:      formatSandboxID(sandboxNote.ID),
+		Content: sandboxNote.Content,
+	}
+	note.CreatedAt, note.UpdatedAt = sandboxNote.timestamps()
+
+	return &note
+}
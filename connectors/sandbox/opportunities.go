@@ -0,0 +1,223 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (client *Client) ListOpportunities(ctx context.Context, params *connectors.ListParams) (*model.OpportunityConnection, error) {
+	sandboxOpportunities := []SandboxOpportunity{}
+	sorts, hasMore, err := client.listPage(ctx, params, sandboxOpportunityList, &sandboxOpportunities)
+	if err != nil {
+		log.Errorf("Error listing opportunities: %s", err)
+		return nil, err
+	}
+
+	opportunityEdges := make([]*model.OpportunityEdge, len(sandboxOpportunities))
+	for i := range sandboxOpportunities {
+		opportunityEdges[i] = &model.OpportunityEdge{
+			Cursor: sandboxRecordCursor(&sandboxOpportunities[i], sandboxOpportunities[i].ID, sorts),
+			Node:   client.mapOpportunity(&sandboxOpportunities[i]),
+		}
+	}
+
+	connection := model.OpportunityConnection{
+		Edges:    opportunityEdges,
+		PageInfo: sandboxPageInfo(params, hasMore, &opportunityEdges),
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(ctx, params, sandboxOpportunityList, &SandboxOpportunity{})
+		if err != nil {
+			log.Errorf("Error counting opportunities: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error) {
+	sandboxOpportunity := SandboxOpportunity{}
+	if err := client.get(ctx, "opportunity", opportunityId, &sandboxOpportunity, "Company"); err != nil {
+		return nil, err
+	}
+
+	return client.mapOpportunity(&sandboxOpportunity), nil
+}
+
+// The stage has to be in the pipeline, the default pipeline is used when the input has no pipeline
+func (client *Client) CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error) {
+	pipelineId, err := sandboxStagePipelineID(input.PipelineID, input.StageName)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxOpportunity := SandboxOpportunity{
+		Record:     Record{IntegrationID: client.IntegrationID},
+		Name:       input.Name,
+		PipelineID: pipelineId,
+		StageID:    input.StageName,
+	}
+
+	if !input.CloseDate.IsZero() {
+		closeDate := input.CloseDate.UTC()
+		sandboxOpportunity.CloseDate = &closeDate
+	}
+	if sandboxOpportunity.Amount, err = parseSandboxAmount(input.Amount); err != nil {
+		return nil, err
+	}
+	if sandboxOpportunity.CustomFields, err = client.customFieldsJSON(model.CrmObjectOpportunity, input.CustomFields); err != nil {
+		return nil, err
+	}
+	if sandboxOpportunity.CompanyID, err = client.reference(ctx, "company", &SandboxCompany{}, input.CompanyID); err != nil {
+		return nil, err
+	}
+	if sandboxOpportunity.OwnerID, err = client.reference(ctx, "user", &SandboxUser{}, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxOpportunity).Error; err != nil {
+		log.Errorf("Error creating opportunity: %s", err)
+		return nil, err
+	}
+
+	return client.GetOpportunity(ctx, formatSandboxID(sandboxOpportunity.ID))
+}
+
+func (client *Client) UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error) {
+	values, err := client.opportunityValues(ctx, opportunityId, input)
+	if err != nil {
+		return false, err
+	}
+
+	return client.update(ctx, "opportunity", opportunityId, &SandboxOpportunity{}, values)
+}
+
+func (client *Client) DeleteOpportunity(ctx context.Context, opportunityId string) (bool, error) {
+	return client.delete(ctx, "opportunity", opportunityId, &SandboxOpportunity{})
+}
+
+func (client *Client) ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error) {
+	id, err := parseSandboxID(opportunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	contactIds := client.DB.Model(&SandboxOpportunityContact{}).Select("contact_id").Where("opportunity_id = ?", id)
+
+	sandboxContacts := []SandboxContact{}
+	if err := client.scoped(ctx).Preload("Company").Where("id IN (?)", contactIds).Order("id asc").Find(&sandboxContacts).Error; err != nil {
+		log.Errorf("Error listing contacts of opportunity #%s: %s", opportunityId, err)
+		return nil, err
+	}
+
+	contacts := make([]*model.Contact, len(sandboxContacts))
+	for i := range sandboxContacts {
+		contacts[i] = client.mapContact(&sandboxContacts[i])
+	}
+
+	return contacts, nil
+}
+
+// Columns of the opportunity the input updates, the empty name and stage are left as they are.
+// A new stage has to be in the pipeline of the opportunity unless the pipeline changes too.
+func (client *Client) opportunityValues(ctx context.Context, opportunityId string, input *model.OpportunityInput) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	if input.Name != "" {
+		values["name"] = input.Name
+	}
+
+	if input.StageName != "" || input.PipelineID != nil {
+		sandboxOpportunity := SandboxOpportunity{}
+		if err := client.get(ctx, "opportunity", opportunityId, &sandboxOpportunity); err != nil {
+			return nil, err
+		}
+
+		pipelineId := &sandboxOpportunity.PipelineID
+		if input.PipelineID != nil {
+			pipelineId = input.PipelineID
+		}
+		stageId := sandboxOpportunity.StageID
+		if input.StageName != "" {
+			stageId = input.StageName
+		}
+
+		pipeline, err := sandboxStagePipelineID(pipelineId, stageId)
+		if err != nil {
+			return nil, err
+		}
+		values["pipeline_id"] = pipeline
+		values["stage_id"] = stageId
+	}
+
+	if !input.CloseDate.IsZero() {
+		values["close_date"] = input.CloseDate.UTC()
+	}
+
+	if input.Amount != nil {
+		amount, err := parseSandboxAmount(input.Amount)
+		if err != nil {
+			return nil, err
+		}
+		values["amount"] = amount
+	}
+
+	if input.CompanyID != nil {
+		companyId, err := client.reference(ctx, "company", &SandboxCompany{}, input.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		values["company_id"] = companyId
+	}
+
+	if input.OwnerID != nil {
+		ownerId, err := client.reference(ctx, "user", &SandboxUser{}, input.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		values["owner_id"] = ownerId
+	}
+
+	if err := client.setCustomFields(values, model.CrmObjectOpportunity, input.CustomFields); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// Maps the opportunity with its custom fields
+func (client *Client) mapOpportunity(sandboxOpportunity *SandboxOpportunity) *model.Opportunity {
+	opportunity := sandboxOpportunity.mapOpportunityProperties()
+	opportunity.CustomFields = client.customFields(model.CrmObjectOpportunity, sandboxOpportunity.CustomFields)
+
+	return opportunity
+}
+
+func (sandboxOpportunity *SandboxOpportunity) mapOpportunityProperties() *model.Opportunity {
+	pipelineId := sandboxOpportunity.PipelineID
+	stageName := sandboxOpportunity.StageID
+
+	opportunity := model.Opportunity{
+		ID:         formatSandboxID(sandboxOpportunity.ID),
+		Name:       sandboxOpportunity.Name,
+		Amount:     sandboxOpportunity.Amount,
+		StageName:  &stageName,
+		PipelineID: &pipelineId,
+		Company:    sandboxCompanyReference(sandboxOpportunity.CompanyID, sandboxOpportunity.Company),
+		Owner:      sandboxUserReference(sandboxOpportunity.OwnerID),
+	}
+	opportunity.CreatedAt, opportunity.UpdatedAt = sandboxOpportunity.timestamps()
+
+	if sandboxOpportunity.CloseDate != nil {
+		closeDate := sandboxOpportunity.CloseDate.UTC()
+		opportunity.CloseDate = &closeDate
+	}
+
+	return &opportunity
+}
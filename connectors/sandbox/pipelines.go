@@ -0,0 +1,94 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+)
+
+const (
+	SANDBOX_DEFAULT_PIPELINE_ID = "default"
+)
+
+type sandboxPipelineStage struct {
+	ID          string
+	Label       string
+	Probability float64
+	IsClosed    bool
+	IsWon       bool
+}
+
+// Pipelines of the sandbox are the same for all the integrations, the stages are referenced by their IDs
+var sandboxPipelines = []struct {
+	ID     string
+	Label  string
+	Stages []sandboxPipelineStage
+}{
+	{
+		ID:    SANDBOX_DEFAULT_PIPELINE_ID,
+		Label: "Sales Pipeline",
+		Stages: []sandboxPipelineStage{
+			{ID: "qualification", Label: "Qualification", Probability: 0.1},
+			{ID: "proposal", Label: "Proposal", Probability: 0.4},
+			{ID: "negotiation", Label: "Negotiation", Probability: 0.7},
+			{ID: "closedwon", Label: "Closed Won", Probability: 1, IsClosed: true, IsWon: true},
+			{ID: "closedlost", Label: "Closed Lost", Probability: 0, IsClosed: true},
+		},
+	},
+	{
+		ID:    "renewals",
+		Label: "Renewals",
+		Stages: []sandboxPipelineStage{
+			{ID: "renewaldue", Label: "Renewal Due", Probability: 0.5},
+			{ID: "renewed", Label: "Renewed", Probability: 1, IsClosed: true, IsWon: true},
+			{ID: "churned", Label: "Churned", Probability: 0, IsClosed: true},
+		},
+	},
+}
+
+func (client *Client) ListPipelines(ctx context.Context) ([]*model.Pipeline, error) {
+	return listSandboxPipelines(), nil
+}
+
+func listSandboxPipelines() []*model.Pipeline {
+	pipelines := make([]*model.Pipeline, len(sandboxPipelines))
+	for i, sandboxPipeline := range sandboxPipelines {
+		pipelineDisplayOrder := i
+		pipelines[i] = &model.Pipeline{
+			ID:           sandboxPipeline.ID,
+			Label:        sandboxPipeline.Label,
+			DisplayOrder: &pipelineDisplayOrder,
+			Stages:       make([]*model.PipelineStage, len(sandboxPipeline.Stages)),
+		}
+
+		for j, sandboxStage := range sandboxPipeline.Stages {
+			displayOrder := j
+			probability := sandboxStage.Probability
+			pipelines[i].Stages[j] = &model.PipelineStage{
+				ID:           sandboxStage.ID,
+				Label:        sandboxStage.Label,
+				DisplayOrder: &displayOrder,
+				Probability:  &probability,
+				IsClosed:     sandboxStage.IsClosed,
+				IsWon:        sandboxStage.IsWon,
+			}
+		}
+	}
+
+	return pipelines
+}
+
+// Returns the ID of the pipeline of the stage, the default pipeline is used when the pipeline isn't given
+func sandboxStagePipelineID(pipelineId *string, stageId string) (string, error) {
+	pipeline := SANDBOX_DEFAULT_PIPELINE_ID
+	if pipelineId != nil && *pipelineId != "" {
+		pipeline = *pipelineId
+	}
+
+	if connectors.FindPipelineStage(listSandboxPipelines(), &pipeline, stageId) == nil {
+		return "", fmt.Errorf("unknown stage %s of pipeline %s", stageId, pipeline)
+	}
+
+	return pipeline, nil
+}
@@ -0,0 +1,38 @@
+package sandbox
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+)
+
+func init() {
+	connectors.Register(connectors.Registration{
+		Connector: connectors.Connector{
+			ServiceCode: connectors.CONNECTOR_CRM_SANDBOX,
+			Type:        connectors.CONNECTOR_TYPE_CRM,
+			Name:        "Sandbox CRM",
+			Description: "Sandbox CRM stores sample records in the Blendbase database, it's meant for development and testing without CRM accounts.",
+			AuthType:    connectors.AUTH_TYPE_NONE,
+		},
+		Position: 6,
+		NewCrmConnector: func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping connectors.FieldMapping) connectors.CrmConnector {
+			client := SandboxClient(app, integration.ID)
+			client.FieldMapping = fieldMapping
+			return client
+		},
+		Capabilities: []*model.ObjectCapabilities{
+			connectors.ObjectCapabilities(model.CrmObjectContact, "id", "createdAt", "updatedAt", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectOpportunity, "id", "createdAt", "updatedAt", "name", "amount", "stageName", "pipelineId", "closeDate", "stage", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectCompany, "id", "createdAt", "updatedAt", "name", "website", "phone", "industry", "description", "city", "country", "numberOfEmployees", "annualRevenue", "owner"),
+			connectors.ObjectCapabilities(model.CrmObjectLead, "id", "createdAt", "updatedAt", "name", "firstName", "lastName", "email", "phone", "website", "companyName", "title", "status", "converted"),
+			connectors.ObjectCapabilities(model.CrmObjectUser, "id", "createdAt", "updatedAt", "name", "firstName", "lastName", "email", "active"),
+			connectors.ObjectCapabilities(model.CrmObjectNote, "id", "createdAt", "updatedAt", "content"),
+			connectors.ObjectCapabilities(model.CrmObjectTask, "id", "createdAt", "updatedAt", "subject", "description", "status", "priority", "dueDate"),
+			connectors.ObjectCapabilities(model.CrmObjectActivity, "id", "createdAt", "updatedAt", "type", "subject", "description", "startTime", "endTime"),
+			connectors.ObjectCapabilities(model.CrmObjectPipeline, connectors.PipelineFields...),
+			connectors.ObjectCapabilities(model.CrmObjectChange, connectors.ChangeFields...),
+		},
+	})
+}
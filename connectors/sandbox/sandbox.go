@@ -0,0 +1,373 @@
+package sandbox
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Sandbox CRM stored in the tables of Blendbase, it needs no credentials.
+// Every integration has its own records, they're seeded with Seed and wiped with Reset.
+type Client struct {
+	DB            *gorm.DB
+	IntegrationID uuid.UUID
+	FieldMapping  connectors.FieldMapping
+}
+
+func SandboxClient(app *config.App, integrationID uuid.UUID) *Client {
+	return &Client{
+		DB:            app.DB,
+		IntegrationID: integrationID,
+	}
+}
+
+// Query of the records of the integration
+func (client *Client) scoped(ctx context.Context) *gorm.DB {
+	return client.DB.WithContext(ctx).Where("integration_id = ?", client.IntegrationID)
+}
+
+// Reads the record of the integration with the given ID, name is the name of the object in the errors
+func (client *Client) get(ctx context.Context, name string, recordId string, record interface{}, preloads ...string) error {
+	id, err := parseSandboxID(recordId)
+	if err != nil {
+		return err
+	}
+
+	query := client.scoped(ctx)
+	for _, preload := range preloads {
+		query = query.Preload(preload)
+	}
+
+	if err := query.First(record, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%s #%s not found", name, recordId)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Updates the given columns of the record of the integration
+func (client *Client) update(ctx context.Context, name string, recordId string, record interface{}, values map[string]interface{}) (bool, error) {
+	if len(values) == 0 {
+		_, err := client.reference(ctx, name, record, &recordId)
+		return err == nil, err
+	}
+
+	id, err := parseSandboxID(recordId)
+	if err != nil {
+		return false, err
+	}
+
+	result := client.scoped(ctx).Model(record).Where("id = ?", id).Updates(values)
+	if result.Error != nil {
+		log.Errorf("Error updating %s #%s: %s", name, recordId, result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, fmt.Errorf("%s #%s not found", name, recordId)
+	}
+
+	return true, nil
+}
+
+// Deleted records stay in the table until the sandbox is reset
+func (client *Client) delete(ctx context.Context, name string, recordId string, record interface{}) (bool, error) {
+	id, err := parseSandboxID(recordId)
+	if err != nil {
+		return false, err
+	}
+
+	result := client.scoped(ctx).Where("id = ?", id).Delete(record)
+	if result.Error != nil {
+		log.Errorf("Error deleting %s #%s: %s", name, recordId, result.Error)
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, fmt.Errorf("%s #%s not found", name, recordId)
+	}
+
+	return true, nil
+}
+
+// Parses the ID of a record the input refers to, the record has to exist in the sandbox.
+// An empty ID clears the reference.
+func (client *Client) reference(ctx context.Context, name string, record interface{}, recordId *string) (*uint64, error) {
+	if recordId == nil || *recordId == "" {
+		return nil, nil
+	}
+
+	id, err := parseSandboxID(*recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := client.scoped(ctx).Model(record).Where("id = ?", id).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("%s #%s not found", name, *recordId)
+	}
+
+	return &id, nil
+}
+
+// Reads a page of the records matching the list parameters, the records are read in the reverse order
+// when paginating backwards and put back in the order of the list. Returns the sorts of the list to build
+// the cursors and true when there are more records in the direction of the pagination.
+func (client *Client) listPage(ctx context.Context, params *connectors.ListParams, list sandboxList, records interface{}) ([]sandboxSort, bool, error) {
+	sorts, err := sandboxSorts(params.OrderBy, list.Fields)
+	if err != nil {
+		return nil, false, err
+	}
+
+	query, err := client.filtered(ctx, params, list)
+	if err != nil {
+		return nil, false, err
+	}
+
+	backward := params.Backward()
+	cursor := params.After
+	if backward {
+		cursor = params.Before
+	}
+
+	if cursor != nil {
+		condition, err := sandboxCursorCondition(*cursor, sorts, backward)
+		if err != nil {
+			return nil, false, err
+		}
+		query = query.Where(condition.SQL, condition.Args...)
+	}
+
+	for _, preload := range list.Preloads {
+		query = query.Preload(preload)
+	}
+
+	limit := params.Limit()
+	if err := query.Order(sandboxOrderBy(sorts, backward)).Limit(limit + 1).Find(records).Error; err != nil {
+		return nil, false, err
+	}
+
+	recordsValue := reflect.ValueOf(records).Elem()
+	hasMore := recordsValue.Len() > limit
+	if hasMore {
+		recordsValue.Set(recordsValue.Slice(0, limit))
+	}
+
+	if backward {
+		swap := reflect.Swapper(recordsValue.Interface())
+		for i, j := 0, recordsValue.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+
+	return sorts, hasMore, nil
+}
+
+// Counts the records matching the filter and the search of the list parameters
+func (client *Client) count(ctx context.Context, params *connectors.ListParams, list sandboxList, record interface{}) (int, error) {
+	query, err := client.filtered(ctx, params, list)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := query.Model(record).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// Query of the records matching the filter and the search of the list parameters
+func (client *Client) filtered(ctx context.Context, params *connectors.ListParams, list sandboxList) (*gorm.DB, error) {
+	query := client.scoped(ctx)
+
+	if params.Filter != nil {
+		condition, err := compileSandboxFilter(params.Filter, list.Fields)
+		if err != nil {
+			return nil, err
+		}
+		if condition != nil {
+			query = query.Where(condition.SQL, condition.Args...)
+		}
+	}
+
+	if params.Query != nil && *params.Query != "" && len(list.SearchColumns) > 0 {
+		condition := sandboxSearchCondition(*params.Query, list.SearchColumns)
+		query = query.Where(condition.SQL, condition.Args...)
+	}
+
+	return query, nil
+}
+
+// Page info of the list, the edges are a pointer to a slice of edges with cursors
+func sandboxPageInfo(params *connectors.ListParams, hasMore bool, edgesPtr interface{}) *model.PageInfo {
+	pageInfo := model.PageInfo{}
+	if params.Backward() {
+		pageInfo.HasPreviousPage = hasMore
+		pageInfo.HasNextPage = params.Before != nil
+	} else {
+		pageInfo.HasNextPage = hasMore
+		pageInfo.HasPreviousPage = params.After != nil
+	}
+
+	edgesValue := reflect.ValueOf(edgesPtr).Elem()
+	if edgesValue.Len() > 0 {
+		startCursor := reflect.Indirect(edgesValue.Index(0)).FieldByName("Cursor").String()
+		endCursor := reflect.Indirect(edgesValue.Index(edgesValue.Len() - 1)).FieldByName("Cursor").String()
+		pageInfo.StartCursor = &startCursor
+		pageInfo.EndCursor = &endCursor
+	}
+
+	return &pageInfo
+}
+
+func parseSandboxID(id string) (uint64, error) {
+	parsed, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || parsed == 0 {
+		return 0, fmt.Errorf("invalid ID %s", id)
+	}
+
+	return parsed, nil
+}
+
+func formatSandboxID(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
+
+func formatOptionalSandboxID(id *uint64) *string {
+	if id == nil {
+		return nil
+	}
+
+	formatted := formatSandboxID(*id)
+	return &formatted
+}
+
+func sandboxUserReference(ownerId *uint64) *model.User {
+	if ownerId == nil {
+		return nil
+	}
+
+	return &model.User{ID: formatSandboxID(*ownerId)}
+}
+
+func sandboxCompanyReference(companyId *uint64, company *SandboxCompany) *model.Company {
+	if company != nil {
+		return &model.Company{ID: formatSandboxID(company.ID), Name: company.Name}
+	}
+	if companyId != nil {
+		return &model.Company{ID: formatSandboxID(*companyId)}
+	}
+
+	return nil
+}
+
+// Timestamps of the record in UTC
+func (record *Record) timestamps() (*time.Time, *time.Time) {
+	createdAt := record.CreatedAt.UTC()
+	updatedAt := record.UpdatedAt.UTC()
+
+	return &createdAt, &updatedAt
+}
+
+// Amounts are stored as numerics, they're validated before they're sent to the database
+func parseSandboxAmount(amount *string) (*string, error) {
+	if amount == nil || *amount == "" {
+		return nil, nil
+	}
+
+	if _, err := strconv.ParseFloat(*amount, 64); err != nil {
+		return nil, fmt.Errorf("invalid amount %s", *amount)
+	}
+
+	return amount, nil
+}
+
+// Sets the value of the column when the input has it
+func setValue(values map[string]interface{}, column string, value interface{}) {
+	if reflect.ValueOf(value).IsNil() {
+		return
+	}
+
+	values[column] = value
+}
+
+// Custom fields of the input by the names of the mapped fields, nil when the input has none
+func (client *Client) customFieldsJSON(object model.CrmObject, customFields map[string]interface{}) (datatypes.JSON, error) {
+	if len(customFields) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(client.FieldMapping.CrmFields(object, customFields))
+	if err != nil {
+		return nil, err
+	}
+
+	return datatypes.JSON(data), nil
+}
+
+// Sets the custom fields of the input on top of the stored ones
+func (client *Client) setCustomFields(values map[string]interface{}, object model.CrmObject, customFields map[string]interface{}) error {
+	data, err := client.customFieldsJSON(object, customFields)
+	if err != nil || data == nil {
+		return err
+	}
+
+	values["custom_fields"] = gorm.Expr("COALESCE(custom_fields, '{}'::JSONB) || ?::JSONB", string(data))
+	return nil
+}
+
+// Mapped custom fields of a record from its stored custom fields
+func (client *Client) customFields(object model.CrmObject, data datatypes.JSON) map[string]interface{} {
+	rawFields := map[string]interface{}{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &rawFields); err != nil {
+			log.Warnf("Error reading the custom fields of a sandbox %s: %s", object, err)
+		}
+	}
+
+	return client.FieldMapping.CustomFields(object, rawFields)
+}
+
+// Record notes, tasks and activities belong to
+type sandboxParent struct {
+	Name   string // name of the object in the errors
+	Column string // column of the ID of the record in the notes, tasks and activities
+	Record interface{}
+}
+
+var (
+	sandboxContactParent     = sandboxParent{Name: "contact", Column: "contact_id", Record: &SandboxContact{}}
+	sandboxOpportunityParent = sandboxParent{Name: "opportunity", Column: "opportunity_id", Record: &SandboxOpportunity{}}
+)
+
+// Parses the ID of the parent record, the record has to be in the sandbox
+func (client *Client) parentID(ctx context.Context, parent sandboxParent, recordId string) (*uint64, error) {
+	return client.reference(ctx, parent.Name, parent.Record, &recordId)
+}
+
+// Contact and opportunity IDs of a child record of the parent
+func (parent sandboxParent) childIDs(id *uint64) (*uint64, *uint64) {
+	if parent.Column == sandboxContactParent.Column {
+		return id, nil
+	}
+
+	return nil, id
+}
@@ -0,0 +1,250 @@
+package sandbox
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/misc/test_utils"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	client *Client
+)
+
+// The sandbox only needs the test database, every run uses a new integration
+func TestMain(m *testing.M) {
+	godotenv.Load("../../.env.test")
+
+	app, _ := config.NewApp()
+	if err := app.DB.AutoMigrate(Models...); err != nil {
+		log.Fatalf("Could not migrate the sandbox tables: %s", err)
+		return
+	}
+
+	client = SandboxClient(app, uuid.New())
+	if err := Seed(app.DB, client.IntegrationID); err != nil {
+		log.Fatalf("Could not seed the sandbox: %s", err)
+		return
+	}
+
+	exitVal := m.Run()
+
+	for _, model := range Models {
+		app.DB.Unscoped().Where("integration_id = ?", client.IntegrationID).Delete(model)
+	}
+
+	os.Exit(exitVal)
+}
+
+func TestListContactsPagination(t *testing.T) {
+	ctx := context.Background()
+	contactConnection, err := client.ListContacts(ctx, &connectors.ListParams{First: 2, IncludeTotalCount: true})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 2, len(contactConnection.Edges), "expecting two contacts")
+	assert.True(t, contactConnection.PageInfo.HasNextPage, "expecting a next page")
+	assert.False(t, contactConnection.PageInfo.HasPreviousPage, "expecting no previous page")
+	assert.GreaterOrEqual(t, *contactConnection.TotalCount, 6, "expecting the seeded contacts to be counted")
+	assert.Equal(t, "Acme Corporation", *contactConnection.Edges[0].Node.CompanyName, "expecting the name of the company of the contact")
+
+	nextConnection, err := client.ListContacts(ctx, &connectors.ListParams{First: 2, After: contactConnection.PageInfo.EndCursor})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 2, len(nextConnection.Edges), "expecting two contacts")
+	assert.True(t, nextConnection.PageInfo.HasPreviousPage, "expecting a previous page")
+	assert.NotEqual(t, contactConnection.Edges[1].Node.ID, nextConnection.Edges[0].Node.ID, "expecting the contacts after the cursor")
+
+	last := 2
+	previousConnection, err := client.ListContacts(ctx, &connectors.ListParams{Last: &last, Before: nextConnection.PageInfo.StartCursor})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 2, len(previousConnection.Edges), "expecting two contacts")
+	assert.Equal(t, contactConnection.Edges[0].Node.ID, previousConnection.Edges[0].Node.ID, "expecting the first page in its order")
+	assert.Equal(t, contactConnection.Edges[1].Node.ID, previousConnection.Edges[1].Node.ID, "expecting the first page in its order")
+	assert.False(t, previousConnection.PageInfo.HasPreviousPage, "expecting no page before the first one")
+}
+
+func TestListContactsFilterAndSort(t *testing.T) {
+	ctx := context.Background()
+	domain := "@initech.example.com"
+	direction := model.SortDirectionDesc
+
+	params := connectors.ListParams{
+		First: 1,
+		Filter: connectors.NewContactFilter(&model.ContactFilter{
+			Email: &model.StringFilter{Contains: &domain},
+		}),
+		OrderBy: []*model.SortInput{{Field: "lastName", Direction: &direction}},
+	}
+	contactConnection, err := client.ListContacts(ctx, &params)
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	assert.Equal(t, "Lumbergh", *contactConnection.Edges[0].Node.LastName, "expecting the contacts in the descending order of their last names")
+
+	params.After = contactConnection.PageInfo.EndCursor
+	contactConnection, err = client.ListContacts(ctx, &params)
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	assert.Equal(t, "Gibbons", *contactConnection.Edges[0].Node.LastName, "expecting the next contact by the last name")
+	assert.False(t, contactConnection.PageInfo.HasNextPage, "expecting no next page")
+
+	query := "100%"
+	contactConnection, err = client.ListContacts(ctx, &connectors.ListParams{First: 10, Query: &query})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 0, len(contactConnection.Edges), "expecting the wildcard to be matched literally")
+
+	_, err = client.ListContacts(ctx, &connectors.ListParams{First: 10, OrderBy: []*model.SortInput{{Field: "website"}}})
+	assert.NotNil(t, err, "expecting an error for an unsupported sort field")
+}
+
+func TestContactCRUD(t *testing.T) {
+	ctx := context.Background()
+	contactInput := test_utils.GenerateContactInput()
+
+	contact, err := client.CreateContact(ctx, contactInput)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, *contactInput.Email, *contact.Email, "expecting an email for the contact equal to the created one")
+
+	companyConnection, err := client.ListCompanies(ctx, &connectors.ListParams{First: 1})
+	assert.Nil(t, err, "expecting nil error")
+	company := companyConnection.Edges[0].Node
+
+	success, err := client.LinkContactToCompany(ctx, contact.ID, company.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting successful linking")
+
+	contact, err = client.GetContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, company.ID, contact.Company.ID, "expecting the linked company")
+
+	unknownOwnerId := "999999999"
+	_, err = client.UpdateContact(ctx, contact.ID, &model.ContactInput{OwnerID: &unknownOwnerId})
+	assert.NotNil(t, err, "expecting an error for an unknown owner")
+
+	note, err := client.CreateContactNote(ctx, contact.ID, test_utils.GenerateNoteInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	notes, err := client.ListContactNotes(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, note.ID, notes[0].ID, "expecting the created note")
+
+	success, err = client.DeleteContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting successful deletion")
+
+	_, err = client.GetContact(ctx, contact.ID)
+	assert.NotNil(t, err, "expecting an error for the deleted contact")
+
+	_, err = client.DeleteContact(ctx, contact.ID)
+	assert.NotNil(t, err, "expecting an error for the deleted contact")
+}
+
+func TestOpportunityStages(t *testing.T) {
+	ctx := context.Background()
+	input := test_utils.GenerateOpportunityInput()
+
+	_, err := client.CreateOpportunity(ctx, input)
+	assert.NotNil(t, err, "expecting an error for a stage that isn't in the pipeline")
+
+	input.StageName = "qualification"
+	opportunity, err := client.CreateOpportunity(ctx, input)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, SANDBOX_DEFAULT_PIPELINE_ID, *opportunity.PipelineID, "expecting the default pipeline")
+
+	renewals := "renewals"
+	_, err = client.UpdateOpportunity(ctx, opportunity.ID, &model.OpportunityInput{PipelineID: &renewals})
+	assert.NotNil(t, err, "expecting an error for a pipeline without the stage of the opportunity")
+
+	amount := "1500.50"
+	success, err := client.UpdateOpportunity(ctx, opportunity.ID, &model.OpportunityInput{PipelineID: &renewals, StageName: "renewed", Amount: &amount})
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting successful update")
+
+	opportunity, err = client.GetOpportunity(ctx, opportunity.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "renewed", *opportunity.StageName, "expecting the updated stage")
+	assert.Equal(t, amount, *opportunity.Amount, "expecting the updated amount")
+}
+
+func TestConvertLead(t *testing.T) {
+	ctx := context.Background()
+	lead, err := client.CreateLead(ctx, test_utils.GenerateLeadInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	result, err := client.ConvertLead(ctx, lead.ID, &model.LeadConversionInput{})
+	assert.Nil(t, err, "expecting nil error")
+	assert.NotNil(t, result.CompanyID, "expecting a company created from the company name of the lead")
+	assert.NotNil(t, result.OpportunityID, "expecting an opportunity")
+
+	contacts, err := client.ListOpportunityContacts(ctx, *result.OpportunityID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, result.ContactID, contacts[0].ID, "expecting the contact of the lead to be the contact of the opportunity")
+
+	lead, err = client.GetLead(ctx, lead.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, *lead.Converted, "expecting converted lead")
+
+	_, err = client.ConvertLead(ctx, lead.ID, &model.LeadConversionInput{})
+	assert.NotNil(t, err, "expecting an error for a converted lead")
+}
+
+func TestListChanges(t *testing.T) {
+	ctx := context.Background()
+	since := time.Now()
+
+	contact, err := client.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+	deletedContact, err := client.CreateContact(ctx, test_utils.GenerateContactInput())
+	assert.Nil(t, err, "expecting nil error")
+	_, err = client.DeleteContact(ctx, deletedContact.ID)
+	assert.Nil(t, err, "expecting nil error")
+
+	params, err := connectors.NewChangesParams(&since, 1, nil, []model.ChangeObjectType{model.ChangeObjectTypeContact})
+	assert.Nil(t, err, "expecting nil error")
+
+	changeConnection, err := client.ListChanges(ctx, params)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(changeConnection.Edges), "expecting single change")
+	assert.Equal(t, contact.ID, changeConnection.Edges[0].Node.ObjectID, "expecting the created contact first")
+	assert.Equal(t, model.ChangeTypeCreated, changeConnection.Edges[0].Node.ChangeType, "expecting the contact to be created")
+	assert.True(t, changeConnection.PageInfo.HasNextPage, "expecting a next page")
+
+	params, err = connectors.NewChangesParams(nil, 10, changeConnection.PageInfo.EndCursor, []model.ChangeObjectType{model.ChangeObjectTypeContact})
+	assert.Nil(t, err, "expecting nil error")
+
+	changeConnection, err = client.ListChanges(ctx, params)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(changeConnection.Edges), "expecting single change")
+	assert.Equal(t, deletedContact.ID, changeConnection.Edges[0].Node.ObjectID, "expecting the deleted contact")
+	assert.Equal(t, model.ChangeTypeDeleted, changeConnection.Edges[0].Node.ChangeType, "expecting the contact to be deleted")
+	assert.False(t, changeConnection.PageInfo.HasNextPage, "expecting no next page")
+}
+
+func TestReset(t *testing.T) {
+	ctx := context.Background()
+	resetClient := &Client{DB: client.DB, IntegrationID: uuid.New()}
+	defer Reset(client.DB, resetClient.IntegrationID)
+
+	assert.Nil(t, Seed(client.DB, resetClient.IntegrationID), "expecting nil error")
+	_, err := resetClient.CreateCompany(ctx, test_utils.GenerateCompanyInput())
+	assert.Nil(t, err, "expecting nil error")
+
+	assert.Nil(t, Reset(client.DB, resetClient.IntegrationID), "expecting nil error")
+
+	companyConnection, err := resetClient.ListCompanies(ctx, &connectors.ListParams{First: 10})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 3, len(companyConnection.Edges), "expecting the seeded companies only")
+}
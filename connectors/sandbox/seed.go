@@ -0,0 +1,135 @@
+package sandbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Creates the sample records of the sandbox unless the sandbox already has records
+func Seed(db *gorm.DB, integrationID uuid.UUID) error {
+	var count int64
+	if err := db.Model(&SandboxUser{}).Unscoped().Where("integration_id = ?", integrationID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		return seedRecords(tx, integrationID)
+	})
+}
+
+// Deletes all the records of the sandbox, the deleted records included, and seeds it again
+func Reset(db *gorm.DB, integrationID uuid.UUID) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range Models {
+			if err := tx.Unscoped().Where("integration_id = ?", integrationID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		return seedRecords(tx, integrationID)
+	})
+}
+
+func seedRecords(tx *gorm.DB, integrationID uuid.UUID) error {
+	record := func() Record {
+		return Record{IntegrationID: integrationID}
+	}
+
+	users := []*SandboxUser{
+		{Record: record(), FirstName: str("Ada"), LastName: str("Lovelace"), Email: str("ada@sandbox.example.com"), Active: true},
+		{Record: record(), FirstName: str("Alan"), LastName: str("Turing"), Email: str("alan@sandbox.example.com"), Active: true},
+	}
+	if err := tx.Create(users).Error; err != nil {
+		return err
+	}
+
+	companies := []*SandboxCompany{
+		{Record: record(), Name: "Acme Corporation", Website: str("acme.example.com"), Industry: str("Manufacturing"), City: str("Springfield"), Country: str("United States"), NumberOfEmployees: integer(1200), AnnualRevenue: str("25000000"), OwnerID: &users[0].ID},
+		{Record: record(), Name: "Globex", Website: str("globex.example.com"), Industry: str("Energy"), City: str("Cypress Creek"), Country: str("United States"), NumberOfEmployees: integer(450), OwnerID: &users[1].ID},
+		{Record: record(), Name: "Initech", Website: str("initech.example.com"), Industry: str("Software"), City: str("Austin"), Country: str("United States"), NumberOfEmployees: integer(80), OwnerID: &users[0].ID},
+	}
+	if err := tx.Create(companies).Error; err != nil {
+		return err
+	}
+
+	contacts := []*SandboxContact{
+		{Record: record(), FirstName: str("Wile"), LastName: str("Coyote"), Email: str("wile@acme.example.com"), Phone: str("+1 555 0100"), CompanyID: &companies[0].ID, OwnerID: &users[0].ID},
+		{Record: record(), FirstName: str("Road"), LastName: str("Runner"), Email: str("road@acme.example.com"), Phone: str("+1 555 0101"), CompanyID: &companies[0].ID, OwnerID: &users[0].ID},
+		{Record: record(), FirstName: str("Hank"), LastName: str("Scorpio"), Email: str("hank@globex.example.com"), Phone: str("+1 555 0102"), CompanyID: &companies[1].ID, OwnerID: &users[1].ID},
+		{Record: record(), FirstName: str("Bill"), LastName: str("Lumbergh"), Email: str("bill@initech.example.com"), CompanyID: &companies[2].ID, OwnerID: &users[0].ID},
+		{Record: record(), FirstName: str("Peter"), LastName: str("Gibbons"), Email: str("peter@initech.example.com"), CompanyID: &companies[2].ID, OwnerID: &users[1].ID},
+		{Record: record(), FirstName: str("Jane"), LastName: str("Doe"), Email: str("jane.doe@example.com"), CompanyName: str("Doe Consulting")},
+	}
+	if err := tx.Create(contacts).Error; err != nil {
+		return err
+	}
+
+	closeDate := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	opportunities := []*SandboxOpportunity{
+		{Record: record(), Name: "Acme - Rocket Skates", Amount: str("12000"), PipelineID: SANDBOX_DEFAULT_PIPELINE_ID, StageID: "proposal", CloseDate: &closeDate, CompanyID: &companies[0].ID, OwnerID: &users[0].ID},
+		{Record: record(), Name: "Globex - Doomsday Device", Amount: str("250000"), PipelineID: SANDBOX_DEFAULT_PIPELINE_ID, StageID: "negotiation", CloseDate: &closeDate, CompanyID: &companies[1].ID, OwnerID: &users[1].ID},
+		{Record: record(), Name: "Initech - TPS Reports", Amount: str("8000"), PipelineID: SANDBOX_DEFAULT_PIPELINE_ID, StageID: "closedwon", CloseDate: &closeDate, CompanyID: &companies[2].ID, OwnerID: &users[0].ID},
+		{Record: record(), Name: "Acme - Renewal", Amount: str("30000"), PipelineID: "renewals", StageID: "renewaldue", CloseDate: &closeDate, CompanyID: &companies[0].ID, OwnerID: &users[1].ID},
+	}
+	if err := tx.Create(opportunities).Error; err != nil {
+		return err
+	}
+
+	opportunityContacts := []*SandboxOpportunityContact{
+		{IntegrationID: integrationID, OpportunityID: opportunities[0].ID, ContactID: contacts[0].ID},
+		{IntegrationID: integrationID, OpportunityID: opportunities[1].ID, ContactID: contacts[2].ID},
+		{IntegrationID: integrationID, OpportunityID: opportunities[2].ID, ContactID: contacts[3].ID},
+		{IntegrationID: integrationID, OpportunityID: opportunities[2].ID, ContactID: contacts[4].ID},
+		{IntegrationID: integrationID, OpportunityID: opportunities[3].ID, ContactID: contacts[1].ID},
+	}
+	if err := tx.Create(opportunityContacts).Error; err != nil {
+		return err
+	}
+
+	notes := []*SandboxNote{
+		{Record: record(), ContactID: &contacts[0].ID, Content: "Interested in the rocket-powered models."},
+		{Record: record(), OpportunityID: &opportunities[1].ID, Content: "Legal review of the contract is in progress."},
+	}
+	if err := tx.Create(notes).Error; err != nil {
+		return err
+	}
+
+	dueDate := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 7)
+	tasks := []*SandboxTask{
+		{Record: record(), ContactID: &contacts[0].ID, Subject: "Send the product catalog", Status: str("NOT_STARTED"), Priority: str("NORMAL"), DueDate: &dueDate},
+		{Record: record(), OpportunityID: &opportunities[1].ID, Subject: "Prepare the final offer", Status: str("IN_PROGRESS"), Priority: str("HIGH"), DueDate: &dueDate},
+	}
+	if err := tx.Create(tasks).Error; err != nil {
+		return err
+	}
+
+	startTime := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, -2)
+	endTime := startTime.Add(30 * time.Minute)
+	activities := []*SandboxActivity{
+		{Record: record(), ContactID: &contacts[2].ID, Type: "CALL", Subject: str("Discovery call"), StartTime: &startTime, EndTime: &endTime},
+		{Record: record(), OpportunityID: &opportunities[0].ID, Type: "MEETING", Subject: str("Product demo"), StartTime: &startTime, EndTime: &endTime},
+	}
+	if err := tx.Create(activities).Error; err != nil {
+		return err
+	}
+
+	leads := []*SandboxLead{
+		{Record: record(), FirstName: str("Homer"), LastName: str("Simpson"), Email: str("homer@example.com"), CompanyName: str("Springfield Nuclear Power Plant"), Title: str("Safety Inspector"), Status: str(SANDBOX_LEAD_STATUS_NEW)},
+		{Record: record(), FirstName: str("Milton"), LastName: str("Waddams"), Email: str("milton@example.com"), Title: str("Collator"), Status: str(SANDBOX_LEAD_STATUS_NEW)},
+	}
+
+	return tx.Create(leads).Error
+}
+
+func str(value string) *string {
+	return &value
+}
+
+func integer(value int) *int {
+	return &value
+}
@@ -0,0 +1,121 @@
+package sandbox
+
+import (
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (client *Client) ListContactTasks(ctx context.Context, contactId string) ([]*model.Task, error) {
+	return client.listTasks(ctx, sandboxContactParent, contactId)
+}
+
+func (client *Client) CreateContactTask(ctx context.Context, contactId string, input *model.TaskInput) (*model.Task, error) {
+	return client.createTask(ctx, sandboxContactParent, contactId, input)
+}
+
+func (client *Client) ListOpportunityTasks(ctx context.Context, opportunityId string) ([]*model.Task, error) {
+	return client.listTasks(ctx, sandboxOpportunityParent, opportunityId)
+}
+
+func (client *Client) CreateOpportunityTask(ctx context.Context, opportunityId string, input *model.TaskInput) (*model.Task, error) {
+	return client.createTask(ctx, sandboxOpportunityParent, opportunityId, input)
+}
+
+func (client *Client) UpdateTask(ctx context.Context, taskId string, input *model.TaskInput) (bool, error) {
+	values := map[string]interface{}{}
+	if input.Subject != "" {
+		values["subject"] = input.Subject
+	}
+	setValue(values, "description", input.Description)
+	setValue(values, "due_date", input.DueDate)
+
+	if input.Status != nil {
+		values["status"] = input.Status.String()
+	}
+	if input.Priority != nil {
+		values["priority"] = input.Priority.String()
+	}
+
+	return client.update(ctx, "task", taskId, &SandboxTask{}, values)
+}
+
+// Lists the tasks of the record by their due dates, tasks without a due date go last
+func (client *Client) listTasks(ctx context.Context, parent sandboxParent, recordId string) ([]*model.Task, error) {
+	id, err := client.parentID(ctx, parent, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxTasks := []SandboxTask{}
+	if err := client.scoped(ctx).Where(parent.Column+" = ?", id).Order("due_date asc nulls last, id asc").Find(&sandboxTasks).Error; err != nil {
+		log.Errorf("Error listing tasks of %s #%s: %s", parent.Name, recordId, err)
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, len(sandboxTasks))
+	for i := range sandboxTasks {
+		tasks[i] = sandboxTasks[i].mapTaskProperties()
+	}
+
+	return tasks, nil
+}
+
+func (client *Client) createTask(ctx context.Context, parent sandboxParent, recordId string, input *model.TaskInput) (*model.Task, error) {
+	id, err := client.parentID(ctx, parent, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	sandboxTask := SandboxTask{
+		Record:      Record{IntegrationID: client.IntegrationID},
+		Subject:     input.Subject,
+		Description: input.Description,
+		DueDate:     input.DueDate,
+	}
+	sandboxTask.ContactID, sandboxTask.OpportunityID = parent.childIDs(id)
+
+	status := model.TaskStatusNotStarted
+	if input.Status != nil {
+		status = *input.Status
+	}
+	statusValue := status.String()
+	sandboxTask.Status = &statusValue
+
+	if input.Priority != nil {
+		priority := input.Priority.String()
+		sandboxTask.Priority = &priority
+	}
+
+	if err := client.DB.WithContext(ctx).Create(&sandboxTask).Error; err != nil {
+		log.Errorf("Error creating task: %s", err)
+		return nil, err
+	}
+
+	return sandboxTask.mapTaskProperties(), nil
+}
+
+func (sandboxTask *SandboxTask) mapTaskProperties() *model.Task {
+	task := model.Task{
+		ID:          formatSandboxID(sandboxTask.ID),
+		Subject:     sandboxTask.Subject,
+		Description: sandboxTask.Description,
+	}
+	task.CreatedAt, task.UpdatedAt = sandboxTask.timestamps()
+
+	if sandboxTask.Status != nil {
+		status := model.TaskStatus(*sandboxTask.Status)
+		task.Status = &status
+	}
+	if sandboxTask.Priority != nil {
+		priority := model.TaskPriority(*sandboxTask.Priority)
+		task.Priority = &priority
+	}
+	if sandboxTask.DueDate != nil {
+		dueDate := sandboxTask.DueDate.UTC()
+		task.DueDate = &dueDate
+	}
+
+	return &task
+}
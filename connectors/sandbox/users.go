@@ -0,0 +1,72 @@
+package sandbox
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Users of the sandbox are the owners of its records, they're only created by Seed
+func (client *Client) ListUsers(ctx context.Context, params *connectors.ListParams) (*model.UserConnection, error) {
+	sandboxUsers := []SandboxUser{}
+	sorts, hasMore, err := client.listPage(ctx, params, sandboxUserList, &sandboxUsers)
+	if err != nil {
+		log.Errorf("Error listing users: %s", err)
+		return nil, err
+	}
+
+	userEdges := make([]*model.UserEdge, len(sandboxUsers))
+	for i := range sandboxUsers {
+		userEdges[i] = &model.UserEdge{
+			Cursor: sandboxRecordCursor(&sandboxUsers[i], sandboxUsers[i].ID, sorts),
+			Node:   sandboxUsers[i].mapUserProperties(),
+		}
+	}
+
+	connection := model.UserConnection{
+		Edges:    userEdges,
+		PageInfo: sandboxPageInfo(params, hasMore, &userEdges),
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(ctx, params, sandboxUserList, &SandboxUser{})
+		if err != nil {
+			log.Errorf("Error counting users: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetUser(ctx context.Context, userId string) (*model.User, error) {
+	sandboxUser := SandboxUser{}
+	if err := client.get(ctx, "user", userId, &sandboxUser); err != nil {
+		return nil, err
+	}
+
+	return sandboxUser.mapUserProperties(), nil
+}
+
+func (sandboxUser *SandboxUser) mapUserProperties() *model.User {
+	active := sandboxUser.Active
+	user := model.User{
+		ID:        formatSandboxID(sandboxUser.ID),
+		FirstName: sandboxUser.FirstName,
+		LastName:  sandboxUser.LastName,
+		Email:     sandboxUser.Email,
+		Active:    &active,
+	}
+	user.CreatedAt, user.UpdatedAt = sandboxUser.timestamps()
+
+	name := strings.TrimSpace(strings.Join([]string{stringValue(sandboxUser.FirstName), stringValue(sandboxUser.LastName)}, " "))
+	if name != "" {
+		user.Name = &name
+	}
+
+	return &user
+}
@@ -0,0 +1,174 @@
+package zoho
+
+import (
+	"blendbase/connectors"
+	"blendbase/integrations"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	ZOHO_DEFAULT_DATA_CENTER = "com"
+
+	// Zoho expects its own token type in the Authorization header
+	ZOHO_TOKEN_TYPE = "Zoho-oauthtoken"
+)
+
+// Accounts and API hosts of the data centers, accounts live in a single data center
+// https://www.zoho.com/crm/developer/docs/api/v5/multi-dc.html
+type ZohoDataCenter struct {
+	AccountsURL string
+	APIDomain   string
+}
+
+var ZohoDataCenters = map[string]ZohoDataCenter{
+	"com":    {AccountsURL: "https://accounts.zoho.com", APIDomain: "https://www.zohoapis.com"},
+	"eu":     {AccountsURL: "https://accounts.zoho.eu", APIDomain: "https://www.zohoapis.eu"},
+	"in":     {AccountsURL: "https://accounts.zoho.in", APIDomain: "https://www.zohoapis.in"},
+	"com.au": {AccountsURL: "https://accounts.zoho.com.au", APIDomain: "https://www.zohoapis.com.au"},
+	"jp":     {AccountsURL: "https://accounts.zoho.jp", APIDomain: "https://www.zohoapis.jp"},
+}
+
+// Zoho separates the scopes with commas
+var zohoScopes = []string{
+	"ZohoCRM.modules.contacts.ALL",
+	"ZohoCRM.modules.deals.ALL",
+	"ZohoCRM.modules.notes.ALL",
+	"ZohoCRM.modules.accounts.READ",
+	"ZohoCRM.settings.fields.READ",
+	"ZohoCRM.coql.READ",
+}
+
+// Refresh tokens are only issued for the offline access, the consent is asked again to get a new one
+var oauth2Flow = connectors.OAuth2Flow{
+	Config: getOAuthConfig,
+	AuthCodeOptions: []oauth2.AuthCodeOption{
+		oauth2.AccessTypeOffline,
+		oauth2.SetAuthURLParam("prompt", "consent"),
+	},
+	TokenType:       ZOHO_TOKEN_TYPE,
+	PrepareCallback: prepareCallback,
+	OnToken:         storeAPIDomain,
+	CustomSettings:  oauth2CustomSettings,
+}
+
+// Zoho redirects to the callback with the accounts server of the user's data center,
+// the code is exchanged there
+func prepareCallback(r *http.Request, consumerOAuthConfig *integrations.ConsumerOauth2Configuration) error {
+	accountsServer := r.FormValue("accounts-server")
+	if accountsServer == "" {
+		return nil
+	}
+
+	dataCenter, ok := DataCenterOfAccountsServer(accountsServer)
+	if !ok {
+		return fmt.Errorf("unknown Zoho accounts server %s", accountsServer)
+	}
+
+	customSettings, err := consumerOAuthConfig.GetCustomSettings()
+	if err != nil || customSettings == nil {
+		customSettings = &integrations.ConsumerOauth2ConfigurationCustomSettings{}
+	}
+	customSettings.ZohoDataCenter = dataCenter
+
+	return consumerOAuthConfig.SetCustomSettings(customSettings)
+}
+
+// The API domain of the token is stored for the requests
+func storeAPIDomain(token *oauth2.Token, consumerOAuthConfig *integrations.ConsumerOauth2Configuration) error {
+	customSettings, err := consumerOAuthConfig.GetCustomSettings()
+	if err != nil || customSettings == nil {
+		customSettings = &integrations.ConsumerOauth2ConfigurationCustomSettings{}
+	}
+	customSettings.ZohoAPIDomain = tokenAPIDomain(token, getDataCenter(consumerOAuthConfig))
+
+	return consumerOAuthConfig.SetCustomSettings(customSettings)
+}
+
+// Zoho keeps the refresh token, only the access token is replaced
+func (client *Client) refreshToken() error {
+	log.Info("Zoho refreshing token")
+
+	newToken, err := oauth2Flow.RefreshToken(client.app, client.consumerOAuthConfig)
+	if err != nil {
+		return err
+	}
+
+	client.HTTPClient = oauth2Flow.Config(client.consumerOAuthConfig).Client(context.TODO(), newToken)
+
+	return nil
+}
+
+func IsDataCenter(dataCenter string) bool {
+	_, ok := ZohoDataCenters[dataCenter]
+	return ok
+}
+
+// Data center of the accounts server given to the callback, e.g. "eu" of https://accounts.zoho.eu
+func DataCenterOfAccountsServer(accountsServer string) (string, bool) {
+	serverUrl, err := url.Parse(accountsServer)
+	if err != nil {
+		return "", false
+	}
+
+	for dataCenter, endpoints := range ZohoDataCenters {
+		accountsUrl, _ := url.Parse(endpoints.AccountsURL)
+		if serverUrl.Scheme == accountsUrl.Scheme && strings.EqualFold(serverUrl.Host, accountsUrl.Host) {
+			return dataCenter, true
+		}
+	}
+
+	return "", false
+}
+
+func getDataCenter(consumerOAuthConfig *integrations.ConsumerOauth2Configuration) string {
+	if customSettings, _ := consumerOAuthConfig.GetCustomSettings(); customSettings != nil && IsDataCenter(customSettings.ZohoDataCenter) {
+		return customSettings.ZohoDataCenter
+	}
+
+	return ZOHO_DEFAULT_DATA_CENTER
+}
+
+// API domain returned with the token, the domain of the data center until the integration is authorized
+func getAPIDomain(consumerOAuthConfig *integrations.ConsumerOauth2Configuration) string {
+	if customSettings, _ := consumerOAuthConfig.GetCustomSettings(); customSettings != nil && customSettings.ZohoAPIDomain != "" {
+		return strings.TrimSuffix(customSettings.ZohoAPIDomain, "/")
+	}
+
+	return ZohoDataCenters[getDataCenter(consumerOAuthConfig)].APIDomain
+}
+
+func tokenAPIDomain(token *oauth2.Token, dataCenter string) string {
+	if apiDomain, ok := token.Extra("api_domain").(string); ok && strings.HasPrefix(apiDomain, "https://") {
+		return strings.TrimSuffix(apiDomain, "/")
+	}
+
+	return ZohoDataCenters[dataCenter].APIDomain
+}
+
+func getOAuthConfig(consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *oauth2.Config {
+	return getDataCenterOAuthConfig(consumerOAuthConfig, getDataCenter(consumerOAuthConfig))
+}
+
+func getDataCenterOAuthConfig(consumerOAuthConfig *integrations.ConsumerOauth2Configuration, dataCenter string) *oauth2.Config {
+	accountsUrl := ZohoDataCenters[dataCenter].AccountsURL
+
+	return &oauth2.Config{
+		RedirectURL:  consumerOAuthConfig.RedirectURL,
+		ClientID:     consumerOAuthConfig.ClientID.Raw,
+		ClientSecret: consumerOAuthConfig.ClientSecret.Raw,
+		Scopes:       []string{strings.Join(zohoScopes, ",")},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   accountsUrl + "/oauth/v2/auth",
+			TokenURL:  accountsUrl + "/oauth/v2/token",
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+}
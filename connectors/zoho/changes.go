@@ -0,0 +1,140 @@
+package zoho
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// records API reads up to 200 records at once
+	ZOHO_MAX_RECORDS_PAGE_SIZE = 200
+)
+
+// Created and updated records are read by their modification time.
+// Deleted records aren't in the feed, Zoho lists them apart without their types' fields.
+func (client *Client) ListChanges(ctx context.Context, params *connectors.ChangesParams) (*model.ChangeConnection, error) {
+	batches := []*connectors.ChangeBatch{}
+	sources := []struct {
+		objectType model.ChangeObjectType
+		list       func(*connectors.ChangesParams) (*connectors.ChangeBatch, error)
+	}{
+		{model.ChangeObjectTypeContact, client.listContactChanges},
+		{model.ChangeObjectTypeNote, client.listNoteChanges},
+		{model.ChangeObjectTypeOpportunity, client.listOpportunityChanges},
+	}
+
+	for _, source := range sources {
+		if !params.Includes(source.objectType) {
+			continue
+		}
+
+		batch, err := source.list(params)
+		if err != nil {
+			log.Errorf("Error listing changes of %s: %s", source.objectType, err)
+			return nil, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return connectors.NewChangeConnection(params, batches), nil
+}
+
+func (client *Client) listContactChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	zohoContacts := []ZohoContact{}
+	info, err := client.listChanged(CONTACTS_MODULE, client.zohoSelectFields(ZohoContact{}, model.CrmObjectContact, false), model.ChangeObjectTypeContact, params, &zohoContacts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(zohoContacts))
+	for i := range zohoContacts {
+		contact := client.mapContact(&zohoContacts[i])
+		changes[i] = params.NewChange(model.ChangeObjectTypeContact, contact.ID, contact.CreatedAt, contact.UpdatedAt)
+		changes[i].Contact = contact
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: info.MoreRecords}, nil
+}
+
+func (client *Client) listNoteChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	zohoNotes := []ZohoNote{}
+	info, err := client.listChanged(NOTES_MODULE, zohoSelectFields(ZohoNote{}, false), model.ChangeObjectTypeNote, params, &zohoNotes)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(zohoNotes))
+	for i := range zohoNotes {
+		note := zohoNotes[i].mapNoteProperties()
+		changes[i] = params.NewChange(model.ChangeObjectTypeNote, note.ID, note.CreatedAt, note.UpdatedAt)
+		changes[i].Note = note
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: info.MoreRecords}, nil
+}
+
+func (client *Client) listOpportunityChanges(params *connectors.ChangesParams) (*connectors.ChangeBatch, error) {
+	zohoDeals := []ZohoDeal{}
+	info, err := client.listChanged(DEALS_MODULE, client.zohoSelectFields(ZohoDeal{}, model.CrmObjectOpportunity, false), model.ChangeObjectTypeOpportunity, params, &zohoDeals)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.Change, len(zohoDeals))
+	for i := range zohoDeals {
+		opportunity := client.mapOpportunity(&zohoDeals[i])
+		changes[i] = params.NewChange(model.ChangeObjectTypeOpportunity, opportunity.ID, opportunity.CreatedAt, opportunity.UpdatedAt)
+		changes[i].Opportunity = opportunity
+	}
+
+	return &connectors.ChangeBatch{Changes: changes, HasMore: info.MoreRecords}, nil
+}
+
+// Lists the records of the module modified since the bound of the object type in the order of the feed.
+// Zoho orders the records modified at the same time by their IDs too, the records before the cursor
+// are dropped when the batches are merged.
+func (client *Client) listChanged(module string, fields []string, objectType model.ChangeObjectType, params *connectors.ChangesParams, records interface{}) (*ZohoListInfo, error) {
+	perPage := params.First + 1
+	if perPage > ZOHO_MAX_RECORDS_PAGE_SIZE {
+		perPage = ZOHO_MAX_RECORDS_PAGE_SIZE
+	}
+
+	query := url.Values{}
+	query.Set("fields", strings.Join(fields, ","))
+	query.Set("sort_by", "Modified_Time")
+	query.Set("sort_order", "asc")
+	query.Set("per_page", fmt.Sprint(perPage))
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s?%s", client.baseUrl(), module, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("If-Modified-Since", zohoModifiedSince(params.Bound(objectType)))
+
+	response := zohoDataResponse{Data: records}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Info, nil
+}
+
+// Zoho times have no fractions of a second, the records modified at the bound second are read again
+// unless the bound is exclusive
+func zohoModifiedSince(bound connectors.ChangeBound) string {
+	since := bound.Time.Truncate(time.Second)
+	if bound.Exclusive {
+		since = since.Add(time.Second)
+	}
+
+	return formatZohoDateTime(since)
+}
@@ -0,0 +1,203 @@
+package zoho
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	CONTACTS_MODULE = "Contacts"
+)
+
+// https://www.zoho.com/crm/developer/docs/api/v5/modules-fields.html
+type ZohoContact struct {
+	ID           string         `json:"id"`
+	FullName     *string        `json:"Full_Name"`
+	FirstName    *string        `json:"First_Name"`
+	LastName     *string        `json:"Last_Name"`
+	Email        *string        `json:"Email"`
+	Phone        *string        `json:"Phone"`
+	Account      *ZohoReference `json:"Account_Name"`
+	AccountName  *string        `json:"Account_Name.Account_Name"`
+	Owner        *ZohoReference `json:"Owner"`
+	CreatedTime  *string        `json:"Created_Time"`
+	ModifiedTime *string        `json:"Modified_Time"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (zohoContact *ZohoContact) UnmarshalJSON(data []byte) error {
+	type plainZohoContact ZohoContact
+	return connectors.UnmarshalWithRawFields(data, (*plainZohoContact)(zohoContact), &zohoContact.RawFields)
+}
+
+type ZohoContactCreateUpdatePayload struct {
+	FirstName *string               `json:"First_Name,omitempty"`
+	LastName  *string               `json:"Last_Name,omitempty"`
+	Email     *string               `json:"Email,omitempty"`
+	Phone     *string               `json:"Phone,omitempty"`
+	Account   *ZohoReferencePayload `json:"Account_Name,omitempty"`
+	Owner     *ZohoReferencePayload `json:"Owner,omitempty"`
+}
+
+func (client *Client) ListContacts(ctx context.Context, params *connectors.ListParams) (*model.ContactConnection, error) {
+	zohoContacts := []ZohoContact{}
+	start, err := client.listPage(CONTACTS_MODULE, client.zohoSelectFields(ZohoContact{}, model.CrmObjectContact, true), params, zohoContactFilterFields, zohoContactSearchFields, &zohoContacts)
+	if err != nil {
+		log.Errorf("Error listing contacts: %s", err)
+		return nil, err
+	}
+
+	contactEdges := make([]*model.ContactEdge, len(zohoContacts))
+	for i := range zohoContacts {
+		contactEdges[i] = &model.ContactEdge{
+			Cursor: zohoCursor(start + i),
+			Node:   client.mapContact(&zohoContacts[i]),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &contactEdges)
+
+	connection := model.ContactConnection{
+		Edges:    recordsValue.Interface().([]*model.ContactEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(CONTACTS_MODULE, params, zohoContactFilterFields, zohoContactSearchFields)
+		if err != nil {
+			log.Errorf("Error counting contacts: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetContact(ctx context.Context, contactId string) (*model.Contact, error) {
+	zohoContact := ZohoContact{}
+	if err := client.get(CONTACTS_MODULE, contactId, &zohoContact); err != nil {
+		log.Errorf("Error getting contact: %s", err)
+		return nil, err
+	}
+
+	return client.mapContact(&zohoContact), nil
+}
+
+// Create contact using GraphQL input, the created contact is read again
+func (client *Client) CreateContact(ctx context.Context, input *model.ContactInput) (*model.Contact, error) {
+	payload, err := client.createZohoContactPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	contactId, err := client.create(CONTACTS_MODULE, payload)
+	if err != nil {
+		log.Errorf("Error creating contact: %s", err)
+		return nil, err
+	}
+
+	return client.GetContact(ctx, contactId)
+}
+
+func (client *Client) UpdateContact(ctx context.Context, contactId string, input *model.ContactInput) (bool, error) {
+	payload, err := client.createZohoContactPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	success, err := client.update(CONTACTS_MODULE, contactId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating contact #%s: %s", contactId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteContact(ctx context.Context, contactId string) (bool, error) {
+	return client.delete(CONTACTS_MODULE, contactId)
+}
+
+// Counts the records of the module matching the list params
+func (client *Client) count(module string, params *connectors.ListParams, filterFields map[string]zohoFilterField, searchFields []string) (int, error) {
+	query, err := zohoCountQuery(module, params, filterFields, searchFields)
+	if err != nil {
+		return 0, err
+	}
+
+	counts := []struct {
+		Count int `json:"COUNT(id)"`
+	}{}
+	if _, err := client.query(query, &counts); err != nil {
+		return 0, err
+	}
+
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	return counts[0].Count, nil
+}
+
+// Creates Zoho Contact Update/Create payload from GraphQL input
+func (client *Client) createZohoContactPayload(input *model.ContactInput) (map[string]interface{}, error) {
+	payload := ZohoContactCreateUpdatePayload{
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+		Email:     input.Email,
+		Phone:     input.Phone,
+	}
+
+	var err error
+	if payload.Account, err = zohoReferencePayload(input.CompanyID); err != nil {
+		return nil, err
+	}
+	if payload.Owner, err = zohoReferencePayload(input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectContact, input.CustomFields))
+}
+
+// Maps the contact with its custom fields
+func (client *Client) mapContact(zohoContact *ZohoContact) *model.Contact {
+	contact := zohoContact.mapContactProperties()
+	contact.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectContact, zohoContact.RawFields)
+
+	return contact
+}
+
+func (zohoContact *ZohoContact) mapContactProperties() *model.Contact {
+	contact := model.Contact{
+		ID:        zohoContact.ID,
+		Name:      zohoContact.FullName,
+		FirstName: zohoContact.FirstName,
+		LastName:  zohoContact.LastName,
+		Email:     zohoContact.Email,
+		Phone:     zohoContact.Phone,
+		Owner:     zohoOwnerReference(zohoContact.Owner),
+		CreatedAt: parseZohoDateTime(zohoContact.CreatedTime),
+		UpdatedAt: parseZohoDateTime(zohoContact.ModifiedTime),
+	}
+
+	if zohoContact.Account != nil {
+		contact.Company = &model.Company{ID: zohoContact.Account.ID}
+
+		// COQL returns the name of the account as a field of the contact
+		companyName := zohoContact.Account.Name
+		if companyName == nil {
+			companyName = zohoContact.AccountName
+		}
+		if companyName != nil {
+			contact.CompanyName = companyName
+			contact.Company.Name = *companyName
+		}
+	}
+
+	return &contact
+}
@@ -0,0 +1,286 @@
+package zoho
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ZOHO_FIELD_TYPE_STRING = iota
+	ZOHO_FIELD_TYPE_NUMBER
+	ZOHO_FIELD_TYPE_DATETIME
+	ZOHO_FIELD_TYPE_DATE
+	ZOHO_FIELD_TYPE_ID // ID of the record or of a lookup
+)
+
+type zohoFilterField struct {
+	Name     string
+	Type     int
+	Constant *string // value of a field that isn't stored in Zoho
+}
+
+var zohoDefaultPipelineID = ZOHO_DEFAULT_PIPELINE_ID
+
+// Zoho fields of the unified filter fields
+var zohoContactFilterFields = map[string]zohoFilterField{
+	"firstName": {Name: "First_Name"},
+	"lastName":  {Name: "Last_Name"},
+	"email":     {Name: "Email"},
+	"phone":     {Name: "Phone"},
+	"companyId": {Name: "Account_Name", Type: ZOHO_FIELD_TYPE_ID},
+	"ownerId":   {Name: "Owner", Type: ZOHO_FIELD_TYPE_ID},
+	"createdAt": {Name: "Created_Time", Type: ZOHO_FIELD_TYPE_DATETIME},
+	"updatedAt": {Name: "Modified_Time", Type: ZOHO_FIELD_TYPE_DATETIME},
+}
+
+var zohoDealFilterFields = map[string]zohoFilterField{
+	"name":       {Name: "Deal_Name"},
+	"stageName":  {Name: "Stage"},
+	"companyId":  {Name: "Account_Name", Type: ZOHO_FIELD_TYPE_ID},
+	"ownerId":    {Name: "Owner", Type: ZOHO_FIELD_TYPE_ID},
+	"amount":     {Name: "Amount", Type: ZOHO_FIELD_TYPE_NUMBER},
+	"closeDate":  {Name: "Closing_Date", Type: ZOHO_FIELD_TYPE_DATE},
+	"createdAt":  {Name: "Created_Time", Type: ZOHO_FIELD_TYPE_DATETIME},
+	"updatedAt":  {Name: "Modified_Time", Type: ZOHO_FIELD_TYPE_DATETIME},
+	"pipelineId": {Constant: &zohoDefaultPipelineID}, // the stages are read as a single pipeline
+}
+
+// Text fields matched by the free-text search
+var zohoContactSearchFields = []string{"Full_Name", "Email", "Phone"}
+var zohoDealSearchFields = []string{"Deal_Name"}
+
+// COQL requires a condition, it matches all the records
+const zohoAllRecordsCondition = "id is not null"
+
+// Builds the COQL query of the page of the list params
+func zohoListQuery(module string, fields []string, params *connectors.ListParams, filterFields map[string]zohoFilterField, searchFields []string) (string, error) {
+	condition, err := zohoListCondition(params, filterFields, searchFields)
+	if err != nil {
+		return "", err
+	}
+
+	orderBy, err := zohoOrderBy(params.OrderBy, filterFields)
+	if err != nil {
+		return "", err
+	}
+
+	start, limit, err := zohoPage(params)
+	if err != nil {
+		return "", err
+	}
+	if limit > ZOHO_MAX_PAGE_SIZE {
+		return "", fmt.Errorf("page size can't be greater than %d", ZOHO_MAX_PAGE_SIZE-1)
+	}
+
+	return fmt.Sprintf("select %s from %s where %s order by %s limit %d offset %d", strings.Join(fields, ", "), module, condition, orderBy, limit, start), nil
+}
+
+// Builds the COQL query counting the records of the list params
+func zohoCountQuery(module string, params *connectors.ListParams, filterFields map[string]zohoFilterField, searchFields []string) (string, error) {
+	condition, err := zohoListCondition(params, filterFields, searchFields)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("select COUNT(id) from %s where %s", module, condition), nil
+}
+
+// COQL condition of the filter and the search of the list params
+func zohoListCondition(params *connectors.ListParams, filterFields map[string]zohoFilterField, searchFields []string) (string, error) {
+	clauses := []string{}
+
+	if params.Filter != nil {
+		clause, err := compileCOQLFilter(params.Filter, filterFields)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	if params.Query != nil && *params.Query != "" {
+		searchClauses := make([]string, len(searchFields))
+		for i, field := range searchFields {
+			searchClauses[i] = fmt.Sprintf("%s like %s", field, formatCOQLString("%"+*params.Query+"%"))
+		}
+		clauses = append(clauses, "("+strings.Join(searchClauses, " or ")+")")
+	}
+
+	if len(clauses) == 0 {
+		return zohoAllRecordsCondition, nil
+	}
+
+	return strings.Join(clauses, " and "), nil
+}
+
+func compileCOQLFilter(filter *connectors.Filter, filterFields map[string]zohoFilterField) (string, error) {
+	clauses := []string{}
+
+	for _, condition := range filter.Conditions {
+		clause, err := compileCOQLCondition(condition, filterFields)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, clause)
+	}
+
+	for _, and := range filter.And {
+		clause, err := compileCOQLFilter(and, filterFields)
+		if err != nil {
+			return "", err
+		}
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		orClauses := []string{}
+		for _, or := range filter.Or {
+			clause, err := compileCOQLFilter(or, filterFields)
+			if err != nil {
+				return "", err
+			}
+			if clause == "" {
+				// an empty filter matches everything
+				orClauses = nil
+				break
+			}
+			orClauses = append(orClauses, clause)
+		}
+
+		if len(orClauses) > 0 {
+			clauses = append(clauses, "("+strings.Join(orClauses, " or ")+")")
+		}
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+
+	return "(" + strings.Join(clauses, " and ") + ")", nil
+}
+
+func compileCOQLCondition(condition connectors.FilterCondition, filterFields map[string]zohoFilterField) (string, error) {
+	field, ok := filterFields[condition.Field]
+	if !ok {
+		return "", fmt.Errorf("filtering by %s is not supported", condition.Field)
+	}
+
+	if field.Constant != nil {
+		return compileCOQLConstantCondition(condition, *field.Constant)
+	}
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ, connectors.FILTER_OPERATOR_GTE, connectors.FILTER_OPERATOR_LTE:
+		value, err := formatCOQLValue(field, condition.Value)
+		if err != nil {
+			return "", err
+		}
+
+		operator := map[string]string{
+			connectors.FILTER_OPERATOR_EQ:  "=",
+			connectors.FILTER_OPERATOR_GTE: ">=",
+			connectors.FILTER_OPERATOR_LTE: "<=",
+		}[condition.Operator]
+
+		return fmt.Sprintf("%s %s %s", field.Name, operator, value), nil
+	case connectors.FILTER_OPERATOR_CONTAINS:
+		value, _ := condition.Value.(string)
+		return fmt.Sprintf("%s like %s", field.Name, formatCOQLString("%"+value+"%")), nil
+	case connectors.FILTER_OPERATOR_IN:
+		values, _ := condition.Value.([]string)
+		if len(values) == 0 {
+			// nothing can match an empty list
+			return "id is null", nil
+		}
+
+		formattedValues := make([]string, len(values))
+		for i, value := range values {
+			formattedValue, err := formatCOQLValue(field, value)
+			if err != nil {
+				return "", err
+			}
+			formattedValues[i] = formattedValue
+		}
+		return fmt.Sprintf("%s in (%s)", field.Name, strings.Join(formattedValues, ", ")), nil
+	}
+
+	return "", fmt.Errorf("unsupported filter operator %s", condition.Operator)
+}
+
+// Evaluates the condition on a constant field, the result doesn't depend on the record
+func compileCOQLConstantCondition(condition connectors.FilterCondition, constant string) (string, error) {
+	matches := false
+
+	switch condition.Operator {
+	case connectors.FILTER_OPERATOR_EQ:
+		value, _ := condition.Value.(string)
+		matches = value == constant
+	case connectors.FILTER_OPERATOR_IN:
+		values, _ := condition.Value.([]string)
+		for _, value := range values {
+			matches = matches || value == constant
+		}
+	default:
+		return "", fmt.Errorf("unsupported filter operator %s for %s", condition.Operator, condition.Field)
+	}
+
+	if matches {
+		return zohoAllRecordsCondition, nil
+	}
+
+	return "id is null", nil
+}
+
+// Order of the sorts, the ID is always the last one to make the offsets stable
+func zohoOrderBy(orderBy []*model.SortInput, filterFields map[string]zohoFilterField) (string, error) {
+	clauses := []string{}
+	for _, sort := range orderBy {
+		field, ok := filterFields[sort.Field]
+		if !ok || field.Constant != nil {
+			return "", fmt.Errorf("sorting by %s is not supported", sort.Field)
+		}
+
+		if connectors.IsDescending(sort) {
+			clauses = append(clauses, field.Name+" desc")
+		} else {
+			clauses = append(clauses, field.Name+" asc")
+		}
+	}
+
+	return strings.Join(append(clauses, "id asc"), ", "), nil
+}
+
+func formatCOQLValue(field zohoFilterField, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case time.Time:
+		if field.Type == ZOHO_FIELD_TYPE_DATE {
+			return formatCOQLString(v.UTC().Format(ZOHO_DATE_FORMAT)), nil
+		}
+		return formatCOQLString(formatZohoDateTime(v)), nil
+	case string:
+		switch field.Type {
+		case ZOHO_FIELD_TYPE_ID:
+			return parseZohoID(v)
+		case ZOHO_FIELD_TYPE_NUMBER:
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				return "", fmt.Errorf("invalid value %s of %s", v, field.Name)
+			}
+			return v, nil
+		}
+		return formatCOQLString(v), nil
+	}
+
+	return formatCOQLString(fmt.Sprint(value)), nil
+}
+
+// Backslashes and single quotes are escaped in COQL string literals
+func formatCOQLString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
@@ -0,0 +1,124 @@
+package zoho
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	NOTES_MODULE = "Notes"
+
+	ZOHO_NOTE_TITLE_LENGTH = 30
+)
+
+// https://www.zoho.com/crm/developer/docs/api/v5/get-notes.html
+type ZohoNote struct {
+	ID           string  `json:"id"`
+	NoteTitle    *string `json:"Note_Title"`
+	NoteContent  *string `json:"Note_Content"`
+	CreatedTime  *string `json:"Created_Time"`
+	ModifiedTime *string `json:"Modified_Time"`
+}
+
+type ZohoNoteCreatePayload struct {
+	NoteTitle   string `json:"Note_Title"`
+	NoteContent string `json:"Note_Content"`
+}
+
+func (client *Client) ListContactNotes(ctx context.Context, contactId string) ([]*model.Note, error) {
+	return client.listNotes(CONTACTS_MODULE, contactId)
+}
+
+func (client *Client) CreateContactNote(ctx context.Context, contactId string, input *model.NoteInput) (*model.Note, error) {
+	return client.createNote(CONTACTS_MODULE, contactId, input)
+}
+
+func (client *Client) ListOpportunityNotes(ctx context.Context, opportunityId string) ([]*model.Note, error) {
+	return client.listNotes(DEALS_MODULE, opportunityId)
+}
+
+func (client *Client) CreateOpportunityNote(ctx context.Context, opportunityId string, input *model.NoteInput) (*model.Note, error) {
+	return client.createNote(DEALS_MODULE, opportunityId, input)
+}
+
+// Lists the notes of the record, up to the size of a single page
+func (client *Client) listNotes(module string, recordId string) ([]*model.Note, error) {
+	id, err := parseZohoID(recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("fields", strings.Join(zohoSelectFields(ZohoNote{}, false), ","))
+	query.Set("sort_by", "Created_Time")
+	query.Set("sort_order", "desc")
+
+	log.Infof("Listing notes of %s #%s", module, id)
+
+	zohoNotes := []ZohoNote{}
+	if err := client.getURL(fmt.Sprintf("%s/%s/%s/Notes?%s", client.baseUrl(), module, id, query.Encode()), &zohoDataResponse{Data: &zohoNotes}); err != nil {
+		log.Errorf("Error listing notes: %s", err)
+		return nil, err
+	}
+
+	notes := make([]*model.Note, len(zohoNotes))
+	for i := range zohoNotes {
+		notes[i] = zohoNotes[i].mapNoteProperties()
+	}
+
+	return notes, nil
+}
+
+// Notes are created in the related list of the record, the created note is read again
+func (client *Client) createNote(module string, recordId string, input *model.NoteInput) (*model.Note, error) {
+	id, err := parseZohoID(recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	noteId, err := client.sendRecordPayload("POST", fmt.Sprintf("%s/%s/%s/Notes", client.baseUrl(), module, id), createZohoNotePayload(input))
+	if err != nil {
+		log.Errorf("Error creating note: %s", err)
+		return nil, err
+	}
+
+	zohoNote := ZohoNote{}
+	if err := client.get(NOTES_MODULE, noteId, &zohoNote); err != nil {
+		log.Errorf("Error getting note: %s", err)
+		return nil, err
+	}
+
+	return zohoNote.mapNoteProperties(), nil
+}
+
+// The title of the note is the beginning of its content
+func createZohoNotePayload(input *model.NoteInput) *ZohoNoteCreatePayload {
+	title := []rune(input.Content)
+	if len(title) > ZOHO_NOTE_TITLE_LENGTH {
+		title = append(title[:ZOHO_NOTE_TITLE_LENGTH], []rune("...")...)
+	}
+
+	return &ZohoNoteCreatePayload{
+		NoteTitle:   string(title),
+		NoteContent: input.Content,
+	}
+}
+
+func (zohoNote *ZohoNote) mapNoteProperties() *model.Note {
+	note := model.Note{
+		ID:        zohoNote.ID,
+		CreatedAt: parseZohoDateTime(zohoNote.CreatedTime),
+		UpdatedAt: parseZohoDateTime(zohoNote.ModifiedTime),
+	}
+
+	if zohoNote.NoteContent != nil {
+		note.Content = *zohoNote.NoteContent
+	}
+
+	return &note
+}
@@ -0,0 +1,221 @@
+package zoho
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DEALS_MODULE = "Deals"
+)
+
+// https://www.zoho.com/crm/developer/docs/api/v5/modules-fields.html
+type ZohoDeal struct {
+	ID           string         `json:"id"`
+	DealName     string         `json:"Deal_Name"`
+	Stage        *string        `json:"Stage"`
+	Amount       *float64       `json:"Amount"`
+	ClosingDate  *string        `json:"Closing_Date"`
+	Account      *ZohoReference `json:"Account_Name"`
+	AccountName  *string        `json:"Account_Name.Account_Name"`
+	Contact      *ZohoReference `json:"Contact_Name"`
+	Owner        *ZohoReference `json:"Owner"`
+	CreatedTime  *string        `json:"Created_Time"`
+	ModifiedTime *string        `json:"Modified_Time"`
+
+	RawFields map[string]interface{} `json:"-"` // for the custom fields
+}
+
+func (zohoDeal *ZohoDeal) UnmarshalJSON(data []byte) error {
+	type plainZohoDeal ZohoDeal
+	return connectors.UnmarshalWithRawFields(data, (*plainZohoDeal)(zohoDeal), &zohoDeal.RawFields)
+}
+
+type ZohoDealCreateUpdatePayload struct {
+	DealName    string                `json:"Deal_Name,omitempty"`
+	Stage       string                `json:"Stage,omitempty"`
+	Amount      *float64              `json:"Amount,omitempty"`
+	ClosingDate string                `json:"Closing_Date,omitempty"`
+	Account     *ZohoReferencePayload `json:"Account_Name,omitempty"`
+	Owner       *ZohoReferencePayload `json:"Owner,omitempty"`
+}
+
+func (client *Client) ListOpportunities(ctx context.Context, params *connectors.ListParams) (*model.OpportunityConnection, error) {
+	zohoDeals := []ZohoDeal{}
+	start, err := client.listPage(DEALS_MODULE, client.zohoSelectFields(ZohoDeal{}, model.CrmObjectOpportunity, true), params, zohoDealFilterFields, zohoDealSearchFields, &zohoDeals)
+	if err != nil {
+		log.Errorf("Error listing opportunities: %s", err)
+		return nil, err
+	}
+
+	opportunityEdges := make([]*model.OpportunityEdge, len(zohoDeals))
+	for i := range zohoDeals {
+		opportunityEdges[i] = &model.OpportunityEdge{
+			Cursor: zohoCursor(start + i),
+			Node:   client.mapOpportunity(&zohoDeals[i]),
+		}
+	}
+
+	recordsValue, pageInfo := client.prepareListResults(params, &opportunityEdges)
+
+	connection := model.OpportunityConnection{
+		Edges:    recordsValue.Interface().([]*model.OpportunityEdge),
+		PageInfo: pageInfo,
+	}
+
+	if params.IncludeTotalCount {
+		totalCount, err := client.count(DEALS_MODULE, params, zohoDealFilterFields, zohoDealSearchFields)
+		if err != nil {
+			log.Errorf("Error counting opportunities: %s", err)
+			return nil, err
+		}
+		connection.TotalCount = &totalCount
+	}
+
+	return &connection, nil
+}
+
+func (client *Client) GetOpportunity(ctx context.Context, opportunityId string) (*model.Opportunity, error) {
+	zohoDeal := ZohoDeal{}
+	if err := client.get(DEALS_MODULE, opportunityId, &zohoDeal); err != nil {
+		log.Errorf("Error getting opportunity: %s", err)
+		return nil, err
+	}
+
+	return client.mapOpportunity(&zohoDeal), nil
+}
+
+// Create opportunity using GraphQL input, the created deal is read again
+func (client *Client) CreateOpportunity(ctx context.Context, input *model.OpportunityInput) (*model.Opportunity, error) {
+	payload, err := client.createZohoDealPayload(input)
+	if err != nil {
+		return nil, err
+	}
+
+	opportunityId, err := client.create(DEALS_MODULE, payload)
+	if err != nil {
+		log.Errorf("Error creating opportunity: %s", err)
+		return nil, err
+	}
+
+	return client.GetOpportunity(ctx, opportunityId)
+}
+
+func (client *Client) UpdateOpportunity(ctx context.Context, opportunityId string, input *model.OpportunityInput) (bool, error) {
+	payload, err := client.createZohoDealPayload(input)
+	if err != nil {
+		return false, err
+	}
+
+	success, err := client.update(DEALS_MODULE, opportunityId, payload)
+	if !success || err != nil {
+		log.Errorf("Error updating opportunity #%s: %s", opportunityId, err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) DeleteOpportunity(ctx context.Context, opportunityId string) (bool, error) {
+	return client.delete(DEALS_MODULE, opportunityId)
+}
+
+// The contact of the deal is the only one Zoho stores on the deal itself
+func (client *Client) ListOpportunityContacts(ctx context.Context, opportunityId string) ([]*model.Contact, error) {
+	zohoDeal := ZohoDeal{}
+	if err := client.get(DEALS_MODULE, opportunityId, &zohoDeal); err != nil {
+		return nil, err
+	}
+
+	contacts := []*model.Contact{}
+	if zohoDeal.Contact == nil {
+		return contacts, nil
+	}
+
+	contact, err := client.GetContact(ctx, zohoDeal.Contact.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(contacts, contact), nil
+}
+
+// Creates Zoho Deal Update/Create payload from GraphQL input, the stage name is the value of the stage picklist
+func (client *Client) createZohoDealPayload(input *model.OpportunityInput) (map[string]interface{}, error) {
+	payload := ZohoDealCreateUpdatePayload{
+		DealName: input.Name,
+		Stage:    input.StageName,
+	}
+
+	if input.PipelineID != nil && *input.PipelineID != ZOHO_DEFAULT_PIPELINE_ID {
+		return nil, fmt.Errorf("unknown pipeline %s", *input.PipelineID)
+	}
+
+	if input.Amount != nil {
+		amount, err := strconv.ParseFloat(*input.Amount, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %s", *input.Amount)
+		}
+		payload.Amount = &amount
+	}
+
+	if !input.CloseDate.IsZero() {
+		payload.ClosingDate = input.CloseDate.UTC().Format(ZOHO_DATE_FORMAT)
+	}
+
+	var err error
+	if payload.Account, err = zohoReferencePayload(input.CompanyID); err != nil {
+		return nil, err
+	}
+	if payload.Owner, err = zohoReferencePayload(input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	return connectors.MergePayload(&payload, client.FieldMapping.CrmFields(model.CrmObjectOpportunity, input.CustomFields))
+}
+
+// Maps the opportunity with its custom fields
+func (client *Client) mapOpportunity(zohoDeal *ZohoDeal) *model.Opportunity {
+	opportunity := zohoDeal.mapOpportunityProperties()
+	opportunity.CustomFields = client.FieldMapping.CustomFields(model.CrmObjectOpportunity, zohoDeal.RawFields)
+
+	return opportunity
+}
+
+func (zohoDeal *ZohoDeal) mapOpportunityProperties() *model.Opportunity {
+	pipelineId := ZOHO_DEFAULT_PIPELINE_ID
+
+	opportunity := model.Opportunity{
+		ID:         zohoDeal.ID,
+		Name:       zohoDeal.DealName,
+		StageName:  zohoDeal.Stage,
+		PipelineID: &pipelineId,
+		CloseDate:  parseZohoDate(zohoDeal.ClosingDate),
+		Owner:      zohoOwnerReference(zohoDeal.Owner),
+		CreatedAt:  parseZohoDateTime(zohoDeal.CreatedTime),
+		UpdatedAt:  parseZohoDateTime(zohoDeal.ModifiedTime),
+	}
+
+	if zohoDeal.Amount != nil {
+		amount := strconv.FormatFloat(*zohoDeal.Amount, 'f', -1, 64)
+		opportunity.Amount = &amount
+	}
+
+	if zohoDeal.Account != nil {
+		opportunity.Company = &model.Company{ID: zohoDeal.Account.ID}
+
+		// COQL returns the name of the account as a field of the deal
+		if zohoDeal.Account.Name != nil {
+			opportunity.Company.Name = *zohoDeal.Account.Name
+		} else if zohoDeal.AccountName != nil {
+			opportunity.Company.Name = *zohoDeal.AccountName
+		}
+	}
+
+	return &opportunity
+}
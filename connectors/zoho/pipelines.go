@@ -0,0 +1,80 @@
+package zoho
+
+import (
+	"blendbase/graph/model"
+	"context"
+	"fmt"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Deals move through the values of the stage picklist, they are read as a single pipeline
+	ZOHO_DEFAULT_PIPELINE_ID    = "default"
+	ZOHO_DEFAULT_PIPELINE_LABEL = "Deals"
+)
+
+// https://www.zoho.com/crm/developer/docs/api/v5/field-meta.html
+type ZohoFieldsResponse struct {
+	Fields []ZohoField `json:"fields"`
+}
+
+type ZohoField struct {
+	APIName        string              `json:"api_name"`
+	PickListValues []ZohoPickListValue `json:"pick_list_values"`
+}
+
+type ZohoPickListValue struct {
+	DisplayValue   string `json:"display_value"`
+	ActualValue    string `json:"actual_value"`
+	SequenceNumber int    `json:"sequence_number"`
+}
+
+func (client *Client) ListPipelines(ctx context.Context) ([]*model.Pipeline, error) {
+	values, err := client.listPickListValues(DEALS_MODULE, "Stage")
+	if err != nil {
+		log.Errorf("Error listing deal stages: %s", err)
+		return nil, err
+	}
+
+	stages := make([]*model.PipelineStage, len(values))
+	for i := range values {
+		displayOrder := i
+		stages[i] = &model.PipelineStage{
+			ID:           values[i].ActualValue,
+			Label:        values[i].DisplayValue,
+			DisplayOrder: &displayOrder,
+		}
+	}
+
+	pipeline := model.Pipeline{
+		ID:     ZOHO_DEFAULT_PIPELINE_ID,
+		Label:  ZOHO_DEFAULT_PIPELINE_LABEL,
+		Stages: stages,
+	}
+
+	return []*model.Pipeline{&pipeline}, nil
+}
+
+// Lists the values of the picklist field in their display order
+func (client *Client) listPickListValues(module string, fieldName string) ([]ZohoPickListValue, error) {
+	response := ZohoFieldsResponse{}
+	if err := client.getURL(fmt.Sprintf("%s/settings/fields?module=%s", client.baseUrl(), module), &response); err != nil {
+		return nil, err
+	}
+
+	for _, field := range response.Fields {
+		if field.APIName != fieldName {
+			continue
+		}
+
+		values := field.PickListValues
+		sort.SliceStable(values, func(i, j int) bool {
+			return values[i].SequenceNumber < values[j].SequenceNumber
+		})
+		return values, nil
+	}
+
+	return nil, fmt.Errorf("field %s of %s not found", fieldName, module)
+}
@@ -0,0 +1,52 @@
+package zoho
+
+import (
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+	"fmt"
+)
+
+func init() {
+	connectors.Register(connectors.Registration{
+		Connector: connectors.Connector{
+			ServiceCode: connectors.CONNECTOR_CRM_ZOHO,
+			Type:        connectors.CONNECTOR_TYPE_CRM,
+			Name:        "Zoho CRM",
+			Description: "Zoho CRM is an online sales CRM that manages the sales, marketing and support of businesses of all sizes.",
+			AuthType:    connectors.AUTH_TYPE_OAUTH2,
+		},
+		Position: 5,
+		NewCrmConnector: func(app *config.App, integration *integrations.ConsumerIntegration, oauthConfig *integrations.ConsumerOauth2Configuration, fieldMapping connectors.FieldMapping) connectors.CrmConnector {
+			client := ZohoClient(app, oauthConfig)
+			client.FieldMapping = fieldMapping
+			return client
+		},
+		OAuth2: &oauth2Flow,
+		// companies, leads, tasks, activities and users aren't mapped yet
+		Capabilities: []*model.ObjectCapabilities{
+			connectors.ObjectOperationCapabilities(model.CrmObjectContact, []model.CrmOperation{model.CrmOperationList, model.CrmOperationGet, model.CrmOperationCreate, model.CrmOperationUpdate, model.CrmOperationDelete}, "id", "createdAt", "updatedAt", "name", "firstName", "lastName", "email", "phone", "companyName", "company", "owner", "customFields"),
+			connectors.ObjectOperationCapabilities(model.CrmObjectOpportunity, []model.CrmOperation{model.CrmOperationList, model.CrmOperationGet, model.CrmOperationCreate, model.CrmOperationUpdate, model.CrmOperationDelete}, "id", "createdAt", "updatedAt", "name", "amount", "stageName", "pipelineId", "closeDate", "stage", "company", "owner", "customFields"),
+			connectors.ObjectCapabilities(model.CrmObjectNote, "id", "createdAt", "updatedAt", "content"),
+			connectors.ObjectCapabilities(model.CrmObjectPipeline, connectors.PipelineFields...),
+			connectors.ObjectCapabilities(model.CrmObjectChange, connectors.ChangeFields...),
+		},
+	})
+}
+
+// The data center is replaced by the one of the account when the integration is authorized
+func oauth2CustomSettings(input *model.OAuth2ConfigurationInput) (*integrations.ConsumerOauth2ConfigurationCustomSettings, error) {
+	if input.ZohoDataCenter != nil && !IsDataCenter(*input.ZohoDataCenter) {
+		return nil, fmt.Errorf("unknown zohoDataCenter %s", *input.ZohoDataCenter)
+	}
+
+	customSettings := integrations.ConsumerOauth2ConfigurationCustomSettings{
+		ZohoDataCenter: ZOHO_DEFAULT_DATA_CENTER,
+	}
+	if input.ZohoDataCenter != nil {
+		customSettings.ZohoDataCenter = *input.ZohoDataCenter
+	}
+
+	return &customSettings, nil
+}
@@ -0,0 +1,513 @@
+package zoho
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"blendbase/config"
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+)
+
+const (
+	BaseUrlTemplate  = "%s/crm/v5"
+	ZOHO_DATE_FORMAT = "2006-01-02"
+
+	// COQL reads up to 2000 records at once
+	ZOHO_MAX_PAGE_SIZE = 2000
+)
+
+// Only contacts, deals and notes are mapped so far
+var ErrNotSupported = errors.New("not supported by the Zoho CRM connector")
+
+type Client struct {
+	APIDomain    string // e.g. https://www.zohoapis.eu
+	HTTPClient   *http.Client
+	FieldMapping connectors.FieldMapping
+
+	app                 *config.App
+	consumerOAuthConfig *integrations.ConsumerOauth2Configuration
+}
+
+// https://www.zoho.com/crm/developer/docs/api/v5/status-codes.html
+type ZohoErrorResponse struct {
+	ZohoActionResult
+	Data []ZohoActionResult `json:"data"` // errors of the records of the payload
+}
+
+// Result of the action on a single record, e.g. the creation of a contact
+type ZohoActionResult struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+	Details struct {
+		ID      string `json:"id"`
+		APIName string `json:"api_name"` // field of the error
+	} `json:"details"`
+}
+
+type ZohoActionResponse struct {
+	Data []ZohoActionResult `json:"data"`
+}
+
+type ZohoListInfo struct {
+	Count       int  `json:"count"`
+	MoreRecords bool `json:"more_records"`
+}
+
+// Lookup of another record, COQL returns the ID only
+type ZohoReference struct {
+	ID    string  `json:"id"`
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+type ZohoReferencePayload struct {
+	ID string `json:"id"`
+}
+
+func (result *ZohoActionResult) Error() string {
+	if result.Details.APIName != "" {
+		return fmt.Sprintf("%s: %s", result.Message, result.Details.APIName)
+	}
+
+	return result.Message
+}
+
+func ZohoClient(app *config.App, consumerOAuthConfig *integrations.ConsumerOauth2Configuration) *Client {
+	context := context.Background()
+
+	// the API domain may be returned with the refreshed token
+	httpClient := oauth2Flow.Client(context, app, consumerOAuthConfig)
+
+	return &Client{
+		APIDomain:  getAPIDomain(consumerOAuthConfig),
+		HTTPClient: httpClient,
+
+		app:                 app,
+		consumerOAuthConfig: consumerOAuthConfig,
+	}
+}
+
+// Loads the client of the integration of the consumer, the latest integration of the connector unless an integration ID is given
+func LoadClientFromDB(app *config.App, consumer *integrations.Consumer, integrationID *uuid.UUID) (*Client, error) {
+	consumerOAuthConfig, err := connectors.LoadOAuth2Configuration(app, consumer, connectors.CONNECTOR_CRM_ZOHO, integrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ZohoClient(app, consumerOAuthConfig), nil
+}
+
+func (client *Client) baseUrl() string {
+	return fmt.Sprintf(BaseUrlTemplate, client.APIDomain)
+}
+
+func (client *Client) sendAPIRequest(req *http.Request, response interface{}) error {
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized && client.consumerOAuthConfig != nil {
+		// the token may be revoked or expire before its expiration, it's refreshed when the API rejects it
+		if err := client.refreshToken(); err != nil {
+			return err
+		}
+
+		// retry with the body read again
+		if err := connectors.RewindRequestBody(req); err != nil {
+			return err
+		}
+		res, err = client.HTTPClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+	}
+
+	log.WithFields(log.Fields{
+		"status_code": res.StatusCode,
+		"url":         req.URL.Path,
+		"method":      req.Method,
+	}).Info("Zoho request")
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var errRes ZohoErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			if errRes.Message != "" {
+				return &errRes.ZohoActionResult
+			}
+			if len(errRes.Data) > 0 && errRes.Data[0].Message != "" {
+				return &errRes.Data[0]
+			}
+		}
+
+		return fmt.Errorf("unexpected error response with status code %d", res.StatusCode)
+	}
+	// Zoho has no content for the empty lists
+	if res.StatusCode == http.StatusNoContent || response == nil {
+		return nil
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(response); err != nil {
+		log.Errorf("Error decoding response body: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// === API Specific Functions ===
+// Gets a record of the module by ID, response is a pointer to the record struct
+func (client *Client) get(module string, recordId string, response interface{}) error {
+	id, err := parseZohoID(recordId)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Fetching %s #%s", module, id)
+
+	records := reflect.New(reflect.SliceOf(reflect.TypeOf(response).Elem()))
+	if err := client.getURL(fmt.Sprintf("%s/%s/%s", client.baseUrl(), module, id), &zohoDataResponse{Data: records.Interface()}); err != nil {
+		return err
+	}
+
+	if records.Elem().Len() == 0 {
+		return fmt.Errorf("%s #%s not found", module, id)
+	}
+	reflect.ValueOf(response).Elem().Set(records.Elem().Index(0))
+
+	return nil
+}
+
+// Records of the module or the related list, the records are decoded into the slice of data
+type zohoDataResponse struct {
+	Data interface{}  `json:"data"`
+	Info ZohoListInfo `json:"info"`
+}
+
+func (client *Client) getURL(url string, response interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	return client.sendAPIRequest(req, response)
+}
+
+// Creates a record of the module and returns its ID, Zoho doesn't return the created record
+func (client *Client) create(module string, payload interface{}) (string, error) {
+	log.Debugf("creating %s", module)
+
+	return client.sendRecordPayload("POST", fmt.Sprintf("%s/%s", client.baseUrl(), module), payload)
+}
+
+func (client *Client) update(module string, recordId string, payload interface{}) (bool, error) {
+	id, err := parseZohoID(recordId)
+	if err != nil {
+		return false, err
+	}
+
+	log.Debugf("updating %s #%s", module, id)
+
+	if _, err := client.sendRecordPayload("PUT", fmt.Sprintf("%s/%s/%s", client.baseUrl(), module, id), payload); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (client *Client) delete(module string, recordId string) (bool, error) {
+	id, err := parseZohoID(recordId)
+	if err != nil {
+		return false, err
+	}
+
+	log.Debugf("deleting %s #%s", module, id)
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s/%s", client.baseUrl(), module, id), nil)
+	if err != nil {
+		return false, err
+	}
+
+	response := ZohoActionResponse{}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		return false, err
+	}
+	if len(response.Data) > 0 && response.Data[0].Status != "success" {
+		return false, &response.Data[0]
+	}
+
+	return true, nil
+}
+
+// Records are written in batches, the payload is the single record of the batch
+func (client *Client) sendRecordPayload(method string, url string, payload interface{}) (string, error) {
+	encodedPayload, err := json.Marshal(map[string]interface{}{"data": []interface{}{payload}})
+	if err != nil {
+		log.Errorf("Error encoding payload %v: %s", payload, err)
+		return "", err
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(encodedPayload))
+	if err != nil {
+		return "", err
+	}
+
+	response := ZohoActionResponse{}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		return "", err
+	}
+
+	if len(response.Data) == 0 {
+		return "", errors.New("unexpected empty response")
+	}
+	if response.Data[0].Status != "success" {
+		return "", &response.Data[0]
+	}
+
+	return response.Data[0].Details.ID, nil
+}
+
+// Runs the COQL query, records is a pointer to the slice of the record structs
+// https://www.zoho.com/crm/developer/docs/api/v5/COQL-Overview.html
+func (client *Client) query(query string, records interface{}) (*ZohoListInfo, error) {
+	encodedPayload, err := json.Marshal(map[string]string{"select_query": query})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/coql", client.baseUrl()), bytes.NewBuffer(encodedPayload))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Querying %s", query)
+
+	response := zohoDataResponse{Data: records}
+	if err := client.sendAPIRequest(req, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Info, nil
+}
+
+// Lists a page of the records of the module. The lists are paginated with an offset, so the cursors hold the offsets of the records.
+// Returns the offset of the first returned record.
+func (client *Client) listPage(module string, fields []string, params *connectors.ListParams, filterFields map[string]zohoFilterField, searchFields []string, records interface{}) (int, error) {
+	start, limit, err := zohoPage(params)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == 0 {
+		// nothing is before the first record
+		return start, nil
+	}
+
+	query, err := zohoListQuery(module, fields, params, filterFields, searchFields)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = client.query(query, records)
+	return start, err
+}
+
+// Offset and number of the records to read for the page, including the record
+// that prepareListResults uses to see if there are more pages
+func zohoPage(params *connectors.ListParams) (int, int, error) {
+	if params.Backward() {
+		if params.Before == nil {
+			// the last page is unknown without the total count
+			return 0, 0, errors.New("last can only be used with before")
+		}
+
+		before, err := zohoCursorOffset(*params.Before)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// +1 to see if there are more pages
+		start := before - *params.Last - 1
+		if start < 0 {
+			start = 0
+		}
+		return start, before - start, nil
+	}
+
+	if params.After == nil {
+		// +1 to see if there are more pages
+		return 0, params.First + 1, nil
+	}
+
+	after, err := zohoCursorOffset(*params.After)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return after + 1, params.First + 1, nil
+}
+
+// Returns cursor of the record at the given offset
+func zohoCursor(offset int) string {
+	return connectors.EncodeCursor(strconv.Itoa(offset))
+}
+
+func zohoCursorOffset(cursor string) (int, error) {
+	offset, err := strconv.Atoi(connectors.DecodeCursor(cursor))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid cursor")
+	}
+
+	return offset, nil
+}
+
+func (client *Client) prepareListResults(params *connectors.ListParams, edgesPtr interface{}) (reflect.Value, *model.PageInfo) {
+	recordsValue := reflect.ValueOf(edgesPtr).Elem()
+	recordsLenght := recordsValue.Len()
+
+	start := 0
+	end := recordsLenght
+	pageInfo := &model.PageInfo{}
+
+	if params.Backward() {
+		// remove the item that was added to see if there are more pages
+		if recordsLenght > *params.Last {
+			start = recordsLenght - *params.Last
+			pageInfo.HasPreviousPage = true
+		}
+		pageInfo.HasNextPage = params.Before != nil
+	} else {
+		if recordsLenght > params.First {
+			// remove the item that was added to see if there are more pages
+			end = params.First
+			pageInfo.HasNextPage = true
+		}
+		pageInfo.HasPreviousPage = params.After != nil
+	}
+
+	ret := recordsValue.Slice(start, end)
+
+	if ret.Len() > 0 {
+		startCursor := ret.Index(0).Elem().FieldByName("Cursor").String()
+		endCursor := ret.Index(ret.Len() - 1).Elem().FieldByName("Cursor").String()
+
+		pageInfo.StartCursor = &startCursor
+		pageInfo.EndCursor = &endCursor
+	}
+
+	return ret, pageInfo
+}
+
+// Zoho IDs are long integers, they are validated before they are put into the paths and the queries
+func parseZohoID(id string) (string, error) {
+	if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid ID %s", id)
+	}
+
+	return id, nil
+}
+
+func zohoReferencePayload(id *string) (*ZohoReferencePayload, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	zohoId, err := parseZohoID(*id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ZohoReferencePayload{ID: zohoId}, nil
+}
+
+func zohoOwnerReference(reference *ZohoReference) *model.User {
+	if reference == nil {
+		return nil
+	}
+
+	return &model.User{ID: reference.ID, Name: reference.Name, Email: reference.Email}
+}
+
+func parseZohoDateTime(dateTime *string) *time.Time {
+	if dateTime == nil || *dateTime == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *dateTime)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"dateTime": *dateTime,
+		}).Error("Failed to parse dateTime")
+		return nil
+	}
+
+	return &t
+}
+
+func parseZohoDate(date *string) *time.Time {
+	if date == nil || *date == "" {
+		return nil
+	}
+
+	t, err := time.Parse(ZOHO_DATE_FORMAT, *date)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"date": *date,
+		}).Error("Failed to parse date")
+		return nil
+	}
+
+	return &t
+}
+
+// Zoho rejects the "Z" suffix of UTC times, the offset is always given
+func formatZohoDateTime(dateTime time.Time) string {
+	return dateTime.UTC().Format("2006-01-02T15:04:05-07:00")
+}
+
+// Fields read from the module, they are the JSON names of the struct fields.
+// Fields of the lookups, e.g. "Account_Name.Account_Name", can only be read by COQL.
+func zohoSelectFields(record interface{}, lookupFields bool) []string {
+	fields := []string{}
+	recordType := reflect.TypeOf(record)
+	for i := 0; i < recordType.NumField(); i++ {
+		name := strings.Split(recordType.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || (!lookupFields && strings.Contains(name, ".")) {
+			continue
+		}
+		fields = append(fields, name)
+	}
+
+	return fields
+}
+
+// Fields read from the module with the mapped fields of the object, for the custom fields of the records
+func (client *Client) zohoSelectFields(record interface{}, object model.CrmObject, lookupFields bool) []string {
+	fields := []string{}
+	for _, field := range client.FieldMapping.QueryFields(object, zohoSelectFields(record, lookupFields)) {
+		if lookupFields || !strings.Contains(field, ".") {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,258 @@
+package zoho
+
+import (
+	"blendbase/connectors"
+	"blendbase/graph/model"
+	"blendbase/integrations"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+	"gorm.io/datatypes"
+)
+
+const (
+	contactId = "4150868000000224005"
+	companyId = "4150868000000224001"
+)
+
+// Client of a fake API, the handler gets the requests sent to the API domain
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{APIDomain: server.URL, HTTPClient: server.Client()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func TestListContactsPagination(t *testing.T) {
+	var selectQuery string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v5/coql", r.URL.Path, "expecting a COQL query")
+
+		payload := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		selectQuery = payload["select_query"]
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": contactId, "Full_Name": "Jane Doe", "Account_Name": map[string]string{"id": companyId}, "Account_Name.Account_Name": "Acme"},
+				{"id": "4150868000000224006", "Full_Name": "John Doe"},
+			},
+			"info": map[string]interface{}{"count": 2, "more_records": true},
+		})
+	})
+
+	ctx := context.Background()
+	contactConnection, err := c.ListContacts(ctx, &connectors.ListParams{First: 1})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Contains(t, selectQuery, "where id is not null order by id asc limit 2 offset 0", "expecting one more record to be read")
+	assert.Equal(t, 1, len(contactConnection.Edges), "expecting single result")
+	assert.True(t, contactConnection.PageInfo.HasNextPage, "expecting a next page")
+	assert.Equal(t, "Acme", *contactConnection.Edges[0].Node.CompanyName, "expecting the name of the account as the company name")
+
+	contactConnection, err = c.ListContacts(ctx, &connectors.ListParams{First: 1, After: contactConnection.PageInfo.EndCursor})
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Contains(t, selectQuery, "limit 2 offset 1", "expecting the contacts after the cursor")
+	assert.True(t, contactConnection.PageInfo.HasPreviousPage, "expecting a previous page")
+}
+
+func TestContactCRUD(t *testing.T) {
+	requests := []string{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "POST":
+			payload := struct {
+				Data []map[string]interface{} `json:"data"`
+			}{}
+			json.NewDecoder(r.Body).Decode(&payload)
+			assert.Equal(t, map[string]interface{}{"id": companyId}, payload.Data[0]["Account_Name"], "expecting the company to be referenced")
+
+			writeJSON(w, http.StatusCreated, map[string]interface{}{
+				"data": []map[string]interface{}{{"code": "SUCCESS", "status": "success", "details": map[string]string{"id": contactId}}},
+			})
+		case "GET":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{{"id": contactId, "Email": "jane.doe@example.com", "Account_Name": map[string]string{"id": companyId, "name": "Acme"}}},
+			})
+		case "PUT":
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+				"data": []map[string]interface{}{{"code": "INVALID_DATA", "status": "error", "message": "invalid data", "details": map[string]string{"api_name": "Email"}}},
+			})
+		case "DELETE":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{{"code": "SUCCESS", "status": "success", "details": map[string]string{"id": contactId}}},
+			})
+		}
+	})
+
+	ctx := context.Background()
+	email := "jane.doe@example.com"
+	company := companyId
+
+	contact, err := c.CreateContact(ctx, &model.ContactInput{Email: &email, CompanyID: &company})
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, contactId, contact.ID, "expecting the ID of the created contact")
+	assert.Equal(t, email, *contact.Email, "expecting an email for the contact equal to the created one")
+	assert.Equal(t, "Acme", contact.Company.Name, "expecting the name of the account")
+
+	invalidEmail := "jane.doe"
+	success, err := c.UpdateContact(ctx, contact.ID, &model.ContactInput{Email: &invalidEmail})
+	assert.EqualError(t, err, "invalid data: Email", "expecting the error of the record")
+	assert.False(t, success, "expecting failed update")
+
+	success, err = c.DeleteContact(ctx, contact.ID)
+	assert.Nil(t, err, "expecting nil error")
+	assert.True(t, success, "expecting successful deletion")
+
+	_, err = c.DeleteContact(ctx, "0035f00000AHo1uAAD")
+	assert.NotNil(t, err, "expecting an error for an invalid ID")
+
+	assert.Equal(t, []string{
+		"POST /crm/v5/Contacts",
+		"GET /crm/v5/Contacts/" + contactId,
+		"PUT /crm/v5/Contacts/" + contactId,
+		"DELETE /crm/v5/Contacts/" + contactId,
+	}, requests, "expecting no request for the invalid ID")
+}
+
+func TestListPipelines(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Deals", r.URL.Query().Get("module"), "expecting the fields of the deals")
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"fields": []map[string]interface{}{
+				{"api_name": "Deal_Name"},
+				{"api_name": "Stage", "pick_list_values": []map[string]interface{}{
+					{"display_value": "Closed Won", "actual_value": "Closed Won", "sequence_number": 2},
+					{"display_value": "Qualification", "actual_value": "Qualification", "sequence_number": 1},
+				}},
+			},
+		})
+	})
+
+	pipelines, err := c.ListPipelines(context.Background())
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(pipelines), "expecting a single pipeline")
+	assert.Equal(t, ZOHO_DEFAULT_PIPELINE_ID, pipelines[0].ID, "expecting the default pipeline")
+	assert.Equal(t, "Qualification", pipelines[0].Stages[0].ID, "expecting the stages in their display order")
+}
+
+func TestListChanges(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v5/Contacts", r.URL.Path, "expecting the contacts only")
+		assert.Equal(t, "2022-10-01T10:00:00+00:00", r.Header.Get("If-Modified-Since"), "expecting the start of the feed")
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": contactId, "Created_Time": "2022-10-01T12:30:00+02:00", "Modified_Time": "2022-10-01T12:30:00+02:00"},
+			},
+			"info": map[string]interface{}{"more_records": false},
+		})
+	})
+
+	since := time.Date(2022, 10, 1, 10, 0, 0, 0, time.UTC)
+	params, err := connectors.NewChangesParams(&since, 10, nil, []model.ChangeObjectType{model.ChangeObjectTypeContact})
+	assert.Nil(t, err, "expecting nil error")
+
+	changeConnection, err := c.ListChanges(context.Background(), params)
+
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, 1, len(changeConnection.Edges), "expecting single change")
+	assert.Equal(t, model.ChangeTypeCreated, changeConnection.Edges[0].Node.ChangeType, "expecting the contact created since the start of the feed")
+	assert.False(t, changeConnection.PageInfo.HasNextPage, "expecting no next page")
+}
+
+func TestListQuery(t *testing.T) {
+	ownerId := "4150868000000225013"
+	stageName := "Qualification"
+	query := "O'Brien"
+	direction := model.SortDirectionDesc
+
+	selectQuery, err := zohoListQuery(DEALS_MODULE, []string{"id", "Deal_Name"}, &connectors.ListParams{
+		First: 10,
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			Or: []*model.OpportunityFilter{
+				{OwnerID: &model.IDFilter{Eq: &ownerId}},
+				{StageName: &model.StringFilter{In: []string{stageName}}},
+			},
+		}),
+		Query:   &query,
+		OrderBy: []*model.SortInput{{Field: "updatedAt", Direction: &direction}},
+	}, zohoDealFilterFields, zohoDealSearchFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "select id, Deal_Name from Deals where (((Owner = "+ownerId+") or (Stage in ('Qualification')))) and (Deal_Name like '%O\\'Brien%') order by Modified_Time desc, id asc limit 11 offset 0", selectQuery, "expecting the alternatives and the escaped search")
+
+	last := 5
+	before := zohoCursor(3)
+	selectQuery, err = zohoListQuery(DEALS_MODULE, []string{"id"}, &connectors.ListParams{Last: &last, Before: &before}, zohoDealFilterFields, zohoDealSearchFields)
+	assert.Nil(t, err, "expecting nil error")
+	assert.Equal(t, "select id from Deals where id is not null order by id asc limit 3 offset 0", selectQuery, "expecting the records before the cursor")
+
+	invalidOwnerId := "me"
+	_, err = zohoListQuery(DEALS_MODULE, []string{"id"}, &connectors.ListParams{
+		Filter: connectors.NewOpportunityFilter(&model.OpportunityFilter{
+			OwnerID: &model.IDFilter{Eq: &invalidOwnerId},
+		}),
+	}, zohoDealFilterFields, zohoDealSearchFields)
+	assert.NotNil(t, err, "expecting an error for an invalid ID")
+}
+
+func TestDataCenters(t *testing.T) {
+	dataCenter, ok := DataCenterOfAccountsServer("https://accounts.zoho.eu")
+	assert.True(t, ok, "expecting a known accounts server")
+	assert.Equal(t, "eu", dataCenter, "expecting the data center of the accounts server")
+
+	_, ok = DataCenterOfAccountsServer("https://accounts.zoho.eu.example.com")
+	assert.False(t, ok, "expecting an unknown accounts server")
+
+	customSettingsJson, _ := json.Marshal(integrations.ConsumerOauth2ConfigurationCustomSettings{ZohoDataCenter: "com.au"})
+	consumerOAuthConfig := integrations.ConsumerOauth2Configuration{CustomSettings: datatypes.JSON(customSettingsJson)}
+
+	assert.Equal(t, "https://accounts.zoho.com.au/oauth/v2/token", getOAuthConfig(&consumerOAuthConfig).Endpoint.TokenURL, "expecting the accounts server of the data center")
+	assert.Equal(t, "https://www.zohoapis.com.au", getAPIDomain(&consumerOAuthConfig), "expecting the API domain of the data center")
+
+	token := (&oauth2.Token{}).WithExtra(map[string]interface{}{"api_domain": "https://www.zohoapis.com.au/"})
+	assert.Equal(t, "https://www.zohoapis.com.au", tokenAPIDomain(token, "eu"), "expecting the API domain of the token")
+}
+
+func TestOAuth2Callback(t *testing.T) {
+	consumerOAuthConfig := integrations.ConsumerOauth2Configuration{}
+
+	r := httptest.NewRequest("GET", "/callback?code=1000.abc&accounts-server=https%3A%2F%2Faccounts.zoho.eu", nil)
+	assert.Nil(t, oauth2Flow.PrepareCallback(r, &consumerOAuthConfig), "expecting the accounts server to be known")
+	assert.Equal(t, "https://accounts.zoho.eu/oauth/v2/token", oauth2Flow.Config(&consumerOAuthConfig).Endpoint.TokenURL, "expecting the code to be exchanged in the data center of the account")
+
+	token := (&oauth2.Token{AccessToken: "1000.def"}).WithExtra(map[string]interface{}{"api_domain": "https://www.zohoapis.eu"})
+	assert.Nil(t, oauth2Flow.OnToken(token, &consumerOAuthConfig), "expecting the API domain to be stored")
+	assert.Equal(t, "https://www.zohoapis.eu", getAPIDomain(&consumerOAuthConfig), "expecting the API domain of the token")
+	assert.Equal(t, ZOHO_TOKEN_TYPE, oauth2Flow.Token(&consumerOAuthConfig).TokenType, "expecting the token type of Zoho")
+
+	r = httptest.NewRequest("GET", "/callback?code=1000.abc&accounts-server=https%3A%2F%2Faccounts.example.com", nil)
+	assert.NotNil(t, oauth2Flow.PrepareCallback(r, &consumerOAuthConfig), "expecting an unknown accounts server to be rejected")
+}
+
+func TestCapabilities(t *testing.T) {
+	registration := connectors.Lookup(connectors.CONNECTOR_CRM_ZOHO)
+
+	assert.NotNil(t, registration, "expecting the connector to be registered")
+	assert.True(t, registration.Supports(model.CrmObjectContact, model.CrmOperationUpdate), "expecting contacts to be updatable")
+	assert.False(t, registration.Supports(model.CrmObjectContact, model.CrmOperationLink), "expecting contacts not to be linkable to companies")
+	assert.False(t, registration.Supports(model.CrmObjectCompany, model.CrmOperationList), "expecting companies not to be supported")
+	assert.Equal(t, connectors.CONNECTOR_CRM_ZOHO, registration.CapabilitiesModel().ServiceCode, "expecting the service code of the connector")
+}
@@ -44,7 +44,7 @@ func (r *mutationResolver) CreateConsumer(ctx context.Context) (string, error) {
 	return consumerID.String(), nil
 }
 
-func (r *mutationResolver) EnableConsumerIntegration(ctx context.Context, serviceCode string, enabled bool) (bool, error) {
+func (r *mutationResolver) EnableConsumerIntegration(ctx context.Context, serviceCode string, enabled bool, consumerIntegrationID *string) (bool, error) {
 	connectClient, err := r.getConnectClient(ctx)
 	if err != nil {
 		return false, err
@@ -54,7 +54,57 @@ func (r *mutationResolver) EnableConsumerIntegration(ctx context.Context, servic
 		return false, errors.New(MISSING_CONSUMER_ID_ERROR)
 	}
 
-	success, err := connectClient.EnableIntegration(serviceCode, enabled)
+	var id *uuid.UUID
+	if consumerIntegrationID != nil {
+		parsedID, err := uuid.Parse(*consumerIntegrationID)
+		if err != nil {
+			return false, errors.New("invalid consumer integration id. must be a valid uuid")
+		}
+		id = &parsedID
+	}
+
+	success, err := connectClient.EnableIntegration(serviceCode, enabled, id)
+	if err != nil || !success {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) AddConsumerIntegration(ctx context.Context, serviceCode string) (string, error) {
+	connectClient, err := r.getConnectClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if connectClient.ConsumerID == uuid.Nil {
+		return "", errors.New(MISSING_CONSUMER_ID_ERROR)
+	}
+
+	consumerIntegrationID, err := connectClient.AddIntegration(serviceCode)
+	if err != nil {
+		return "", err
+	}
+
+	return consumerIntegrationID.String(), nil
+}
+
+func (r *mutationResolver) SetDefaultConsumerIntegration(ctx context.Context, consumerIntegrationID string) (bool, error) {
+	connectClient, err := r.getConnectClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if connectClient.ConsumerID == uuid.Nil {
+		return false, errors.New(MISSING_CONSUMER_ID_ERROR)
+	}
+
+	id, err := uuid.Parse(consumerIntegrationID)
+	if err != nil {
+		return false, errors.New("invalid consumer integration id. must be a valid uuid")
+	}
+
+	success, err := connectClient.SetDefaultIntegration(id)
 	if err != nil || !success {
 		return false, err
 	}
@@ -108,6 +158,46 @@ func (r *mutationResolver) ConfigureConsumerIntegrationOAuth(ctx context.Context
 	return true, nil
 }
 
+func (r *mutationResolver) SetConsumerIntegrationFieldMapping(ctx context.Context, consumerIntegrationID string, input model.FieldMappingInput) (bool, error) {
+	connectClient, err := r.getConnectClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if connectClient.ConsumerID == uuid.Nil {
+		return false, errors.New(MISSING_CONSUMER_ID_ERROR)
+	}
+
+	id, err := uuid.Parse(consumerIntegrationID)
+	if err != nil {
+		return false, errors.New("invalid consumer integration id. must be a valid uuid")
+	}
+
+	if err := connectClient.SetFieldMapping(id, &input); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) DeleteConsumerIntegrationFieldMapping(ctx context.Context, consumerIntegrationID string, object model.CrmObject, key string) (bool, error) {
+	connectClient, err := r.getConnectClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if connectClient.ConsumerID == uuid.Nil {
+		return false, errors.New(MISSING_CONSUMER_ID_ERROR)
+	}
+
+	id, err := uuid.Parse(consumerIntegrationID)
+	if err != nil {
+		return false, errors.New("invalid consumer integration id. must be a valid uuid")
+	}
+
+	return connectClient.DeleteFieldMapping(id, object, key)
+}
+
 func (r *queryResolver) Connect(ctx context.Context) (*model.Connect, error) {
 	return &model.Connect{}, nil
 }
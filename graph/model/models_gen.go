@@ -9,9 +9,110 @@ import (
 	"time"
 )
 
+type Activity struct {
+	ID          string       `json:"id"`
+	CreatedAt   *time.Time   `json:"createdAt"`
+	UpdatedAt   *time.Time   `json:"updatedAt"`
+	Type        ActivityType `json:"type"`
+	Subject     *string      `json:"subject"`
+	Description *string      `json:"description"`
+	StartTime   *time.Time   `json:"startTime"`
+	EndTime     *time.Time   `json:"endTime"`
+}
+
+type ActivityInput struct {
+	Type        ActivityType `json:"type"`
+	Subject     *string      `json:"subject"`
+	Description *string      `json:"description"`
+	StartTime   *time.Time   `json:"startTime"`
+	EndTime     *time.Time   `json:"endTime"`
+}
+
+type AllContactConnection struct {
+	Edges []*AllContactEdge `json:"edges"`
+}
+
+type AllContactEdge struct {
+	ServiceCode   string   `json:"serviceCode"`
+	IntegrationID string   `json:"integrationId"`
+	Node          *Contact `json:"node"`
+}
+
+type AllOpportunityConnection struct {
+	Edges []*AllOpportunityEdge `json:"edges"`
+}
+
+type AllOpportunityEdge struct {
+	ServiceCode   string       `json:"serviceCode"`
+	IntegrationID string       `json:"integrationId"`
+	Node          *Opportunity `json:"node"`
+}
+
+type Capabilities struct {
+	ServiceCode string                `json:"serviceCode"`
+	Objects     []*ObjectCapabilities `json:"objects"`
+}
+
+type Change struct {
+	ObjectType  ChangeObjectType `json:"objectType"`
+	ObjectID    string           `json:"objectId"`
+	ChangeType  ChangeType       `json:"changeType"`
+	ChangedAt   time.Time        `json:"changedAt"`
+	Contact     *Contact         `json:"contact"`
+	Opportunity *Opportunity     `json:"opportunity"`
+	Note        *Note            `json:"note"`
+}
+
+type ChangeConnection struct {
+	PageInfo *PageInfo     `json:"pageInfo"`
+	Edges    []*ChangeEdge `json:"edges"`
+}
+
+type ChangeEdge struct {
+	Node   *Change `json:"node"`
+	Cursor string  `json:"cursor"`
+}
+
 type Company struct {
-	Name    string  `json:"name"`
-	Website *string `json:"website"`
+	ID                string         `json:"id"`
+	CreatedAt         *time.Time     `json:"createdAt"`
+	UpdatedAt         *time.Time     `json:"updatedAt"`
+	Archived          *bool          `json:"archived"`
+	Name              string         `json:"name"`
+	Website           *string        `json:"website"`
+	Phone             *string        `json:"phone"`
+	Industry          *string        `json:"industry"`
+	Description       *string        `json:"description"`
+	City              *string        `json:"city"`
+	Country           *string        `json:"country"`
+	NumberOfEmployees *int           `json:"numberOfEmployees"`
+	AnnualRevenue     *string        `json:"annualRevenue"`
+	Owner             *User          `json:"owner"`
+	Contacts          []*Contact     `json:"contacts"`
+	Opportunities     []*Opportunity `json:"opportunities"`
+}
+
+type CompanyConnection struct {
+	PageInfo *PageInfo      `json:"pageInfo"`
+	Edges    []*CompanyEdge `json:"edges"`
+}
+
+type CompanyEdge struct {
+	Node   *Company `json:"node"`
+	Cursor string   `json:"cursor"`
+}
+
+type CompanyInput struct {
+	Name              string  `json:"name"`
+	Website           *string `json:"website"`
+	Phone             *string `json:"phone"`
+	Industry          *string `json:"industry"`
+	Description       *string `json:"description"`
+	City              *string `json:"city"`
+	Country           *string `json:"country"`
+	NumberOfEmployees *int    `json:"numberOfEmployees"`
+	AnnualRevenue     *string `json:"annualRevenue"`
+	OwnerID           *string `json:"ownerId"`
 }
 
 type Connect struct {
@@ -26,30 +127,40 @@ type ConsumerIntegration struct {
 	ServiceName    *string         `json:"serviceName"`
 	Description    *string         `json:"description"`
 	Enabled        *bool           `json:"enabled"`
+	Default        *bool           `json:"default"`
 	CallbackURL    *string         `json:"callbackURL"`
 	LoginURL       *string         `json:"loginURL"`
 	Oauth2Metadata *OAuth2Metadata `json:"oauth2Metadata"`
 	AuthType       AuthType        `json:"authType"`
+	AuthTypes      []AuthType      `json:"authTypes"`
+	Capabilities   *Capabilities   `json:"capabilities"`
+	FieldMappings  []*FieldMapping `json:"fieldMappings"`
 }
 
 type Contact struct {
-	ID          string     `json:"id"`
-	CreatedAt   *time.Time `json:"createdAt"`
-	UpdatedAt   *time.Time `json:"updatedAt"`
-	Archived    *bool      `json:"archived"`
-	Name        *string    `json:"name"`
-	FirstName   *string    `json:"firstName"`
-	LastName    *string    `json:"lastName"`
-	Email       *string    `json:"email"`
-	Phone       *string    `json:"phone"`
-	Website     *string    `json:"website"`
-	CompanyName *string    `json:"companyName"`
-	Notes       []*Note    `json:"notes"`
+	ID           string                 `json:"id"`
+	CreatedAt    *time.Time             `json:"createdAt"`
+	UpdatedAt    *time.Time             `json:"updatedAt"`
+	Archived     *bool                  `json:"archived"`
+	Name         *string                `json:"name"`
+	FirstName    *string                `json:"firstName"`
+	LastName     *string                `json:"lastName"`
+	Email        *string                `json:"email"`
+	Phone        *string                `json:"phone"`
+	Website      *string                `json:"website"`
+	CompanyName  *string                `json:"companyName"`
+	CustomFields map[string]interface{} `json:"customFields"`
+	Company      *Company               `json:"company"`
+	Owner        *User                  `json:"owner"`
+	Notes        []*Note                `json:"notes"`
+	Tasks        []*Task                `json:"tasks"`
+	Activities   []*Activity            `json:"activities"`
 }
 
 type ContactConnection struct {
-	PageInfo *PageInfo      `json:"pageInfo"`
-	Edges    []*ContactEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*ContactEdge `json:"edges"`
+	TotalCount *int           `json:"totalCount"`
 }
 
 type ContactEdge struct {
@@ -57,13 +168,29 @@ type ContactEdge struct {
 	Cursor string   `json:"cursor"`
 }
 
+type ContactFilter struct {
+	And       []*ContactFilter `json:"and"`
+	Or        []*ContactFilter `json:"or"`
+	FirstName *StringFilter    `json:"firstName"`
+	LastName  *StringFilter    `json:"lastName"`
+	Email     *StringFilter    `json:"email"`
+	Phone     *StringFilter    `json:"phone"`
+	CompanyID *IDFilter        `json:"companyId"`
+	OwnerID   *IDFilter        `json:"ownerId"`
+	CreatedAt *DateTimeFilter  `json:"createdAt"`
+	UpdatedAt *DateTimeFilter  `json:"updatedAt"`
+}
+
 type ContactInput struct {
-	CompanyName *string `json:"companyName"`
-	FirstName   *string `json:"firstName"`
-	LastName    *string `json:"lastName"`
-	Email       *string `json:"email"`
-	Phone       *string `json:"phone"`
-	Website     *string `json:"website"`
+	CompanyName  *string                `json:"companyName"`
+	CompanyID    *string                `json:"companyId"`
+	FirstName    *string                `json:"firstName"`
+	LastName     *string                `json:"lastName"`
+	Email        *string                `json:"email"`
+	Phone        *string                `json:"phone"`
+	Website      *string                `json:"website"`
+	OwnerID      *string                `json:"ownerId"`
+	CustomFields map[string]interface{} `json:"customFields"`
 }
 
 type ContactUpdateResponse struct {
@@ -75,6 +202,112 @@ type Crm struct {
 	Contacts      *ContactConnection     `json:"contacts"`
 	Opportunities *OpportunityConnection `json:"opportunities"`
 	Opportunity   *Opportunity           `json:"opportunity"`
+	Companies     *CompanyConnection     `json:"companies"`
+	Company       *Company               `json:"company"`
+	Leads         *LeadConnection        `json:"leads"`
+	Lead          *Lead                  `json:"lead"`
+	Users         *UserConnection        `json:"users"`
+	User          *User                  `json:"user"`
+	Pipelines     []*Pipeline            `json:"pipelines"`
+	Changes       *ChangeConnection      `json:"changes"`
+	Capabilities  *Capabilities          `json:"capabilities"`
+	Describe      *ObjectDescription     `json:"describe"`
+	All           *CrmAll                `json:"all"`
+}
+
+type CrmAll struct {
+	Contacts      *AllContactConnection     `json:"contacts"`
+	Opportunities *AllOpportunityConnection `json:"opportunities"`
+}
+
+type DateTimeFilter struct {
+	Gte *time.Time `json:"gte"`
+	Lte *time.Time `json:"lte"`
+}
+
+type DecimalFilter struct {
+	Eq  *string `json:"eq"`
+	Gte *string `json:"gte"`
+	Lte *string `json:"lte"`
+}
+
+type FieldDescription struct {
+	Name           string           `json:"name"`
+	Label          string           `json:"label"`
+	Type           string           `json:"type"`
+	Required       bool             `json:"required"`
+	Readonly       bool             `json:"readonly"`
+	Custom         bool             `json:"custom"`
+	PicklistValues []*PicklistValue `json:"picklistValues"`
+}
+
+type FieldMapping struct {
+	Object CrmObject `json:"object"`
+	Key    string    `json:"key"`
+	Field  string    `json:"field"`
+}
+
+type FieldMappingInput struct {
+	Object CrmObject `json:"object"`
+	Key    string    `json:"key"`
+	Field  string    `json:"field"`
+}
+
+type IDFilter struct {
+	Eq *string  `json:"eq"`
+	In []string `json:"in"`
+}
+
+type Lead struct {
+	ID          string     `json:"id"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+	Archived    *bool      `json:"archived"`
+	Name        *string    `json:"name"`
+	FirstName   *string    `json:"firstName"`
+	LastName    *string    `json:"lastName"`
+	Email       *string    `json:"email"`
+	Phone       *string    `json:"phone"`
+	Website     *string    `json:"website"`
+	CompanyName *string    `json:"companyName"`
+	Title       *string    `json:"title"`
+	Status      *string    `json:"status"`
+	Converted   *bool      `json:"converted"`
+}
+
+type LeadConnection struct {
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	Edges      []*LeadEdge `json:"edges"`
+	TotalCount *int        `json:"totalCount"`
+}
+
+type LeadConversionInput struct {
+	ConvertedStatus   *string `json:"convertedStatus"`
+	CompanyID         *string `json:"companyId"`
+	CreateOpportunity *bool   `json:"createOpportunity"`
+	OpportunityName   *string `json:"opportunityName"`
+}
+
+type LeadConversionResult struct {
+	ContactID     string  `json:"contactId"`
+	CompanyID     *string `json:"companyId"`
+	OpportunityID *string `json:"opportunityId"`
+}
+
+type LeadEdge struct {
+	Node   *Lead  `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+type LeadInput struct {
+	FirstName   *string `json:"firstName"`
+	LastName    *string `json:"lastName"`
+	Email       *string `json:"email"`
+	Phone       *string `json:"phone"`
+	Website     *string `json:"website"`
+	CompanyName *string `json:"companyName"`
+	Title       *string `json:"title"`
+	Status      *string `json:"status"`
 }
 
 type Note struct {
@@ -92,6 +325,10 @@ type OAuth2ConfigurationInput struct {
 	ClientID                    *string `json:"clientID"`
 	ClientSecret                *string `json:"clientSecret"`
 	SalesforceInstanceSubdomain *string `json:"salesforceInstanceSubdomain"`
+	SalesforceLoginURL          *string `json:"salesforceLoginUrl"`
+	DynamicsOrgURL              *string `json:"dynamicsOrgUrl"`
+	DynamicsTenantID            *string `json:"dynamicsTenantId"`
+	ZohoDataCenter              *string `json:"zohoDataCenter"`
 }
 
 type OAuth2Metadata struct {
@@ -99,18 +336,41 @@ type OAuth2Metadata struct {
 	TokensSet            bool `json:"tokensSet"`
 }
 
+type ObjectCapabilities struct {
+	Object     CrmObject      `json:"object"`
+	Operations []CrmOperation `json:"operations"`
+	Fields     []string       `json:"fields"`
+}
+
+type ObjectDescription struct {
+	Object CrmObject           `json:"object"`
+	Name   string              `json:"name"`
+	Fields []*FieldDescription `json:"fields"`
+}
+
 type Opportunity struct {
-	ID        string     `json:"id"`
-	Name      string     `json:"name"`
-	Amount    *string    `json:"amount"`
-	StageName *string    `json:"stageName"`
-	CloseDate *time.Time `json:"closeDate"`
-	Notes     []*Note    `json:"notes"`
+	ID           string                 `json:"id"`
+	CreatedAt    *time.Time             `json:"createdAt"`
+	UpdatedAt    *time.Time             `json:"updatedAt"`
+	Name         string                 `json:"name"`
+	Amount       *string                `json:"amount"`
+	StageName    *string                `json:"stageName"`
+	PipelineID   *string                `json:"pipelineId"`
+	CloseDate    *time.Time             `json:"closeDate"`
+	CustomFields map[string]interface{} `json:"customFields"`
+	Stage        *PipelineStage         `json:"stage"`
+	Company      *Company               `json:"company"`
+	Owner        *User                  `json:"owner"`
+	Contacts     []*Contact             `json:"contacts"`
+	Notes        []*Note                `json:"notes"`
+	Tasks        []*Task                `json:"tasks"`
+	Activities   []*Activity            `json:"activities"`
 }
 
 type OpportunityConnection struct {
-	PageInfo *PageInfo          `json:"pageInfo"`
-	Edges    []*OpportunityEdge `json:"edges"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*OpportunityEdge `json:"edges"`
+	TotalCount *int               `json:"totalCount"`
 }
 
 type OpportunityEdge struct {
@@ -118,17 +378,153 @@ type OpportunityEdge struct {
 	Cursor string       `json:"cursor"`
 }
 
+type OpportunityFilter struct {
+	And        []*OpportunityFilter `json:"and"`
+	Or         []*OpportunityFilter `json:"or"`
+	Name       *StringFilter        `json:"name"`
+	StageName  *StringFilter        `json:"stageName"`
+	PipelineID *IDFilter            `json:"pipelineId"`
+	CompanyID  *IDFilter            `json:"companyId"`
+	OwnerID    *IDFilter            `json:"ownerId"`
+	Amount     *DecimalFilter       `json:"amount"`
+	CloseDate  *DateTimeFilter      `json:"closeDate"`
+	CreatedAt  *DateTimeFilter      `json:"createdAt"`
+	UpdatedAt  *DateTimeFilter      `json:"updatedAt"`
+}
+
 type OpportunityInput struct {
-	Name      string    `json:"name"`
-	Amount    *string   `json:"amount"`
-	StageName string    `json:"stageName"`
-	CloseDate time.Time `json:"closeDate"`
+	Name         string                 `json:"name"`
+	Amount       *string                `json:"amount"`
+	StageName    string                 `json:"stageName"`
+	PipelineID   *string                `json:"pipelineId"`
+	CloseDate    time.Time              `json:"closeDate"`
+	CompanyID    *string                `json:"companyId"`
+	OwnerID      *string                `json:"ownerId"`
+	CustomFields map[string]interface{} `json:"customFields"`
 }
 
 type PageInfo struct {
-	HasNextPage bool    `json:"hasNextPage"`
-	StartCursor *string `json:"startCursor"`
-	EndCursor   *string `json:"endCursor"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type PicklistValue struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+type Pipeline struct {
+	ID           string           `json:"id"`
+	Label        string           `json:"label"`
+	DisplayOrder *int             `json:"displayOrder"`
+	Stages       []*PipelineStage `json:"stages"`
+}
+
+type PipelineStage struct {
+	ID           string   `json:"id"`
+	Label        string   `json:"label"`
+	DisplayOrder *int     `json:"displayOrder"`
+	Probability  *float64 `json:"probability"`
+	IsClosed     bool     `json:"isClosed"`
+	IsWon        bool     `json:"isWon"`
+}
+
+type SortInput struct {
+	Field     string         `json:"field"`
+	Direction *SortDirection `json:"direction"`
+}
+
+type StringFilter struct {
+	Eq       *string  `json:"eq"`
+	In       []string `json:"in"`
+	Contains *string  `json:"contains"`
+}
+
+type Task struct {
+	ID          string        `json:"id"`
+	CreatedAt   *time.Time    `json:"createdAt"`
+	UpdatedAt   *time.Time    `json:"updatedAt"`
+	Subject     string        `json:"subject"`
+	Description *string       `json:"description"`
+	Status      *TaskStatus   `json:"status"`
+	Priority    *TaskPriority `json:"priority"`
+	DueDate     *time.Time    `json:"dueDate"`
+}
+
+type TaskInput struct {
+	Subject     string        `json:"subject"`
+	Description *string       `json:"description"`
+	Status      *TaskStatus   `json:"status"`
+	Priority    *TaskPriority `json:"priority"`
+	DueDate     *time.Time    `json:"dueDate"`
+}
+
+type User struct {
+	ID        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+	Archived  *bool      `json:"archived"`
+	Name      *string    `json:"name"`
+	FirstName *string    `json:"firstName"`
+	LastName  *string    `json:"lastName"`
+	Email     *string    `json:"email"`
+	Active    *bool      `json:"active"`
+}
+
+type UserConnection struct {
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	Edges      []*UserEdge `json:"edges"`
+	TotalCount *int        `json:"totalCount"`
+}
+
+type UserEdge struct {
+	Node   *User  `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+type ActivityType string
+
+const (
+	ActivityTypeCall    ActivityType = "CALL"
+	ActivityTypeMeeting ActivityType = "MEETING"
+	ActivityTypeEmail   ActivityType = "EMAIL"
+)
+
+var AllActivityType = []ActivityType{
+	ActivityTypeCall,
+	ActivityTypeMeeting,
+	ActivityTypeEmail,
+}
+
+func (e ActivityType) IsValid() bool {
+	switch e {
+	case ActivityTypeCall, ActivityTypeMeeting, ActivityTypeEmail:
+		return true
+	}
+	return false
+}
+
+func (e ActivityType) String() string {
+	return string(e)
+}
+
+func (e *ActivityType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ActivityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ActivityType", str)
+	}
+	return nil
+}
+
+func (e ActivityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
 type AuthType string
@@ -136,16 +532,18 @@ type AuthType string
 const (
 	AuthTypeOauth2 AuthType = "oauth2"
 	AuthTypeSecret AuthType = "secret"
+	AuthTypeNone   AuthType = "none"
 )
 
 var AllAuthType = []AuthType{
 	AuthTypeOauth2,
 	AuthTypeSecret,
+	AuthTypeNone,
 }
 
 func (e AuthType) IsValid() bool {
 	switch e {
-	case AuthTypeOauth2, AuthTypeSecret:
+	case AuthTypeOauth2, AuthTypeSecret, AuthTypeNone:
 		return true
 	}
 	return false
@@ -171,3 +569,330 @@ func (e *AuthType) UnmarshalGQL(v interface{}) error {
 func (e AuthType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type ChangeObjectType string
+
+const (
+	ChangeObjectTypeContact     ChangeObjectType = "CONTACT"
+	ChangeObjectTypeNote        ChangeObjectType = "NOTE"
+	ChangeObjectTypeOpportunity ChangeObjectType = "OPPORTUNITY"
+)
+
+var AllChangeObjectType = []ChangeObjectType{
+	ChangeObjectTypeContact,
+	ChangeObjectTypeNote,
+	ChangeObjectTypeOpportunity,
+}
+
+func (e ChangeObjectType) IsValid() bool {
+	switch e {
+	case ChangeObjectTypeContact, ChangeObjectTypeNote, ChangeObjectTypeOpportunity:
+		return true
+	}
+	return false
+}
+
+func (e ChangeObjectType) String() string {
+	return string(e)
+}
+
+func (e *ChangeObjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeObjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeObjectType", str)
+	}
+	return nil
+}
+
+func (e ChangeObjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ChangeType string
+
+const (
+	ChangeTypeCreated ChangeType = "CREATED"
+	ChangeTypeUpdated ChangeType = "UPDATED"
+	ChangeTypeDeleted ChangeType = "DELETED"
+)
+
+var AllChangeType = []ChangeType{
+	ChangeTypeCreated,
+	ChangeTypeUpdated,
+	ChangeTypeDeleted,
+}
+
+func (e ChangeType) IsValid() bool {
+	switch e {
+	case ChangeTypeCreated, ChangeTypeUpdated, ChangeTypeDeleted:
+		return true
+	}
+	return false
+}
+
+func (e ChangeType) String() string {
+	return string(e)
+}
+
+func (e *ChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeType", str)
+	}
+	return nil
+}
+
+func (e ChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CrmObject string
+
+const (
+	CrmObjectContact     CrmObject = "CONTACT"
+	CrmObjectOpportunity CrmObject = "OPPORTUNITY"
+	CrmObjectCompany     CrmObject = "COMPANY"
+	CrmObjectLead        CrmObject = "LEAD"
+	CrmObjectUser        CrmObject = "USER"
+	CrmObjectNote        CrmObject = "NOTE"
+	CrmObjectTask        CrmObject = "TASK"
+	CrmObjectActivity    CrmObject = "ACTIVITY"
+	CrmObjectPipeline    CrmObject = "PIPELINE"
+	CrmObjectChange      CrmObject = "CHANGE"
+)
+
+var AllCrmObject = []CrmObject{
+	CrmObjectContact,
+	CrmObjectOpportunity,
+	CrmObjectCompany,
+	CrmObjectLead,
+	CrmObjectUser,
+	CrmObjectNote,
+	CrmObjectTask,
+	CrmObjectActivity,
+	CrmObjectPipeline,
+	CrmObjectChange,
+}
+
+func (e CrmObject) IsValid() bool {
+	switch e {
+	case CrmObjectContact, CrmObjectOpportunity, CrmObjectCompany, CrmObjectLead, CrmObjectUser, CrmObjectNote, CrmObjectTask, CrmObjectActivity, CrmObjectPipeline, CrmObjectChange:
+		return true
+	}
+	return false
+}
+
+func (e CrmObject) String() string {
+	return string(e)
+}
+
+func (e *CrmObject) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CrmObject(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CrmObject", str)
+	}
+	return nil
+}
+
+func (e CrmObject) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CrmOperation string
+
+const (
+	CrmOperationList     CrmOperation = "LIST"
+	CrmOperationGet      CrmOperation = "GET"
+	CrmOperationCreate   CrmOperation = "CREATE"
+	CrmOperationUpdate   CrmOperation = "UPDATE"
+	CrmOperationDelete   CrmOperation = "DELETE"
+	CrmOperationLink     CrmOperation = "LINK"
+	CrmOperationConvert  CrmOperation = "CONVERT"
+	CrmOperationDescribe CrmOperation = "DESCRIBE"
+)
+
+var AllCrmOperation = []CrmOperation{
+	CrmOperationList,
+	CrmOperationGet,
+	CrmOperationCreate,
+	CrmOperationUpdate,
+	CrmOperationDelete,
+	CrmOperationLink,
+	CrmOperationConvert,
+	CrmOperationDescribe,
+}
+
+func (e CrmOperation) IsValid() bool {
+	switch e {
+	case CrmOperationList, CrmOperationGet, CrmOperationCreate, CrmOperationUpdate, CrmOperationDelete, CrmOperationLink, CrmOperationConvert, CrmOperationDescribe:
+		return true
+	}
+	return false
+}
+
+func (e CrmOperation) String() string {
+	return string(e)
+}
+
+func (e *CrmOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CrmOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CrmOperation", str)
+	}
+	return nil
+}
+
+func (e CrmOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "LOW"
+	TaskPriorityNormal TaskPriority = "NORMAL"
+	TaskPriorityHigh   TaskPriority = "HIGH"
+)
+
+var AllTaskPriority = []TaskPriority{
+	TaskPriorityLow,
+	TaskPriorityNormal,
+	TaskPriorityHigh,
+}
+
+func (e TaskPriority) IsValid() bool {
+	switch e {
+	case TaskPriorityLow, TaskPriorityNormal, TaskPriorityHigh:
+		return true
+	}
+	return false
+}
+
+func (e TaskPriority) String() string {
+	return string(e)
+}
+
+func (e *TaskPriority) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskPriority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskPriority", str)
+	}
+	return nil
+}
+
+func (e TaskPriority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TaskStatus string
+
+const (
+	TaskStatusNotStarted TaskStatus = "NOT_STARTED"
+	TaskStatusInProgress TaskStatus = "IN_PROGRESS"
+	TaskStatusWaiting    TaskStatus = "WAITING"
+	TaskStatusCompleted  TaskStatus = "COMPLETED"
+	TaskStatusDeferred   TaskStatus = "DEFERRED"
+)
+
+var AllTaskStatus = []TaskStatus{
+	TaskStatusNotStarted,
+	TaskStatusInProgress,
+	TaskStatusWaiting,
+	TaskStatusCompleted,
+	TaskStatusDeferred,
+}
+
+func (e TaskStatus) IsValid() bool {
+	switch e {
+	case TaskStatusNotStarted, TaskStatusInProgress, TaskStatusWaiting, TaskStatusCompleted, TaskStatusDeferred:
+		return true
+	}
+	return false
+}
+
+func (e TaskStatus) String() string {
+	return string(e)
+}
+
+func (e *TaskStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskStatus", str)
+	}
+	return nil
+}
+
+func (e TaskStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
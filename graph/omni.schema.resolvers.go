@@ -4,11 +4,74 @@ package graph
 // will be copied through when generating and any unknown code will be moved to the end.
 
 import (
+	"blendbase/connectors"
 	"blendbase/graph/generated"
 	"blendbase/graph/model"
 	"context"
+	"fmt"
+	"time"
 )
 
+func (r *companyResolver) Owner(ctx context.Context, obj *model.Company) (*model.User, error) {
+	// connectors only fill a reference to the owner
+	if obj.Owner == nil || obj.Owner.ID == "" {
+		return nil, nil
+	}
+
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetUser(ctx, obj.Owner.ID)
+}
+
+func (r *companyResolver) Contacts(ctx context.Context, obj *model.Company) ([]*model.Contact, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListCompanyContacts(ctx, obj.ID)
+}
+
+func (r *companyResolver) Opportunities(ctx context.Context, obj *model.Company) ([]*model.Opportunity, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListCompanyOpportunities(ctx, obj.ID)
+}
+
+func (r *contactResolver) Company(ctx context.Context, obj *model.Contact) (*model.Company, error) {
+	// connectors only fill a reference to the linked company
+	if obj.Company == nil || obj.Company.ID == "" {
+		return nil, nil
+	}
+
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetCompany(ctx, obj.Company.ID)
+}
+
+func (r *contactResolver) Owner(ctx context.Context, obj *model.Contact) (*model.User, error) {
+	// connectors only fill a reference to the owner
+	if obj.Owner == nil || obj.Owner.ID == "" {
+		return nil, nil
+	}
+
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetUser(ctx, obj.Owner.ID)
+}
+
 func (r *contactResolver) Notes(ctx context.Context, obj *model.Contact) ([]*model.Note, error) {
 	c, err := r.getCrmConnector(ctx)
 	if err != nil {
@@ -18,6 +81,24 @@ func (r *contactResolver) Notes(ctx context.Context, obj *model.Contact) ([]*mod
 	return c.ListContactNotes(ctx, obj.ID)
 }
 
+func (r *contactResolver) Tasks(ctx context.Context, obj *model.Contact) ([]*model.Task, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListContactTasks(ctx, obj.ID)
+}
+
+func (r *contactResolver) Activities(ctx context.Context, obj *model.Contact) ([]*model.Activity, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListContactActivities(ctx, obj.ID)
+}
+
 func (r *crmResolver) Contact(ctx context.Context, obj *model.Crm, id string) (*model.Contact, error) {
 	c, err := r.getCrmConnector(ctx)
 	if err != nil {
@@ -27,7 +108,7 @@ func (r *crmResolver) Contact(ctx context.Context, obj *model.Crm, id string) (*
 	return c.GetContact(ctx, id)
 }
 
-func (r *crmResolver) Contacts(ctx context.Context, obj *model.Crm, first *int, after *string) (*model.ContactConnection, error) {
+func (r *crmResolver) Contacts(ctx context.Context, obj *model.Crm, first *int, after *string, last *int, before *string, filter *model.ContactFilter, query *string, orderBy []*model.SortInput) (*model.ContactConnection, error) {
 	c, err := r.getCrmConnector(ctx)
 	if err != nil {
 		return nil, err
@@ -38,10 +119,24 @@ func (r *crmResolver) Contacts(ctx context.Context, obj *model.Crm, first *int,
 		firstOption = *first
 	}
 
-	return c.ListContacts(ctx, firstOption, after)
+	params := connectors.ListParams{
+		First:             firstOption,
+		After:             after,
+		Last:              last,
+		Before:            before,
+		Filter:            connectors.NewContactFilter(filter),
+		Query:             query,
+		OrderBy:           orderBy,
+		IncludeTotalCount: isFieldSelected(ctx, "totalCount"),
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.ListContacts(ctx, &params)
 }
 
-func (r *crmResolver) Opportunities(ctx context.Context, obj *model.Crm, first *int, after *string) (*model.OpportunityConnection, error) {
+func (r *crmResolver) Opportunities(ctx context.Context, obj *model.Crm, first *int, after *string, last *int, before *string, filter *model.OpportunityFilter, query *string, orderBy []*model.SortInput) (*model.OpportunityConnection, error) {
 	c, err := r.getCrmConnector(ctx)
 	if err != nil {
 		return nil, err
@@ -52,7 +147,21 @@ func (r *crmResolver) Opportunities(ctx context.Context, obj *model.Crm, first *
 		firstOption = *first
 	}
 
-	return c.ListOpportunities(ctx, firstOption, after)
+	params := connectors.ListParams{
+		First:             firstOption,
+		After:             after,
+		Last:              last,
+		Before:            before,
+		Filter:            connectors.NewOpportunityFilter(filter),
+		Query:             query,
+		OrderBy:           orderBy,
+		IncludeTotalCount: isFieldSelected(ctx, "totalCount"),
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.ListOpportunities(ctx, &params)
 }
 
 func (r *crmResolver) Opportunity(ctx context.Context, obj *model.Crm, id string) (*model.Opportunity, error) {
@@ -64,6 +173,277 @@ func (r *crmResolver) Opportunity(ctx context.Context, obj *model.Crm, id string
 	return c.GetOpportunity(ctx, id)
 }
 
+func (r *crmResolver) Companies(ctx context.Context, obj *model.Crm, first *int, after *string, orderBy []*model.SortInput) (*model.CompanyConnection, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	firstOption := 10
+	if first != nil {
+		firstOption = *first
+	}
+
+	params := connectors.ListParams{
+		First:   firstOption,
+		After:   after,
+		OrderBy: orderBy,
+	}
+
+	return c.ListCompanies(ctx, &params)
+}
+
+func (r *crmResolver) Company(ctx context.Context, obj *model.Crm, id string) (*model.Company, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetCompany(ctx, id)
+}
+
+func (r *crmResolver) Leads(ctx context.Context, obj *model.Crm, first *int, after *string, last *int, before *string, orderBy []*model.SortInput) (*model.LeadConnection, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	firstOption := 10
+	if first != nil {
+		firstOption = *first
+	}
+
+	params := connectors.ListParams{
+		First:             firstOption,
+		After:             after,
+		Last:              last,
+		Before:            before,
+		OrderBy:           orderBy,
+		IncludeTotalCount: isFieldSelected(ctx, "totalCount"),
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.ListLeads(ctx, &params)
+}
+
+func (r *crmResolver) Lead(ctx context.Context, obj *model.Crm, id string) (*model.Lead, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetLead(ctx, id)
+}
+
+func (r *crmResolver) Users(ctx context.Context, obj *model.Crm, first *int, after *string, last *int, before *string, orderBy []*model.SortInput) (*model.UserConnection, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	firstOption := 10
+	if first != nil {
+		firstOption = *first
+	}
+
+	params := connectors.ListParams{
+		First:             firstOption,
+		After:             after,
+		Last:              last,
+		Before:            before,
+		OrderBy:           orderBy,
+		IncludeTotalCount: isFieldSelected(ctx, "totalCount"),
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.ListUsers(ctx, &params)
+}
+
+func (r *crmResolver) User(ctx context.Context, obj *model.Crm, id string) (*model.User, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetUser(ctx, id)
+}
+
+func (r *crmResolver) Pipelines(ctx context.Context, obj *model.Crm) ([]*model.Pipeline, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListPipelines(ctx)
+}
+
+func (r *crmResolver) Changes(ctx context.Context, obj *model.Crm, since *time.Time, first *int, after *string, objectTypes []model.ChangeObjectType) (*model.ChangeConnection, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	firstOption := 10
+	if first != nil {
+		firstOption = *first
+	}
+
+	params, err := connectors.NewChangesParams(since, firstOption, after, objectTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListChanges(ctx, params)
+}
+
+func (r *crmResolver) Capabilities(ctx context.Context, obj *model.Crm) (*model.Capabilities, error) {
+	integration, err := r.getCrmConsumerIntegration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	registration := connectors.Lookup(integration.ServiceCode)
+	if registration == nil {
+		return nil, fmt.Errorf("crm integration not found")
+	}
+
+	return registration.CapabilitiesModel(), nil
+}
+
+func (r *crmResolver) Describe(ctx context.Context, obj *model.Crm, object model.CrmObject) (*model.ObjectDescription, error) {
+	integration, err := r.getCrmConsumerIntegration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	registration := connectors.Lookup(integration.ServiceCode)
+	if registration == nil {
+		return nil, fmt.Errorf("crm integration not found")
+	}
+
+	c, err := r.newCrmConnector(integration)
+	if err != nil {
+		return nil, err
+	}
+
+	describer, ok := c.(connectors.ObjectDescriber)
+	if !ok || !registration.Supports(object, model.CrmOperationDescribe) {
+		return nil, fmt.Errorf("describing %s is not supported by %s", object, integration.ServiceCode)
+	}
+
+	return connectors.Descriptions.Describe(ctx, integration.ID, object, describer)
+}
+
+func (r *crmResolver) All(ctx context.Context, obj *model.Crm) (*model.CrmAll, error) {
+	return &model.CrmAll{}, nil
+}
+
+func (r *crmAllResolver) Contacts(ctx context.Context, obj *model.CrmAll, first *int, filter *model.ContactFilter, query *string, orderBy []*model.SortInput) (*model.AllContactConnection, error) {
+	crmConnectors, err := r.getAllCrmConnectors(ctx, model.CrmObjectContact, model.CrmOperationList)
+	if err != nil {
+		return nil, err
+	}
+
+	firstOption := 10
+	if first != nil {
+		firstOption = *first
+	}
+
+	params := connectors.ListParams{
+		First:   firstOption,
+		Filter:  connectors.NewContactFilter(filter),
+		Query:   query,
+		OrderBy: orderBy,
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	connections := make([]*model.ContactConnection, len(crmConnectors))
+	queryAllCrmConnectors(ctx, crmConnectors, func(i int, c connectors.CrmConnector) (err error) {
+		params := params
+		connections[i], err = c.ListContacts(ctx, &params)
+		return err
+	})
+
+	output := &model.AllContactConnection{Edges: []*model.AllContactEdge{}}
+	for i, connection := range connections {
+		if connection == nil {
+			continue
+		}
+
+		integration := crmConnectors[i].integration
+		for _, edge := range connection.Edges {
+			if edge == nil || edge.Node == nil {
+				continue
+			}
+
+			output.Edges = append(output.Edges, &model.AllContactEdge{
+				ServiceCode:   integration.ServiceCode,
+				IntegrationID: integration.ID.String(),
+				Node:          edge.Node,
+			})
+		}
+	}
+
+	return output, nil
+}
+
+func (r *crmAllResolver) Opportunities(ctx context.Context, obj *model.CrmAll, first *int, filter *model.OpportunityFilter, query *string, orderBy []*model.SortInput) (*model.AllOpportunityConnection, error) {
+	crmConnectors, err := r.getAllCrmConnectors(ctx, model.CrmObjectOpportunity, model.CrmOperationList)
+	if err != nil {
+		return nil, err
+	}
+
+	firstOption := 10
+	if first != nil {
+		firstOption = *first
+	}
+
+	params := connectors.ListParams{
+		First:   firstOption,
+		Filter:  connectors.NewOpportunityFilter(filter),
+		Query:   query,
+		OrderBy: orderBy,
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	connections := make([]*model.OpportunityConnection, len(crmConnectors))
+	queryAllCrmConnectors(ctx, crmConnectors, func(i int, c connectors.CrmConnector) (err error) {
+		params := params
+		connections[i], err = c.ListOpportunities(ctx, &params)
+		return err
+	})
+
+	output := &model.AllOpportunityConnection{Edges: []*model.AllOpportunityEdge{}}
+	for i, connection := range connections {
+		if connection == nil {
+			continue
+		}
+
+		integration := crmConnectors[i].integration
+		for _, edge := range connection.Edges {
+			if edge == nil || edge.Node == nil {
+				continue
+			}
+
+			output.Edges = append(output.Edges, &model.AllOpportunityEdge{
+				ServiceCode:   integration.ServiceCode,
+				IntegrationID: integration.ID.String(),
+				Node:          edge.Node,
+			})
+		}
+	}
+
+	return output, nil
+}
+
 func (r *mutationResolver) CreateContact(ctx context.Context, input model.ContactInput) (*model.Contact, error) {
 	c, err := r.getCrmConnector(ctx)
 	if err != nil {
@@ -143,6 +523,218 @@ func (r *mutationResolver) CreateOpportunityNote(ctx context.Context, opportunit
 	return c.CreateOpportunityNote(ctx, opportunityID, &input)
 }
 
+func (r *mutationResolver) CreateContactTask(ctx context.Context, contactID string, input model.TaskInput) (*model.Task, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateContactTask(ctx, contactID, &input)
+}
+
+func (r *mutationResolver) CreateOpportunityTask(ctx context.Context, opportunityID string, input model.TaskInput) (*model.Task, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateOpportunityTask(ctx, opportunityID, &input)
+}
+
+func (r *mutationResolver) UpdateTask(ctx context.Context, id string, input model.TaskInput) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.UpdateTask(ctx, id, &input)
+	return &success, err
+}
+
+func (r *mutationResolver) CreateContactActivity(ctx context.Context, contactID string, input model.ActivityInput) (*model.Activity, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateContactActivity(ctx, contactID, &input)
+}
+
+func (r *mutationResolver) CreateOpportunityActivity(ctx context.Context, opportunityID string, input model.ActivityInput) (*model.Activity, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateOpportunityActivity(ctx, opportunityID, &input)
+}
+
+func (r *mutationResolver) LinkContactToCompany(ctx context.Context, contactID string, companyID string) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.LinkContactToCompany(ctx, contactID, companyID)
+	return &success, err
+}
+
+func (r *mutationResolver) UnlinkContactFromCompany(ctx context.Context, contactID string, companyID string) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.UnlinkContactFromCompany(ctx, contactID, companyID)
+	return &success, err
+}
+
+func (r *mutationResolver) LinkOpportunityToCompany(ctx context.Context, opportunityID string, companyID string) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.LinkOpportunityToCompany(ctx, opportunityID, companyID)
+	return &success, err
+}
+
+func (r *mutationResolver) UnlinkOpportunityFromCompany(ctx context.Context, opportunityID string, companyID string) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.UnlinkOpportunityFromCompany(ctx, opportunityID, companyID)
+	return &success, err
+}
+
+func (r *mutationResolver) CreateCompany(ctx context.Context, input model.CompanyInput) (*model.Company, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateCompany(ctx, &input)
+}
+
+func (r *mutationResolver) UpdateCompany(ctx context.Context, id string, input model.CompanyInput) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.UpdateCompany(ctx, id, &input)
+	return &success, err
+}
+
+func (r *mutationResolver) DeleteCompany(ctx context.Context, id string) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.DeleteCompany(ctx, id)
+	return &success, err
+}
+
+func (r *mutationResolver) CreateLead(ctx context.Context, input model.LeadInput) (*model.Lead, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateLead(ctx, &input)
+}
+
+func (r *mutationResolver) UpdateLead(ctx context.Context, id string, input model.LeadInput) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.UpdateLead(ctx, id, &input)
+	return &success, err
+}
+
+func (r *mutationResolver) DeleteLead(ctx context.Context, id string) (*bool, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	success, err := c.DeleteLead(ctx, id)
+	return &success, err
+}
+
+func (r *mutationResolver) ConvertLead(ctx context.Context, id string, input *model.LeadConversionInput) (*model.LeadConversionResult, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input == nil {
+		input = &model.LeadConversionInput{}
+	}
+
+	return c.ConvertLead(ctx, id, input)
+}
+
+func (r *opportunityResolver) Stage(ctx context.Context, obj *model.Opportunity) (*model.PipelineStage, error) {
+	if obj.StageName == nil || *obj.StageName == "" {
+		return nil, nil
+	}
+
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelines, err := c.ListPipelines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connectors.FindPipelineStage(pipelines, obj.PipelineID, *obj.StageName), nil
+}
+
+func (r *opportunityResolver) Company(ctx context.Context, obj *model.Opportunity) (*model.Company, error) {
+	// connectors only fill a reference to the linked company
+	if obj.Company == nil || obj.Company.ID == "" {
+		return nil, nil
+	}
+
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetCompany(ctx, obj.Company.ID)
+}
+
+func (r *opportunityResolver) Owner(ctx context.Context, obj *model.Opportunity) (*model.User, error) {
+	// connectors only fill a reference to the owner
+	if obj.Owner == nil || obj.Owner.ID == "" {
+		return nil, nil
+	}
+
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetUser(ctx, obj.Owner.ID)
+}
+
+func (r *opportunityResolver) Contacts(ctx context.Context, obj *model.Opportunity) ([]*model.Contact, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListOpportunityContacts(ctx, obj.ID)
+}
+
 func (r *opportunityResolver) Notes(ctx context.Context, obj *model.Opportunity) ([]*model.Note, error) {
 	c, err := r.getCrmConnector(ctx)
 	if err != nil {
@@ -152,19 +744,45 @@ func (r *opportunityResolver) Notes(ctx context.Context, obj *model.Opportunity)
 	return c.ListOpportunityNotes(ctx, obj.ID)
 }
 
-func (r *queryResolver) Crm(ctx context.Context) (*model.Crm, error) {
+func (r *opportunityResolver) Tasks(ctx context.Context, obj *model.Opportunity) ([]*model.Task, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListOpportunityTasks(ctx, obj.ID)
+}
+
+func (r *opportunityResolver) Activities(ctx context.Context, obj *model.Opportunity) ([]*model.Activity, error) {
+	c, err := r.getCrmConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListOpportunityActivities(ctx, obj.ID)
+}
+
+func (r *queryResolver) Crm(ctx context.Context, integrationID *string) (*model.Crm, error) {
 	return &model.Crm{}, nil
 }
 
+// Company returns generated.CompanyResolver implementation.
+func (r *Resolver) Company() generated.CompanyResolver { return &companyResolver{r} }
+
 // Contact returns generated.ContactResolver implementation.
 func (r *Resolver) Contact() generated.ContactResolver { return &contactResolver{r} }
 
 // Crm returns generated.CrmResolver implementation.
 func (r *Resolver) Crm() generated.CrmResolver { return &crmResolver{r} }
 
+// CrmAll returns generated.CrmAllResolver implementation.
+func (r *Resolver) CrmAll() generated.CrmAllResolver { return &crmAllResolver{r} }
+
 // Opportunity returns generated.OpportunityResolver implementation.
 func (r *Resolver) Opportunity() generated.OpportunityResolver { return &opportunityResolver{r} }
 
+type companyResolver struct{ *Resolver }
 type contactResolver struct{ *Resolver }
 type crmResolver struct{ *Resolver }
+type crmAllResolver struct{ *Resolver }
 type opportunityResolver struct{ *Resolver }
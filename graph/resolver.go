@@ -4,22 +4,39 @@ import (
 	"blendbase/config"
 	"blendbase/connect"
 	"blendbase/connectors"
-	"blendbase/connectors/hubspot"
-	"blendbase/connectors/salesforce"
+	_ "blendbase/connectors/all"
 	"blendbase/graph/auth"
+	"blendbase/graph/model"
 	"blendbase/integrations"
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"sync"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
-const MISSING_CONSUMER_ID_ERROR = "missing consumer ID. please provide consumer ID in order to use this endpoint"
+const (
+	MISSING_CONSUMER_ID_ERROR = "missing consumer ID. please provide consumer ID in order to use this endpoint"
+
+	// header selecting the integration of the consumer in the Omni API, the crm field's integrationId argument takes precedence
+	INTEGRATION_ID_HEADER = "X-Integration-ID"
+)
+
+type integrationIDContextKey struct{}
+
+// Client of one of the crm integrations queried by crm.all
+type crmIntegrationConnector struct {
+	integration *integrations.ConsumerIntegration
+	connector   connectors.CrmConnector
+}
 
 type Resolver struct {
 	App       *config.App
@@ -36,15 +53,87 @@ func (r *Resolver) getCrmConsumerIntegration(ctx context.Context) (*integrations
 		return nil, err
 	}
 
+	integrationID, err := selectedIntegrationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	integration := integrations.ConsumerIntegration{}
-	// TODO: add support for users having multiple integrations
-	if err := r.App.DB.Where("consumer_id = ?", *consumerID).Where("type = ?", "crm").Where("enabled = ?", true).First(&integration).Error; err != nil {
+	query := r.App.DB.Where("consumer_id = ?", *consumerID).Where("type = ?", "crm").Where("enabled = ?", true)
+
+	if integrationID != nil {
+		if err := query.Where("id = ?", *integrationID).First(&integration).Error; err != nil {
+			return nil, fmt.Errorf("crm integration #%s not found", *integrationID)
+		}
+
+		return &integration, nil
+	}
+
+	// the default integration, the oldest enabled one when the consumer has no default
+	if err := query.Order("is_default DESC").Order("created_at ASC").First(&integration).Error; err != nil {
 		return nil, fmt.Errorf("crm integration not found")
 	}
 
 	return &integration, nil
 }
 
+// Stores the integration selected by the X-Integration-ID header in the context of the request
+func IntegrationSelector(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if integrationID := r.Header.Get(INTEGRATION_ID_HEADER); integrationID != "" {
+			r = r.WithContext(context.WithValue(r.Context(), integrationIDContextKey{}, integrationID))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Returns the ID of the integration selected by the integrationId argument of the crm field or by the header,
+// nil when the request doesn't select an integration
+func selectedIntegrationID(ctx context.Context) (*uuid.UUID, error) {
+	var integrationID *string
+	for fieldContext := graphql.GetFieldContext(ctx); fieldContext != nil; fieldContext = fieldContext.Parent {
+		// the records listed by crm.all are resolved with the integrations they come from
+		if integrationID = allEdgeIntegrationID(fieldContext.Result); integrationID != nil {
+			break
+		}
+
+		if fieldContext.Object == "Query" && fieldContext.Field.Name == "crm" {
+			integrationID, _ = fieldContext.Args["integrationId"].(*string)
+			break
+		}
+	}
+
+	if integrationID == nil {
+		if headerIntegrationID, ok := ctx.Value(integrationIDContextKey{}).(string); ok {
+			integrationID = &headerIntegrationID
+		}
+	}
+
+	if integrationID == nil {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(*integrationID)
+	if err != nil {
+		return nil, errors.New("invalid integration id. must be a valid uuid")
+	}
+
+	return &id, nil
+}
+
+// Returns the integration of the edge listed by crm.all, the items of the lists are the results of their field contexts
+func allEdgeIntegrationID(result interface{}) *string {
+	switch edge := result.(type) {
+	case **model.AllContactEdge:
+		return &(*edge).IntegrationID
+	case **model.AllOpportunityEdge:
+		return &(*edge).IntegrationID
+	}
+
+	return nil
+}
+
 func (r *Resolver) getOAuthConfig(consumerIntegration *integrations.ConsumerIntegration) *integrations.ConsumerOauth2Configuration {
 	var consumerOAuthConfig integrations.ConsumerOauth2Configuration
 	if err := r.App.DB.Where("consumer_integration_id = ?", consumerIntegration.ID).First(&consumerOAuthConfig).Error; err != nil {
@@ -59,17 +148,99 @@ func (r *Resolver) getCrmConnector(ctx context.Context) (connectors.CrmConnector
 		return nil, err
 	}
 
-	if integration.ServiceCode == connectors.CONNECTOR_CRM_HUBSPOT {
-		client := hubspot.HubspotClient(integration.Secret.Raw)
+	return r.newCrmConnector(integration)
+}
+
+func (r *Resolver) newCrmConnector(integration *integrations.ConsumerIntegration) (connectors.CrmConnector, error) {
+	registration := connectors.Lookup(integration.ServiceCode)
+	if registration == nil {
+		return nil, fmt.Errorf("crm integration not found")
+	}
+
+	var oauthConfig *integrations.ConsumerOauth2Configuration
+	if registration.SupportsAuthType(connectors.AUTH_TYPE_OAUTH2) {
+		oauthConfig = r.getOAuthConfig(integration)
+	}
+
+	fieldMapping, err := connectors.LoadFieldMapping(r.App, integration.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return registration.NewCrmConnector(r.App, integration, oauthConfig, fieldMapping), nil
+}
+
+// Returns the clients of the connected crm integrations of the consumer that support the operation on the object,
+// the default integration first, the disabled integrations are included as long as they have credentials
+func (r *Resolver) getAllCrmConnectors(ctx context.Context, object model.CrmObject, operation model.CrmOperation) ([]*crmIntegrationConnector, error) {
+	consumerID := r.GraphAuth.GetConsumerIDFromContext(ctx)
+	if consumerID == nil {
+		return nil, fmt.Errorf("missing consumer ID")
+	}
+
+	if err := r.checkConsumerExistence(consumerID); err != nil {
+		return nil, err
+	}
+
+	consumerIntegrations, err := connect.NewConnectClient(r.App, *consumerID).ListConnectedIntegrations("crm")
+	if err != nil {
+		return nil, err
+	}
+
+	crmConnectors := []*crmIntegrationConnector{}
+	for _, integration := range consumerIntegrations {
+		registration := connectors.Lookup(integration.ServiceCode)
+		if registration == nil || !registration.Supports(object, operation) {
+			continue
+		}
+
+		connector, err := r.newCrmConnector(integration)
+		if err != nil {
+			return nil, err
+		}
+
+		crmConnectors = append(crmConnectors, &crmIntegrationConnector{integration: integration, connector: connector})
+	}
+
+	return crmConnectors, nil
+}
+
+// Runs the query against the clients concurrently, the errors of the integrations are added to the errors of the response
+// so that the records of the other integrations are still returned
+func queryAllCrmConnectors(ctx context.Context, crmConnectors []*crmIntegrationConnector, query func(i int, c connectors.CrmConnector) error) {
+	errs := make([]error, len(crmConnectors))
 
-		return client, nil
-	} else if integration.ServiceCode == connectors.CONNECTOR_CRM_SALESFORCE {
-		oauthConfig := r.getOAuthConfig(integration)
+	var wg sync.WaitGroup
+	for i, crmConnector := range crmConnectors {
+		wg.Add(1)
+		go func(i int, c connectors.CrmConnector) {
+			defer wg.Done()
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					errs[i] = fmt.Errorf("%v", recovered)
+				}
+			}()
 
-		return salesforce.SaleforceClient(r.App, oauthConfig), nil
+			errs[i] = query(i, c)
+		}(i, crmConnector.connector)
 	}
+	wg.Wait()
 
-	return nil, fmt.Errorf("crm integration not found")
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		integration := crmConnectors[i].integration
+		graphql.AddError(ctx, &gqlerror.Error{
+			Path:    graphql.GetPath(ctx),
+			Message: fmt.Sprintf("%s integration #%s: %s", integration.ServiceCode, integration.ID, err),
+			Extensions: map[string]interface{}{
+				"serviceCode":   integration.ServiceCode,
+				"integrationId": integration.ID.String(),
+			},
+		})
+	}
 }
 
 func (r *Resolver) getConnectClient(ctx context.Context) (*connect.ConnectClient, error) {
@@ -94,3 +265,15 @@ func (r *Resolver) checkConsumerExistence(consumerID *uuid.UUID) error {
 
 	return nil
 }
+
+// Returns true when the field is selected in the result of the resolved field,
+// e.g. to skip counting the records when the total count isn't requested
+func isFieldSelected(ctx context.Context, name string) bool {
+	for _, field := range graphql.CollectFieldsCtx(ctx, nil) {
+		if field.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -45,6 +45,7 @@ type ConsumerOauth2Configuration struct {
 	TokenType             string `gorm:"type:VARCHAR(255);"` // e.g. "bearer"
 	AccessToken           gormext.EncryptedValue
 	RefreshToken          gormext.EncryptedValue
+	ExpiresAt             *time.Time     // expiration of the access token, nil when it's unknown
 	CustomSettings        datatypes.JSON `gorm:"type:JSONB;"`
 }
 
@@ -61,16 +62,53 @@ func (consumerOauth2Configuration *ConsumerOauth2Configuration) GetCustomSetting
 	return &customSettings, nil
 }
 
+func (consumerOauth2Configuration *ConsumerOauth2Configuration) SetCustomSettings(customSettings *ConsumerOauth2ConfigurationCustomSettings) error {
+	customSettingsJson, err := json.Marshal(customSettings)
+	if err != nil {
+		return err
+	}
+
+	consumerOauth2Configuration.CustomSettings = datatypes.JSON(customSettingsJson)
+
+	return nil
+}
+
 type ConsumerOauth2ConfigurationCustomSettings struct {
 	SalesforceInstanceSubdomain string `json:"salesforceInstanceSubdomain"`
+	SalesforceLoginURL          string `json:"salesforceLoginUrl"`    // e.g. https://test.salesforce.com for the sandboxes
+	SalesforceInstanceURL       string `json:"salesforceInstanceUrl"` // e.g. https://acme.my.salesforce.com, returned with the token
+	DynamicsOrgURL              string `json:"dynamicsOrgUrl"`        // e.g. https://contoso.crm.dynamics.com
+	DynamicsTenantID            string `json:"dynamicsTenantId"`      // Azure AD tenant of single-tenant apps
+	ZohoDataCenter              string `json:"zohoDataCenter"`        // e.g. "eu"
+	ZohoAPIDomain               string `json:"zohoApiDomain"`         // e.g. https://www.zohoapis.eu, returned with the token
+}
+
+// Authorization started by the login URL of an integration, the callback accepts its state once before it expires
+type ConsumerOauth2State struct {
+	Base
+	ConsumerID            uuid.UUID              `gorm:"type:UUID;index;"`
+	ConsumerIntegrationID uuid.UUID              `gorm:"type:UUID;"`
+	CodeVerifier          gormext.EncryptedValue // PKCE code verifier, empty for the connectors without PKCE
+	ExpiresAt             time.Time
 }
 
 type ConsumerIntegration struct {
 	Base
 	Type        string    `gorm:"type:VARCHAR(255);"` // e.g. "crm"
-	ServiceCode string    `gorm:"type:VARCHAR(255);"` // e.g. "crm_salesforce", "crm_hubspot"
+	ServiceCode string    `gorm:"type:VARCHAR(255);"` // e.g. "crm_salesforce", "crm_hubspot", "crm_pipedrive", "crm_dynamics", "crm_zoho", "crm_sandbox"
 	ConsumerID  uuid.UUID `gorm:"type:UUID;"`
 	Enabled     bool      `gorm:"default:false;"`
+	IsDefault   bool      `gorm:"default:false;"` // the enabled integration used when the requests don't select one, one per consumer and type
 	Consumer    Consumer
 	Secret      gormext.EncryptedValue
 }
+
+// Field of the CRM returned in the custom fields of the records of the integration under a key defined by the consumer
+type ConsumerIntegrationFieldMapping struct {
+	Base
+	ConsumerIntegrationID uuid.UUID `gorm:"type:UUID;uniqueIndex:idx_field_mapping_key;"`
+	ConsumerIntegration   ConsumerIntegration
+	Object                string `gorm:"type:VARCHAR(255);uniqueIndex:idx_field_mapping_key;"` // e.g. "CONTACT"
+	Key                   string `gorm:"type:VARCHAR(255);uniqueIndex:idx_field_mapping_key;"` // e.g. "score"
+	Field                 string `gorm:"type:VARCHAR(255);"`                                   // e.g. "Custom_Score__c"
+}
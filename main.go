@@ -16,6 +16,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		cmd.DBSeedCmd,
 		cmd.DBMigrateCmd,
+		cmd.SandboxResetCmd,
 		cmd.ServerCmd,
 		cmd.GenerateEncryptionKeyCmd,
 		cmd.GenerateAuthTokenCmd,
@@ -3,6 +3,7 @@ package db_utils
 import (
 	"blendbase/config"
 	"blendbase/connectors"
+	"blendbase/connectors/sandbox"
 	"blendbase/integrations"
 	"blendbase/misc/gormext"
 	"errors"
@@ -26,12 +27,18 @@ func Migrate(app *config.App) error {
 		&integrations.Consumer{},
 		&integrations.ConsumerIntegration{},
 		&integrations.ConsumerOauth2Configuration{},
+		&integrations.ConsumerOauth2State{},
+		&integrations.ConsumerIntegrationFieldMapping{},
 	)
 
 	if err != nil {
 		return err
 	}
 
+	if err := app.DB.AutoMigrate(sandbox.Models...); err != nil {
+		return err
+	}
+
 	app.Logger.Info("Database migrated")
 
 	return nil
@@ -50,7 +57,7 @@ func Seed(app *config.App) error {
 
 	if result.Error == nil {
 		log.Println("Seed data already exists")
-		return nil
+		return seedSandbox(app, &consumer)
 	}
 
 	consumer = integrations.Consumer{Base: integrations.Base{ID: uuid.MustParse(TEST_CONSUMER_ID)}}
@@ -97,5 +104,52 @@ func Seed(app *config.App) error {
 		return err
 	}
 
+	return seedSandbox(app, &consumer)
+}
+
+// Creates the enabled sandbox integration of the consumer with its sample records,
+// the records of an existing sandbox are left as they are
+func seedSandbox(app *config.App, consumer *integrations.Consumer) error {
+	sandboxIntegration := integrations.ConsumerIntegration{}
+	result := app.DB.Where(integrations.ConsumerIntegration{
+		ConsumerID:  consumer.ID,
+		Type:        connectors.CONNECTOR_TYPE_CRM,
+		ServiceCode: connectors.CONNECTOR_CRM_SANDBOX,
+	}).Attrs(integrations.ConsumerIntegration{Enabled: true, IsDefault: true}).FirstOrCreate(&sandboxIntegration)
+	if result.Error != nil {
+		log.Fatalf("failed to create sandbox integration: %+v", result.Error)
+		return result.Error
+	}
+
+	if err := sandbox.Seed(app.DB, sandboxIntegration.ID); err != nil {
+		log.Fatalf("failed to seed sandbox: %+v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Deletes the records of the sandbox integrations and seeds them again,
+// all the sandbox integrations are reset unless an integration ID is given
+func ResetSandbox(app *config.App, integrationID *uuid.UUID) error {
+	sandboxIntegrations := []integrations.ConsumerIntegration{}
+	query := app.DB.Where("service_code = ?", connectors.CONNECTOR_CRM_SANDBOX)
+	if integrationID != nil {
+		query = query.Where("id = ?", *integrationID)
+	}
+	if err := query.Find(&sandboxIntegrations).Error; err != nil {
+		return err
+	}
+
+	if integrationID != nil && len(sandboxIntegrations) == 0 {
+		return fmt.Errorf("sandbox integration #%s not found", *integrationID)
+	}
+
+	for _, integration := range sandboxIntegrations {
+		if err := sandbox.Reset(app.DB, integration.ID); err != nil {
+			return fmt.Errorf("failed to reset sandbox integration #%s: %s", integration.ID, err)
+		}
+	}
+
 	return nil
 }
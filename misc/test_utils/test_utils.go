@@ -49,3 +49,76 @@ func GenerateNoteInput() *model.NoteInput {
 
 	return &input
 }
+
+func GenerateCompanyInput() *model.CompanyInput {
+	name := gofakeit.Company()
+	website := gofakeit.DomainName()
+	phone := gofakeit.Phone()
+	city := gofakeit.City()
+	numberOfEmployees := gofakeit.Number(1, 10000)
+
+	input := model.CompanyInput{
+		Name:              name,
+		Website:           &website,
+		Phone:             &phone,
+		City:              &city,
+		NumberOfEmployees: &numberOfEmployees,
+	}
+
+	return &input
+}
+
+func GenerateLeadInput() *model.LeadInput {
+	companyName := gofakeit.Company()
+	firstName := gofakeit.FirstName()
+	lastName := gofakeit.LastName()
+	email := gofakeit.Email()
+	phone := gofakeit.Phone()
+	title := gofakeit.JobTitle()
+
+	input := model.LeadInput{
+		CompanyName: &companyName,
+		FirstName:   &firstName,
+		LastName:    &lastName,
+		Email:       &email,
+		Phone:       &phone,
+		Title:       &title,
+	}
+
+	return &input
+}
+
+func GenerateTaskInput() *model.TaskInput {
+	subject := gofakeit.Sentence(5)
+	description := gofakeit.Sentence(20)
+	status := model.TaskStatusNotStarted
+	priority := model.TaskPriorityHigh
+	dueDate := time.Now().UTC().AddDate(0, 0, 7)
+
+	input := model.TaskInput{
+		Subject:     subject,
+		Description: &description,
+		Status:      &status,
+		Priority:    &priority,
+		DueDate:     &dueDate,
+	}
+
+	return &input
+}
+
+func GenerateActivityInput(activityType model.ActivityType) *model.ActivityInput {
+	subject := gofakeit.Sentence(5)
+	description := gofakeit.Sentence(20)
+	startTime := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	endTime := startTime.Add(15 * time.Minute)
+
+	input := model.ActivityInput{
+		Type:        activityType,
+		Subject:     &subject,
+		Description: &description,
+		StartTime:   &startTime,
+		EndTime:     &endTime,
+	}
+
+	return &input
+}